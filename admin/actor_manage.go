@@ -10,6 +10,7 @@ import (
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
 	"gitlab.com/navyx/ai/maos/maos-core/util"
 )
 
@@ -43,6 +44,9 @@ func ListActors(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource
 				Deployable:   row.Deployable,
 				Configurable: row.Configurable,
 				Migratable:   row.Migratable,
+				RetryPolicy:  toApiRetryPolicy(row.MaxAttempts, row.RetryBackoffSeconds),
+				MaxPriority:  int(row.MaxPriority),
+				LeaseSeconds: toApiLeaseSeconds(row.LeaseSeconds),
 			}
 		},
 	)
@@ -72,10 +76,29 @@ func CreateActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 			N400JSONResponse: api.N400JSONResponse{Error: "Deployable actors must also be configurable"},
 		}, nil
 	}
+	maxAttempts, retryBackoffSeconds, err := invocation.RetryPolicyParams(request.Body.RetryPolicy)
+	if err != nil {
+		return api.AdminCreateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+	leaseSeconds, err := invocation.LeaseSecondsParam(request.Body.LeaseSeconds)
+	if err != nil {
+		return api.AdminCreateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+	maxPriority, err := invocation.PriorityParam("max_priority", request.Body.MaxPriority)
+	if err != nil {
+		return api.AdminCreateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
 
 	queue, err := querier.QueueInsert(ctx, ds, &dbsqlc.QueueInsertParams{
-		Name:     request.Body.Name,
-		Metadata: []byte(`{"type":"actor"}`),
+		Name:         request.Body.Name,
+		Metadata:     []byte(`{"type":"actor"}`),
+		LeaseSeconds: leaseSeconds,
 	})
 	if err != nil {
 		logger.Error("Cannot create actors", "error", err)
@@ -85,13 +108,16 @@ func CreateActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 	}
 
 	actor, err := querier.ActorInsert(ctx, ds, &dbsqlc.ActorInsertParams{
-		Name:         request.Body.Name,
-		Role:         dbsqlc.ActorRole(request.Body.Role),
-		QueueID:      queue.ID,
-		Enabled:      lo.FromPtrOr(request.Body.Enabled, true),
-		Deployable:   lo.FromPtrOr(request.Body.Deployable, false),
-		Configurable: lo.FromPtrOr(request.Body.Configurable, false),
-		Migratable:   lo.FromPtrOr(request.Body.Migratable, false),
+		Name:                request.Body.Name,
+		Role:                dbsqlc.ActorRole(request.Body.Role),
+		QueueID:             queue.ID,
+		Enabled:             lo.FromPtrOr(request.Body.Enabled, true),
+		Deployable:          lo.FromPtrOr(request.Body.Deployable, false),
+		Configurable:        lo.FromPtrOr(request.Body.Configurable, false),
+		Migratable:          lo.FromPtrOr(request.Body.Migratable, false),
+		MaxAttempts:         maxAttempts,
+		RetryBackoffSeconds: retryBackoffSeconds,
+		MaxPriority:         maxPriority,
 	})
 	if err != nil {
 
@@ -110,6 +136,9 @@ func CreateActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 		TokenCount:   0,
 		CreatedAt:    actor.CreatedAt,
 		Renameable:   true,
+		RetryPolicy:  toApiRetryPolicy(actor.MaxAttempts, actor.RetryBackoffSeconds),
+		MaxPriority:  int(actor.MaxPriority),
+		LeaseSeconds: toApiLeaseSeconds(queue.LeaseSeconds),
 	}, nil
 }
 
@@ -144,6 +173,9 @@ func GetActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource,
 			Deployable:   actor.Deployable,
 			Configurable: actor.Configurable,
 			Migratable:   actor.Migratable,
+			RetryPolicy:  toApiRetryPolicy(actor.MaxAttempts, actor.RetryBackoffSeconds),
+			MaxPriority:  int(actor.MaxPriority),
+			LeaseSeconds: toApiLeaseSeconds(actor.LeaseSeconds),
 		},
 	}, nil
 }
@@ -161,15 +193,58 @@ func UpdateActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 			N400JSONResponse: api.N400JSONResponse{Error: "Deployable actors must also be configurable"},
 		}, nil
 	}
+	maxAttempts, retryBackoffSeconds, err := invocation.RetryPolicyParams(request.Body.RetryPolicy)
+	if err != nil {
+		return api.AdminUpdateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+	leaseSeconds, err := invocation.LeaseSecondsParam(request.Body.LeaseSeconds)
+	if err != nil {
+		return api.AdminUpdateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+	maxPriority, err := invocation.PriorityParam("max_priority", request.Body.MaxPriority)
+	if err != nil {
+		return api.AdminUpdateActor400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
 
-	actor, err := querier.ActorUpdate(ctx, ds, &dbsqlc.ActorUpdateParams{
-		ID:           int64(request.Id),
-		Name:         request.Body.Name,
-		Role:         dbsqlc.NullActorRole{ActorRole: dbsqlc.ActorRole(lo.FromPtrOr(request.Body.Role, "")), Valid: request.Body.Role != nil},
-		Enabled:      request.Body.Enabled,
-		Deployable:   request.Body.Deployable,
-		Configurable: request.Body.Configurable,
-		Migratable:   request.Body.Migratable,
+	// the actor and its queue are updated together, so that a failed queue update doesn't leave the actor half updated
+	var actor *dbsqlc.Actor
+	var queue *dbsqlc.Queue
+	err = dbaccess.WithTx(ctx, ds, func(ctx context.Context, tx dbaccess.DataSource) error {
+		var err error
+		actor, err = querier.ActorUpdate(ctx, tx, &dbsqlc.ActorUpdateParams{
+			ID:                  int64(request.Id),
+			Name:                request.Body.Name,
+			Role:                dbsqlc.NullActorRole{ActorRole: dbsqlc.ActorRole(lo.FromPtrOr(request.Body.Role, "")), Valid: request.Body.Role != nil},
+			Enabled:             request.Body.Enabled,
+			Deployable:          request.Body.Deployable,
+			Configurable:        request.Body.Configurable,
+			Migratable:          request.Body.Migratable,
+			MaxAttempts:         maxAttempts,
+			RetryBackoffSeconds: retryBackoffSeconds,
+			MaxPriority:         maxPriority,
+		})
+		if err != nil {
+			return err
+		}
+
+		if leaseSeconds != nil {
+			queue, err = querier.QueueUpdate(ctx, tx, &dbsqlc.QueueUpdateParams{
+				ID:           actor.QueueID,
+				LeaseSeconds: leaseSeconds,
+			})
+		} else {
+			queue, err = querier.QueueFindById(ctx, tx, actor.QueueID)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot update actor queue: %w", err)
+		}
+		return nil
 	})
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -192,6 +267,9 @@ func UpdateActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 			Configurable: actor.Configurable,
 			Migratable:   actor.Migratable,
 			CreatedAt:    actor.CreatedAt,
+			RetryPolicy:  toApiRetryPolicy(actor.MaxAttempts, actor.RetryBackoffSeconds),
+			MaxPriority:  int(actor.MaxPriority),
+			LeaseSeconds: toApiLeaseSeconds(queue.LeaseSeconds),
 		},
 	}, nil
 }
@@ -201,6 +279,11 @@ func DeleteActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 
 	actor, err := querier.ActorDelete(ctx, ds, int64(request.Id))
 	if err != nil {
+		// a config or schedule created for the actor while it was being deleted
+		if dbaccess.IsForeignKeyViolation(err) {
+			return api.AdminDeleteActor409Response{}, nil
+		}
+
 		logger.Error("Cannot delete actor", "error", err)
 		return api.AdminDeleteActor500JSONResponse{
 			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot delete actor: %v", err)},
@@ -220,3 +303,17 @@ func DeleteActor(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSourc
 		N500JSONResponse: api.N500JSONResponse{Error: "Cannot delete actor"},
 	}, nil
 }
+
+func toApiRetryPolicy(maxAttempts int16, backoffSeconds int32) api.RetryPolicy {
+	return api.RetryPolicy{
+		MaxAttempts:    lo.ToPtr(int(maxAttempts)),
+		BackoffSeconds: lo.ToPtr(int(backoffSeconds)),
+	}
+}
+
+func toApiLeaseSeconds(leaseSeconds *int32) *int {
+	if leaseSeconds == nil {
+		return nil
+	}
+	return lo.ToPtr(int(*leaseSeconds))
+}
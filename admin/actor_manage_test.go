@@ -193,6 +193,7 @@ func TestCreateActorWithDB(t *testing.T) {
 		assert.True(t, jsonResponse.Deployable)
 		assert.True(t, jsonResponse.Configurable)
 		assert.True(t, jsonResponse.Renameable)
+		assert.Nil(t, jsonResponse.LeaseSeconds)
 
 		// Verify the actor was created in the database
 		actor, err := querier.ActorFindById(ctx, dbPool, jsonResponse.Id)
@@ -318,6 +319,49 @@ func TestCreateActorWithDB(t *testing.T) {
 		assert.IsType(t, api.AdminCreateActor400JSONResponse{}, response)
 	})
 
+	t.Run("Successful actor creation with retry policy", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		request := api.AdminCreateActorRequestObject{
+			Body: &api.AdminCreateActorJSONRequestBody{
+				Name:        "TestActor",
+				Role:        api.ActorCreateRole("agent"),
+				RetryPolicy: &api.RetryPolicy{MaxAttempts: lo.ToPtr(3)},
+			},
+		}
+
+		response, err := admin.CreateActor(ctx, logger, dbPool, request)
+
+		assert.NoError(t, err)
+		require.IsType(t, api.AdminCreateActor201JSONResponse{}, response)
+		jsonResponse := response.(api.AdminCreateActor201JSONResponse)
+		assert.Equal(t, api.RetryPolicy{MaxAttempts: lo.ToPtr(3), BackoffSeconds: lo.ToPtr(10)}, jsonResponse.RetryPolicy)
+
+		actor, err := querier.ActorFindById(ctx, dbPool, jsonResponse.Id)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, actor.MaxAttempts)
+		assert.EqualValues(t, 10, actor.RetryBackoffSeconds)
+	})
+
+	t.Run("Invalid retry policy", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		request := api.AdminCreateActorRequestObject{
+			Body: &api.AdminCreateActorJSONRequestBody{
+				Name:        "TestActor",
+				Role:        api.ActorCreateRole("agent"),
+				RetryPolicy: &api.RetryPolicy{MaxAttempts: lo.ToPtr(0)},
+			},
+		}
+
+		response, err := admin.CreateActor(ctx, logger, dbPool, request)
+
+		assert.NoError(t, err)
+		assert.IsType(t, api.AdminCreateActor400JSONResponse{}, response)
+	})
+
 	t.Run("Invalid configurable value", func(t *testing.T) {
 		dbPool := testhelper.TestDB(ctx, t)
 		defer dbPool.Close()
@@ -414,6 +458,30 @@ func TestUpdateActor(t *testing.T) {
 		assert.Equal(t, existingActor.Configurable, updatedActor.Configurable)
 	})
 
+	t.Run("Lease is set and removed", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		existingActor := fixture.InsertActor(t, ctx, dbPool, "ExistingActor")
+
+		update := func(leaseSeconds int) api.Actor {
+			response, err := admin.UpdateActor(ctx, logger, dbPool, api.AdminUpdateActorRequestObject{
+				Id:   existingActor.ID,
+				Body: &api.AdminUpdateActorJSONRequestBody{LeaseSeconds: lo.ToPtr(leaseSeconds)},
+			})
+			require.NoError(t, err)
+			require.IsType(t, api.AdminUpdateActor200JSONResponse{}, response)
+			return response.(api.AdminUpdateActor200JSONResponse).Data
+		}
+
+		assert.Equal(t, lo.ToPtr(600), update(600).LeaseSeconds)
+		assert.Nil(t, update(0).LeaseSeconds)
+
+		queue, err := querier.QueueFindById(ctx, dbPool, existingActor.QueueID)
+		require.NoError(t, err)
+		assert.Nil(t, queue.LeaseSeconds)
+	})
+
 	t.Run("Actor not found", func(t *testing.T) {
 		dbPool := testhelper.TestDB(ctx, t)
 		defer dbPool.Close()
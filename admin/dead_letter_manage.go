@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func ListDeadLetters(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListDeadLettersRequestObject) (api.AdminListDeadLettersResponseObject, error) {
+	logger.Info("ListDeadLetters", "params", request.Params)
+
+	data, nextCursor, err := listInvocationPage(ctx, ds, request.Params.Cursor, request.Params.PageSize, &dbsqlc.InvocationListPaginatedParams{
+		Actor:   request.Params.Actor,
+		QueueID: request.Params.QueueId,
+		State:   dbsqlc.NullInvocationState{InvocationState: dbsqlc.InvocationStateDiscarded, Valid: true},
+	})
+	if err != nil {
+		if err == errInvalidCursor {
+			return api.AdminListDeadLetters400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+			}, nil
+		}
+		logger.Error("Cannot list dead letters", "error", err)
+		return api.AdminListDeadLetters500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list dead letters: %v", err)},
+		}, nil
+	}
+
+	response := api.AdminListDeadLetters200JSONResponse{Data: data}
+	response.Meta.NextCursor = nextCursor
+	return response, nil
+}
+
+func ReplayDeadLetters(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminReplayDeadLettersRequestObject) (api.AdminReplayDeadLettersResponseObject, error) {
+	logger.Info("ReplayDeadLetters", "ids", request.Body.Ids)
+
+	if len(request.Body.Ids) == 0 || len(request.Body.Ids) > 1000 {
+		return api.AdminReplayDeadLetters400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "ids must have between 1 and 1000 items"},
+		}, nil
+	}
+
+	ids, err := parseInvocationIds(request.Body.Ids)
+	if err != nil {
+		return api.AdminReplayDeadLetters400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+
+	var payload []byte
+	if request.Body.Payload != nil {
+		payload, err = json.Marshal(request.Body.Payload)
+		if err != nil {
+			return api.AdminReplayDeadLetters400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Invalid payload: %v", err)},
+			}, nil
+		}
+	}
+
+	rows, err := querier.InvocationReplayDiscarded(ctx, ds, &dbsqlc.InvocationReplayDiscardedParams{
+		Now:     time.Now().Unix(),
+		Payload: payload,
+		Ids:     ids,
+	})
+	if err != nil {
+		logger.Error("Cannot replay dead letters", "error", err)
+		return api.AdminReplayDeadLetters500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot replay dead letters: %v", err)},
+		}, nil
+	}
+
+	for _, row := range rows {
+		metrics.InvocationsEnqueued.WithLabelValues(metrics.QueueLabel(row.QueueID)).Inc()
+	}
+
+	queueIds := lo.Uniq(lo.Map(rows, func(row *dbsqlc.InvocationReplayDiscardedRow, _ int) int64 { return row.QueueID }))
+	if err := invocation.NotifyQueues(ctx, ds, queueIds); err != nil {
+		logger.Error("Cannot notify replayed dead letters", "error", err)
+	}
+
+	data := lo.Map(rows, func(row *dbsqlc.InvocationReplayDiscardedRow, _ int) api.DeadLetterReplay {
+		return api.DeadLetterReplay{
+			Id:           strconv.FormatInt(row.ID, 10),
+			ReplayedFrom: strconv.FormatInt(*row.ReplayedFrom, 10),
+		}
+	})
+	replayed := lo.SliceToMap(rows, func(row *dbsqlc.InvocationReplayDiscardedRow) (int64, bool) { return *row.ReplayedFrom, true })
+	skipped := lo.Uniq(lo.FilterMap(ids, func(id int64, _ int) (string, bool) {
+		return strconv.FormatInt(id, 10), !replayed[id]
+	}))
+	return api.AdminReplayDeadLetters200JSONResponse{Data: data, Skipped: skipped}, nil
+}
+
+func PurgeDeadLetters(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminPurgeDeadLettersRequestObject) (api.AdminPurgeDeadLettersResponseObject, error) {
+	logger.Info("PurgeDeadLetters", "request", request.Body)
+
+	if request.Body.Ids == nil && request.Body.Actor == nil && request.Body.QueueId == nil {
+		return api.AdminPurgeDeadLetters400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "One of ids, actor and queue_id is required"},
+		}, nil
+	}
+	if request.Body.Actor != nil && request.Body.QueueId != nil {
+		return api.AdminPurgeDeadLetters400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "actor and queue_id cannot be used together"},
+		}, nil
+	}
+
+	var ids []int64
+	if request.Body.Ids != nil {
+		var err error
+		ids, err = parseInvocationIds(*request.Body.Ids)
+		if err != nil {
+			return api.AdminPurgeDeadLetters400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+			}, nil
+		}
+	}
+
+	queueId := request.Body.QueueId
+	if request.Body.Actor != nil {
+		actor, err := querier.ActorFindByName(ctx, ds, *request.Body.Actor)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return api.AdminPurgeDeadLetters400JSONResponse{
+					N400JSONResponse: api.N400JSONResponse{Error: "Actor not found"},
+				}, nil
+			}
+			logger.Error("Cannot purge dead letters", "error", err)
+			return api.AdminPurgeDeadLetters500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot purge dead letters: %v", err)},
+			}, nil
+		}
+		queueId = &actor.QueueID
+	}
+
+	purged, err := querier.InvocationPurgeDiscarded(ctx, ds, &dbsqlc.InvocationPurgeDiscardedParams{
+		Ids:             ids,
+		QueueID:         queueId,
+		FinalizedBefore: request.Body.FinalizedBefore,
+	})
+	if err != nil {
+		logger.Error("Cannot purge dead letters", "error", err)
+		return api.AdminPurgeDeadLetters500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot purge dead letters: %v", err)},
+		}, nil
+	}
+
+	return api.AdminPurgeDeadLetters200JSONResponse{Purged: purged}, nil
+}
+
+func parseInvocationIds(ids []string) ([]int64, error) {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid invocation id: %s", id)
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package admin_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestDeadLetterManageWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+	querier := dbsqlc.New()
+
+	setup := func(t *testing.T) (*pgxpool.Pool, []string, string) {
+		dbPool := testhelper.TestDB(ctx, t)
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+		fixture.InsertActor(t, ctx, dbPool, "actor2")
+
+		discard := func(actor string, finalizedAt int64) string {
+			id := fixture.InsertInvocation(t, ctx, dbPool, "available", `{"n":1}`, actor)
+			_, err := dbPool.Exec(ctx, `UPDATE invocations SET state = 'discarded', finalized_at = $2, errors = '{"message":"boom"}' WHERE id = $1`, id, finalizedAt)
+			require.NoError(t, err)
+			return strconv.FormatInt(id, 10)
+		}
+		discarded := []string{discard("actor1", 1000), discard("actor1", 2000), discard("actor2", 3000)}
+		available := strconv.FormatInt(fixture.InsertInvocation(t, ctx, dbPool, "available", `{}`, "actor1"), 10)
+		return dbPool, discarded, available
+	}
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+		dbPool, discarded, _ := setup(t)
+		defer dbPool.Close()
+
+		response, err := admin.ListDeadLetters(ctx, logger, dbPool, api.AdminListDeadLettersRequestObject{
+			Params: api.AdminListDeadLettersParams{Actor: lo.ToPtr("actor1")},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListDeadLetters200JSONResponse{}, response)
+		list := response.(api.AdminListDeadLetters200JSONResponse)
+		assert.Equal(t, []string{discarded[1], discarded[0]}, lo.Map(list.Data, func(item api.AdminInvocation, _ int) string { return item.Id }))
+		assert.Equal(t, map[string]interface{}{"message": "boom"}, *list.Data[0].Errors)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		t.Parallel()
+		dbPool, discarded, available := setup(t)
+		defer dbPool.Close()
+
+		response, err := admin.ReplayDeadLetters(ctx, logger, dbPool, api.AdminReplayDeadLettersRequestObject{
+			Body: &api.AdminReplayDeadLettersJSONRequestBody{
+				Ids:     []string{discarded[0], discarded[2], available},
+				Payload: &map[string]interface{}{"n": 2},
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminReplayDeadLetters200JSONResponse{}, response)
+		replays := response.(api.AdminReplayDeadLetters200JSONResponse).Data
+		require.Len(t, replays, 2)
+		assert.Equal(t, discarded[0], replays[0].ReplayedFrom)
+		assert.Equal(t, discarded[2], replays[1].ReplayedFrom)
+		assert.Equal(t, []string{available}, response.(api.AdminReplayDeadLetters200JSONResponse).Skipped)
+
+		id, err := strconv.ParseInt(replays[0].Id, 10, 64)
+		require.NoError(t, err)
+		replay, err := querier.InvocationFindById(ctx, dbPool, id)
+		require.NoError(t, err)
+		assert.Equal(t, dbsqlc.InvocationStateAvailable, replay.State)
+		assert.JSONEq(t, `{"n":2}`, string(replay.Payload))
+		assert.JSONEq(t, `{"kind":"test","trace_id":"123"}`, string(replay.Metadata))
+		assert.Equal(t, discarded[0], strconv.FormatInt(*replay.ReplayedFrom, 10))
+
+		// the replayed dead letters are not replayed again
+		response, err = admin.ReplayDeadLetters(ctx, logger, dbPool, api.AdminReplayDeadLettersRequestObject{
+			Body: &api.AdminReplayDeadLettersJSONRequestBody{Ids: []string{discarded[0], discarded[1], "999999"}},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminReplayDeadLetters200JSONResponse{}, response)
+		replays = response.(api.AdminReplayDeadLetters200JSONResponse).Data
+		require.Len(t, replays, 1)
+		assert.Equal(t, discarded[1], replays[0].ReplayedFrom)
+		assert.Equal(t, []string{discarded[0], "999999"}, response.(api.AdminReplayDeadLetters200JSONResponse).Skipped)
+	})
+
+	t.Run("Replay with invalid id", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		response, err := admin.ReplayDeadLetters(ctx, logger, dbPool, api.AdminReplayDeadLettersRequestObject{
+			Body: &api.AdminReplayDeadLettersJSONRequestBody{Ids: []string{"abc"}},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminReplayDeadLetters400JSONResponse{}, response)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Parallel()
+		dbPool, discarded, _ := setup(t)
+		defer dbPool.Close()
+
+		response, err := admin.PurgeDeadLetters(ctx, logger, dbPool, api.AdminPurgeDeadLettersRequestObject{
+			Body: &api.AdminPurgeDeadLettersJSONRequestBody{},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminPurgeDeadLetters400JSONResponse{}, response)
+
+		response, err = admin.PurgeDeadLetters(ctx, logger, dbPool, api.AdminPurgeDeadLettersRequestObject{
+			Body: &api.AdminPurgeDeadLettersJSONRequestBody{Actor: lo.ToPtr("actor1"), FinalizedBefore: lo.ToPtr(int64(1500))},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminPurgeDeadLetters200JSONResponse{}, response)
+		assert.EqualValues(t, 1, response.(api.AdminPurgeDeadLetters200JSONResponse).Purged)
+
+		response, err = admin.PurgeDeadLetters(ctx, logger, dbPool, api.AdminPurgeDeadLettersRequestObject{
+			Body: &api.AdminPurgeDeadLettersJSONRequestBody{Ids: &[]string{discarded[1], discarded[2]}},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminPurgeDeadLetters200JSONResponse{}, response)
+		assert.EqualValues(t, 2, response.(api.AdminPurgeDeadLetters200JSONResponse).Purged)
+	})
+}
@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+)
+
+var errInvalidCursor = errors.New("Invalid cursor")
+
+var invocationStates = []dbsqlc.InvocationState{
+	dbsqlc.InvocationStateAvailable,
+	dbsqlc.InvocationStateRunning,
+	dbsqlc.InvocationStateCompleted,
+	dbsqlc.InvocationStateCancelled,
+	dbsqlc.InvocationStateDiscarded,
+}
+
+func ListInvocations(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListInvocationsRequestObject) (api.AdminListInvocationsResponseObject, error) {
+	logger.Info("ListInvocations", "params", request.Params)
+
+	state := dbsqlc.NullInvocationState{}
+	if request.Params.State != nil {
+		if err := state.Scan(string(*request.Params.State)); err != nil || !lo.Contains(invocationStates, state.InvocationState) {
+			return api.AdminListInvocations400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Invalid state: %s", *request.Params.State)},
+			}, nil
+		}
+	}
+
+	// kind and trace_id are matched by containment so that the metadata index is used
+	var metadata []byte
+	metaFilter := map[string]string{}
+	if request.Params.Kind != nil {
+		metaFilter["kind"] = *request.Params.Kind
+	}
+	if request.Params.TraceId != nil {
+		metaFilter["trace_id"] = *request.Params.TraceId
+	}
+	if len(metaFilter) > 0 {
+		metadata, _ = json.Marshal(metaFilter)
+	}
+
+	data, nextCursor, err := listInvocationPage(ctx, ds, request.Params.Cursor, request.Params.PageSize, &dbsqlc.InvocationListPaginatedParams{
+		Actor:           request.Params.Actor,
+		QueueID:         request.Params.QueueId,
+		State:           state,
+		Tags:            lo.FromPtr(request.Params.Tag),
+		Metadata:        metadata,
+		CreatedAfter:    request.Params.CreatedAfter,
+		CreatedBefore:   request.Params.CreatedBefore,
+		FinalizedAfter:  request.Params.FinalizedAfter,
+		FinalizedBefore: request.Params.FinalizedBefore,
+	})
+	if err != nil {
+		if err == errInvalidCursor {
+			return api.AdminListInvocations400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+			}, nil
+		}
+		logger.Error("Cannot list invocations", "error", err)
+		return api.AdminListInvocations500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list invocations: %v", err)},
+		}, nil
+	}
+
+	response := api.AdminListInvocations200JSONResponse{Data: data}
+	response.Meta.NextCursor = nextCursor
+	return response, nil
+}
+
+// listInvocationPage lists the invocations matching the filters of params, starting after the given cursor. It returns
+// the cursor of the next page, or nil on the last page.
+func listInvocationPage(ctx context.Context, ds dbaccess.DataSource, cursor *string, pageSize *int, params *dbsqlc.InvocationListPaginatedParams) ([]api.AdminInvocation, *string, error) {
+	pageSizePtr, _ := lo.Coalesce[*int](pageSize, &defaultPageSize)
+	size := lo.Clamp(*pageSizePtr, 1, 100)
+
+	if cursor != nil {
+		id, err := strconv.ParseInt(*cursor, 10, 64)
+		if err != nil {
+			return nil, nil, errInvalidCursor
+		}
+		params.Cursor = &id
+	}
+
+	// one more row is fetched to tell whether there is a next page
+	params.PageSize = int64(size + 1)
+	res, err := querier.InvocationListPaginated(ctx, ds, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextCursor *string
+	if len(res) > size {
+		res = res[:size]
+		nextCursor = lo.ToPtr(strconv.FormatInt(res[len(res)-1].Invocation.ID, 10))
+	}
+
+	data := make([]api.AdminInvocation, 0, len(res))
+	for _, row := range res {
+		invocation, err := toApiAdminInvocation(&row.Invocation, row.ActorName)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = append(data, invocation)
+	}
+	return data, nextCursor, nil
+}
+
+func toApiAdminInvocation(invocation *dbsqlc.Invocation, actorName *string) (api.AdminInvocation, error) {
+	result := api.AdminInvocation{
+		Id:          strconv.FormatInt(invocation.ID, 10),
+		Actor:       actorName,
+		QueueId:     invocation.QueueID,
+		State:       api.InvocationState(invocation.State),
+		Priority:    int(invocation.Priority),
+		Tags:        lo.Ternary(invocation.Tags == nil, []string{}, invocation.Tags),
+		Attempts:    len(invocation.AttemptedBy),
+		MaxAttempts: int(invocation.MaxAttempts),
+		CreatedBy:   invocation.CreatedBy,
+		CreatedAt:   invocation.CreatedAt,
+		ScheduledAt: invocation.ScheduledAt,
+		AttemptedAt: invocation.AttemptedAt,
+		FinalizedAt: invocation.FinalizedAt,
+	}
+	if invocation.ReplayedFrom != nil {
+		result.ReplayedFrom = lo.ToPtr(strconv.FormatInt(*invocation.ReplayedFrom, 10))
+	}
+
+	if err := json.Unmarshal(invocation.Metadata, &result.Meta); err != nil {
+		return result, fmt.Errorf("invalid meta of invocation %d: %w", invocation.ID, err)
+	}
+	if err := json.Unmarshal(invocation.Payload, &result.Payload); err != nil {
+		return result, fmt.Errorf("invalid payload of invocation %d: %w", invocation.ID, err)
+	}
+	if invocation.Result != nil {
+		if err := json.Unmarshal(invocation.Result, &result.Result); err != nil {
+			return result, fmt.Errorf("invalid result of invocation %d: %w", invocation.ID, err)
+		}
+	}
+	if invocation.Errors != nil {
+		if err := json.Unmarshal(invocation.Errors, &result.Errors); err != nil {
+			return result, fmt.Errorf("invalid errors of invocation %d: %w", invocation.ID, err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package admin_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestListInvocationsWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+	querier := dbsqlc.New()
+
+	dbPool := testhelper.TestDB(ctx, t)
+	defer dbPool.Close()
+
+	fixture.InsertActor(t, ctx, dbPool, "actor1")
+	fixture.InsertActor(t, ctx, dbPool, "actor2")
+
+	insert := func(actor string, createdAt int64, meta string, tags []string) int64 {
+		invocation, err := querier.InvocationInsert(ctx, dbPool, &dbsqlc.InvocationInsertParams{
+			ActorName: actor,
+			State:     dbsqlc.InvocationStateAvailable,
+			CreatedAt: createdAt,
+			Payload:   []byte(`{}`),
+			Metadata:  []byte(meta),
+			Tags:      tags,
+		})
+		require.NoError(t, err)
+		return invocation.ID
+	}
+
+	id1 := insert("actor1", 1000, `{"kind":"report","trace_id":"t1"}`, []string{"daily", "finance"})
+	id2 := insert("actor1", 2000, `{"kind":"chat","trace_id":"t2"}`, []string{"daily"})
+	id3 := insert("actor2", 3000, `{"kind":"report","trace_id":"t3"}`, nil)
+
+	list := func(params api.AdminListInvocationsParams) api.AdminListInvocations200JSONResponse {
+		response, err := admin.ListInvocations(ctx, logger, dbPool, api.AdminListInvocationsRequestObject{Params: params})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListInvocations200JSONResponse{}, response)
+		return response.(api.AdminListInvocations200JSONResponse)
+	}
+	ids := func(response api.AdminListInvocations200JSONResponse) []string {
+		return lo.Map(response.Data, func(item api.AdminInvocation, _ int) string { return item.Id })
+	}
+	str := func(id int64) string { return strconv.FormatInt(id, 10) }
+
+	t.Run("Without filters", func(t *testing.T) {
+		response := list(api.AdminListInvocationsParams{})
+		assert.Equal(t, []string{str(id3), str(id2), str(id1)}, ids(response))
+		assert.Nil(t, response.Meta.NextCursor)
+		assert.Equal(t, "actor2", *response.Data[0].Actor)
+		assert.Equal(t, []string{"daily", "finance"}, response.Data[2].Tags)
+	})
+
+	t.Run("Cursor pagination", func(t *testing.T) {
+		response := list(api.AdminListInvocationsParams{PageSize: lo.ToPtr(2)})
+		assert.Equal(t, []string{str(id3), str(id2)}, ids(response))
+		require.NotNil(t, response.Meta.NextCursor)
+
+		response = list(api.AdminListInvocationsParams{PageSize: lo.ToPtr(2), Cursor: response.Meta.NextCursor})
+		assert.Equal(t, []string{str(id1)}, ids(response))
+		assert.Nil(t, response.Meta.NextCursor)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		assert.Equal(t, []string{str(id2), str(id1)}, ids(list(api.AdminListInvocationsParams{Actor: lo.ToPtr("actor1")})))
+		assert.Equal(t, []string{str(id3), str(id1)}, ids(list(api.AdminListInvocationsParams{Kind: lo.ToPtr("report")})))
+		assert.Equal(t, []string{str(id2)}, ids(list(api.AdminListInvocationsParams{TraceId: lo.ToPtr("t2")})))
+		assert.Equal(t, []string{str(id1)}, ids(list(api.AdminListInvocationsParams{Tag: &[]string{"daily", "finance"}})))
+		assert.Equal(t, []string{str(id2)}, ids(list(api.AdminListInvocationsParams{CreatedAfter: lo.ToPtr(int64(1500)), CreatedBefore: lo.ToPtr(int64(3000))})))
+		assert.Empty(t, ids(list(api.AdminListInvocationsParams{State: lo.ToPtr(api.InvocationStateRunning)})))
+		assert.Empty(t, ids(list(api.AdminListInvocationsParams{FinalizedAfter: lo.ToPtr(int64(0))})))
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		response, err := admin.ListInvocations(ctx, logger, dbPool, api.AdminListInvocationsRequestObject{
+			Params: api.AdminListInvocationsParams{Cursor: lo.ToPtr("abc")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminListInvocations400JSONResponse{}, response)
+	})
+
+	t.Run("Invalid state", func(t *testing.T) {
+		response, err := admin.ListInvocations(ctx, logger, dbPool, api.AdminListInvocationsRequestObject{
+			Params: api.AdminListInvocationsParams{State: lo.ToPtr(api.InvocationState("done"))},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminListInvocations400JSONResponse{}, response)
+	})
+}
@@ -0,0 +1,171 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func PauseActorQueue(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminPauseActorQueueRequestObject) (api.AdminPauseActorQueueResponseObject, error) {
+	logger.Info("PauseActorQueue", "id", request.Id, "request", request.Body)
+
+	rejectInvocations := false
+	if request.Body != nil {
+		rejectInvocations = lo.FromPtr(request.Body.RejectInvocations)
+	}
+
+	queue, err := querier.QueuePauseByActorId(ctx, ds, &dbsqlc.QueuePauseByActorIdParams{
+		ActorId:           request.Id,
+		RejectInvocations: rejectInvocations,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminPauseActorQueue404Response{}, nil
+		}
+		logger.Error("Cannot pause queue", "error", err)
+		return api.AdminPauseActorQueue500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot pause queue: %v", err)},
+		}, nil
+	}
+
+	return api.AdminPauseActorQueue200JSONResponse(toApiQueuePauseState(queue)), nil
+}
+
+func ResumeActorQueue(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminResumeActorQueueRequestObject) (api.AdminResumeActorQueueResponseObject, error) {
+	logger.Info("ResumeActorQueue", "id", request.Id)
+
+	queue, err := querier.QueueResumeByActorId(ctx, ds, request.Id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminResumeActorQueue404Response{}, nil
+		}
+		logger.Error("Cannot resume queue", "error", err)
+		return api.AdminResumeActorQueue500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot resume queue: %v", err)},
+		}, nil
+	}
+
+	// wake up the actors waiting on the queue for the invocations held while it was paused
+	if err := invocation.NotifyQueues(ctx, ds, []int64{queue.ID}); err != nil {
+		logger.Error("Cannot notify resumed queue", "error", err)
+	}
+
+	return api.AdminResumeActorQueue200JSONResponse(toApiQueuePauseState(queue)), nil
+}
+
+func ListQueueStats(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListQueueStatsRequestObject) (api.AdminListQueueStatsResponseObject, error) {
+	logger.Info("ListQueueStats", "params", request.Params)
+
+	window := lo.FromPtr(request.Params.Window)
+	if request.Params.Window == nil {
+		window = 3600
+	}
+	if window <= 0 {
+		return api.AdminListQueueStats400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "window must be positive"},
+		}, nil
+	}
+
+	data, err := listQueueStats(ctx, ds, time.Now(), time.Duration(window)*time.Second)
+	if err != nil {
+		logger.Error("Cannot list queue stats", "error", err)
+		return api.AdminListQueueStats500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list queue stats: %v", err)},
+		}, nil
+	}
+
+	return api.AdminListQueueStats200JSONResponse{Data: data}, nil
+}
+
+func listQueueStats(ctx context.Context, ds dbaccess.DataSource, now time.Time, window time.Duration) ([]api.QueueStats, error) {
+	queues, err := querier.QueueListWithActorName(ctx, ds)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := querier.InvocationCountByQueueAndState(ctx, ds, now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	since := now.Add(-window).Unix()
+	finalizedCounts, err := querier.InvocationCountFinalizedByQueueAndState(ctx, ds, since)
+	if err != nil {
+		return nil, err
+	}
+	waitTimes, err := querier.InvocationWaitTimePercentiles(ctx, ds, since)
+	if err != nil {
+		return nil, err
+	}
+	runTimes, err := querier.InvocationRunTimePercentiles(ctx, ds, since)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]api.QueueStats, 0, len(queues))
+	stats := make(map[int64]*api.QueueStats, len(queues))
+	for _, queue := range queues {
+		data = append(data, api.QueueStats{
+			QueueId:  queue.ID,
+			Name:     queue.Name,
+			Actor:    queue.ActorName,
+			PausedAt: queue.PausedAt,
+		})
+		stats[queue.ID] = &data[len(data)-1]
+	}
+
+	for _, count := range counts {
+		stat, ok := stats[count.QueueID]
+		if !ok {
+			continue
+		}
+		switch count.State {
+		case dbsqlc.InvocationStateAvailable:
+			stat.Counts.Available = count.Count
+			if count.OldestScheduledAt != nil {
+				stat.OldestAvailableAge = lo.ToPtr(max(now.Unix()-*count.OldestScheduledAt, 0))
+			}
+		case dbsqlc.InvocationStateRunning:
+			stat.Counts.Running = count.Count
+		}
+	}
+	for _, count := range finalizedCounts {
+		stat, ok := stats[count.QueueID]
+		if !ok {
+			continue
+		}
+		switch count.State {
+		case dbsqlc.InvocationStateCompleted:
+			stat.Counts.Completed = count.Count
+		case dbsqlc.InvocationStateCancelled:
+			stat.Counts.Cancelled = count.Count
+		case dbsqlc.InvocationStateDiscarded:
+			stat.Counts.Discarded = count.Count
+		}
+	}
+	for _, waitTime := range waitTimes {
+		if stat, ok := stats[waitTime.QueueID]; ok {
+			stat.WaitTime = &api.DurationPercentiles{P50: waitTime.P50, P95: waitTime.P95}
+		}
+	}
+	for _, runTime := range runTimes {
+		if stat, ok := stats[runTime.QueueID]; ok {
+			stat.RunTime = &api.DurationPercentiles{P50: runTime.P50, P95: runTime.P95}
+		}
+	}
+	return data, nil
+}
+
+func toApiQueuePauseState(queue *dbsqlc.Queue) api.QueuePauseState {
+	return api.QueuePauseState{
+		QueueId:           queue.ID,
+		PausedAt:          queue.PausedAt,
+		RejectInvocations: queue.RejectInvocationsWhenPaused,
+	}
+}
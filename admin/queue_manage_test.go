@@ -0,0 +1,81 @@
+package admin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestListQueueStatsWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+
+	dbPool := testhelper.TestDB(ctx, t)
+	defer dbPool.Close()
+
+	actor1 := fixture.InsertActor(t, ctx, dbPool, "actor1")
+	actor2 := fixture.InsertActor(t, ctx, dbPool, "actor2")
+
+	now := time.Now().Unix()
+	insert := func(actor string, state string, createdAt int64, attemptedAt, finalizedAt *int64) {
+		id := fixture.InsertInvocation(t, ctx, dbPool, "available", `{}`, actor)
+		_, err := dbPool.Exec(ctx,
+			`UPDATE invocations SET state = $2, created_at = $3, scheduled_at = $3, attempted_at = $4, finalized_at = $5 WHERE id = $1`,
+			id, state, createdAt, attemptedAt, finalizedAt)
+		require.NoError(t, err)
+	}
+	insert("actor1", "available", now-100, nil, nil)
+	insert("actor1", "available", now-50, nil, nil)
+	// not due yet
+	insert("actor1", "available", now+100, nil, nil)
+	insert("actor1", "running", now-30, lo.ToPtr(now-20), nil)
+	insert("actor1", "completed", now-60, lo.ToPtr(now-50), lo.ToPtr(now-40))
+	insert("actor1", "discarded", now-100, lo.ToPtr(now-70), lo.ToPtr(now-40))
+	// outside of the window
+	insert("actor1", "completed", now-10000, lo.ToPtr(now-9000), lo.ToPtr(now-8000))
+
+	t.Run("Invalid window", func(t *testing.T) {
+		response, err := admin.ListQueueStats(ctx, logger, dbPool, api.AdminListQueueStatsRequestObject{
+			Params: api.AdminListQueueStatsParams{Window: lo.ToPtr(0)},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListQueueStats400JSONResponse{}, response)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		response, err := admin.ListQueueStats(ctx, logger, dbPool, api.AdminListQueueStatsRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListQueueStats200JSONResponse{}, response)
+		data := response.(api.AdminListQueueStats200JSONResponse).Data
+		require.Len(t, data, 2)
+
+		stats := data[0]
+		assert.Equal(t, actor1.QueueID, stats.QueueId)
+		assert.Equal(t, "actor1", *stats.Actor)
+		assert.Equal(t, api.InvocationStateCounts{Available: 3, Running: 1, Completed: 1, Discarded: 1}, stats.Counts)
+		require.NotNil(t, stats.OldestAvailableAge)
+		assert.InDelta(t, 100, *stats.OldestAvailableAge, 2)
+		require.NotNil(t, stats.WaitTime)
+		assert.Equal(t, 10.0, stats.WaitTime.P50)
+		assert.InDelta(t, 28.0, stats.WaitTime.P95, 0.001)
+		require.NotNil(t, stats.RunTime)
+		assert.Equal(t, 20.0, stats.RunTime.P50)
+		assert.InDelta(t, 29.0, stats.RunTime.P95, 0.001)
+
+		empty := data[1]
+		assert.Equal(t, actor2.QueueID, empty.QueueId)
+		assert.Equal(t, api.InvocationStateCounts{}, empty.Counts)
+		assert.Nil(t, empty.OldestAvailableAge)
+		assert.Nil(t, empty.WaitTime)
+		assert.Nil(t, empty.RunTime)
+	})
+}
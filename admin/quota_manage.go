@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/usage"
+)
+
+func ListActorQuotas(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListActorQuotasRequestObject) (api.AdminListActorQuotasResponseObject, error) {
+	logger.Info("ListActorQuotas", "id", request.Id)
+
+	if _, err := querier.ActorFindById(ctx, ds, request.Id); err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminListActorQuotas404Response{}, nil
+		}
+		logger.Error("Cannot get actor", "error", err)
+		return api.AdminListActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get actor: %v", err)},
+		}, nil
+	}
+
+	quotas, err := querier.ActorQuotaListByActorId(ctx, ds, request.Id)
+	if err != nil {
+		logger.Error("Cannot list quotas", "error", err)
+		return api.AdminListActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list quotas: %v", err)},
+		}, nil
+	}
+
+	data, err := toApiActorQuotas(ctx, ds, quotas, time.Now())
+	if err != nil {
+		logger.Error("Cannot get quota usage", "error", err)
+		return api.AdminListActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get quota usage: %v", err)},
+		}, nil
+	}
+	return api.AdminListActorQuotas200JSONResponse{Data: data}, nil
+}
+
+func SetActorQuotas(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminSetActorQuotasRequestObject) (api.AdminSetActorQuotasResponseObject, error) {
+	logger.Info("SetActorQuotas", "id", request.Id, "request", request.Body)
+
+	periods := make(map[api.ActorQuotaSetPeriod]bool)
+	for _, quota := range request.Body.Quotas {
+		if quota.Period != api.ActorQuotaSetPeriodDaily && quota.Period != api.ActorQuotaSetPeriodMonthly {
+			return api.AdminSetActorQuotas400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Invalid period: %s", quota.Period)},
+			}, nil
+		}
+		if periods[quota.Period] {
+			return api.AdminSetActorQuotas400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Duplicate period: %s", quota.Period)},
+			}, nil
+		}
+		periods[quota.Period] = true
+		if lo.FromPtr(quota.MaxTokens) < 0 || lo.FromPtr(quota.MaxCost) < 0 {
+			return api.AdminSetActorQuotas400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "Quota limits must not be negative"},
+			}, nil
+		}
+	}
+
+	if _, err := querier.ActorFindById(ctx, ds, request.Id); err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminSetActorQuotas404Response{}, nil
+		}
+		logger.Error("Cannot get actor", "error", err)
+		return api.AdminSetActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get actor: %v", err)},
+		}, nil
+	}
+
+	tx, err := ds.Begin(ctx)
+	if err != nil {
+		logger.Error("Cannot start transaction", "error", err)
+		return api.AdminSetActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot start transaction: %v", err)},
+		}, nil
+	}
+	defer tx.Rollback(ctx)
+
+	if err := querier.ActorQuotaDeleteByActorId(ctx, tx, request.Id); err != nil {
+		logger.Error("Cannot delete quotas", "error", err)
+		return api.AdminSetActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot set quotas: %v", err)},
+		}, nil
+	}
+	quotas := make([]*dbsqlc.ActorQuota, 0, len(request.Body.Quotas))
+	for _, quota := range request.Body.Quotas {
+		inserted, err := querier.ActorQuotaInsert(ctx, tx, &dbsqlc.ActorQuotaInsertParams{
+			ActorId:   request.Id,
+			Period:    string(quota.Period),
+			MaxTokens: quota.MaxTokens,
+			MaxCost:   quota.MaxCost,
+		})
+		if err != nil {
+			logger.Error("Cannot insert quota", "error", err)
+			return api.AdminSetActorQuotas500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot set quotas: %v", err)},
+			}, nil
+		}
+		quotas = append(quotas, inserted)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Cannot commit transaction", "error", err)
+		return api.AdminSetActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot set quotas: %v", err)},
+		}, nil
+	}
+
+	data, err := toApiActorQuotas(ctx, ds, quotas, time.Now())
+	if err != nil {
+		logger.Error("Cannot get quota usage", "error", err)
+		return api.AdminSetActorQuotas500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get quota usage: %v", err)},
+		}, nil
+	}
+	return api.AdminSetActorQuotas200JSONResponse{Data: data}, nil
+}
+
+func ListTokenUsages(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListTokenUsagesRequestObject) (api.AdminListTokenUsagesResponseObject, error) {
+	logger.Info("ListTokenUsages", "params", request.Params)
+
+	page, _ := lo.Coalesce[*int](request.Params.Page, &defaultPage)
+	pageSize, _ := lo.Coalesce[*int](request.Params.PageSize, &defaultPageSize)
+	res, err := querier.TokenUsageListPaginated(ctx, ds, &dbsqlc.TokenUsageListPaginatedParams{
+		ActorId:  request.Params.ActorId,
+		FromDay:  request.Params.From,
+		ToDay:    request.Params.To,
+		Page:     int64(*page),
+		PageSize: int64(*pageSize),
+	})
+	if err != nil {
+		logger.Error("Cannot list token usages", "error", err)
+		return api.AdminListTokenUsages500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list token usages: %v", err)},
+		}, nil
+	}
+
+	data := lo.Map(res, func(row *dbsqlc.TokenUsageListPaginatedRow, _ int) api.TokenUsage {
+		return api.TokenUsage{
+			ApiTokenId:   row.TokenUsage.ApiTokenID,
+			ActorId:      row.TokenUsage.ActorId,
+			ModelId:      row.TokenUsage.ModelID,
+			Day:          row.TokenUsage.Day,
+			Requests:     row.TokenUsage.Requests,
+			InputTokens:  row.TokenUsage.InputTokens,
+			OutputTokens: row.TokenUsage.OutputTokens,
+			Cost:         row.TokenUsage.Cost,
+		}
+	})
+
+	response := api.AdminListTokenUsages200JSONResponse{Data: data}
+	if len(res) > 0 {
+		response.Meta.TotalPages = int((res[0].TotalCount + int64(*pageSize) - 1) / int64(*pageSize))
+	}
+	return response, nil
+}
+
+// toApiActorQuotas converts the quotas along with their usage in their periods containing now.
+func toApiActorQuotas(ctx context.Context, ds dbaccess.DataSource, quotas []*dbsqlc.ActorQuota, now time.Time) ([]api.ActorQuota, error) {
+	data := make([]api.ActorQuota, 0, len(quotas))
+	for _, quota := range quotas {
+		tokens, cost, err := usage.Used(ctx, ds, quota.ActorId, quota.Period, now)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, api.ActorQuota{
+			Period:     api.ActorQuotaPeriod(quota.Period),
+			MaxTokens:  quota.MaxTokens,
+			MaxCost:    quota.MaxCost,
+			UsedTokens: tokens,
+			UsedCost:   cost,
+		})
+	}
+	return data, nil
+}
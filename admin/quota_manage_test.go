@@ -0,0 +1,173 @@
+package admin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/usage"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+func TestActorQuotasWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+
+	dbPool := testhelper.TestDB(ctx, t)
+	defer dbPool.Close()
+
+	actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+	err := usage.Record(ctx, dbPool, usage.Entry{
+		ApiTokenId: "token1",
+		ActorId:    actor.ID,
+		ModelId:    "model1",
+		Usage:      llm.CompletionUsage{InputTokens: 100, OutputTokens: 50},
+		Cost:       0.5,
+	}, time.Now())
+	require.NoError(t, err)
+
+	setQuotas := func(id int64, quotas ...api.ActorQuotaSet) (api.AdminSetActorQuotasResponseObject, error) {
+		return admin.SetActorQuotas(ctx, logger, dbPool, api.AdminSetActorQuotasRequestObject{
+			Id:   id,
+			Body: &api.AdminSetActorQuotasJSONRequestBody{Quotas: quotas},
+		})
+	}
+
+	t.Run("Unknown actor", func(t *testing.T) {
+		response, err := admin.ListActorQuotas(ctx, logger, dbPool, api.AdminListActorQuotasRequestObject{Id: 999999})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListActorQuotas404Response{}, response)
+
+		setResponse, err := setQuotas(999999)
+		require.NoError(t, err)
+		require.IsType(t, api.AdminSetActorQuotas404Response{}, setResponse)
+	})
+
+	t.Run("Duplicate period", func(t *testing.T) {
+		response, err := setQuotas(actor.ID,
+			api.ActorQuotaSet{Period: api.ActorQuotaSetPeriodDaily, MaxTokens: lo.ToPtr(int64(1000))},
+			api.ActorQuotaSet{Period: api.ActorQuotaSetPeriodDaily, MaxCost: lo.ToPtr(1.0)},
+		)
+		require.NoError(t, err)
+		require.IsType(t, api.AdminSetActorQuotas400JSONResponse{}, response)
+	})
+
+	t.Run("Negative limit", func(t *testing.T) {
+		response, err := setQuotas(actor.ID,
+			api.ActorQuotaSet{Period: api.ActorQuotaSetPeriodDaily, MaxTokens: lo.ToPtr(int64(-1))},
+		)
+		require.NoError(t, err)
+		require.IsType(t, api.AdminSetActorQuotas400JSONResponse{}, response)
+	})
+
+	t.Run("Set and list", func(t *testing.T) {
+		response, err := setQuotas(actor.ID,
+			api.ActorQuotaSet{Period: api.ActorQuotaSetPeriodDaily, MaxTokens: lo.ToPtr(int64(1000))},
+			api.ActorQuotaSet{Period: api.ActorQuotaSetPeriodMonthly, MaxCost: lo.ToPtr(10.0)},
+		)
+		require.NoError(t, err)
+		require.IsType(t, api.AdminSetActorQuotas200JSONResponse{}, response)
+		require.Len(t, response.(api.AdminSetActorQuotas200JSONResponse).Data, 2)
+
+		listResponse, err := admin.ListActorQuotas(ctx, logger, dbPool, api.AdminListActorQuotasRequestObject{Id: actor.ID})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListActorQuotas200JSONResponse{}, listResponse)
+		data := listResponse.(api.AdminListActorQuotas200JSONResponse).Data
+		require.Len(t, data, 2)
+		assert.Equal(t, api.ActorQuota{
+			Period:     api.ActorQuotaPeriodDaily,
+			MaxTokens:  lo.ToPtr(int64(1000)),
+			UsedTokens: 150,
+			UsedCost:   0.5,
+		}, data[0])
+		assert.Equal(t, api.ActorQuota{
+			Period:     api.ActorQuotaPeriodMonthly,
+			MaxCost:    lo.ToPtr(10.0),
+			UsedTokens: 150,
+			UsedCost:   0.5,
+		}, data[1])
+	})
+
+	t.Run("Replace with no quotas", func(t *testing.T) {
+		response, err := setQuotas(actor.ID)
+		require.NoError(t, err)
+		require.IsType(t, api.AdminSetActorQuotas200JSONResponse{}, response)
+		assert.Empty(t, response.(api.AdminSetActorQuotas200JSONResponse).Data)
+
+		listResponse, err := admin.ListActorQuotas(ctx, logger, dbPool, api.AdminListActorQuotasRequestObject{Id: actor.ID})
+		require.NoError(t, err)
+		assert.Empty(t, listResponse.(api.AdminListActorQuotas200JSONResponse).Data)
+	})
+}
+
+func TestListTokenUsagesWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+
+	dbPool := testhelper.TestDB(ctx, t)
+	defer dbPool.Close()
+
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	record := func(tokenId string, actorId int64, at time.Time) {
+		err := usage.Record(ctx, dbPool, usage.Entry{
+			ApiTokenId: tokenId,
+			ActorId:    actorId,
+			ModelId:    "model1",
+			Usage:      llm.CompletionUsage{InputTokens: 10, OutputTokens: 5},
+			Cost:       0.1,
+		}, at)
+		require.NoError(t, err)
+	}
+	record("token1", 1, now)
+	record("token1", 1, now)
+	record("token1", 1, yesterday)
+	record("token2", 2, now)
+
+	t.Run("List all", func(t *testing.T) {
+		response, err := admin.ListTokenUsages(ctx, logger, dbPool, api.AdminListTokenUsagesRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListTokenUsages200JSONResponse{}, response)
+		data := response.(api.AdminListTokenUsages200JSONResponse)
+		require.Len(t, data.Data, 3)
+		assert.Equal(t, 1, data.Meta.TotalPages)
+	})
+
+	t.Run("Filter by actor and day", func(t *testing.T) {
+		response, err := admin.ListTokenUsages(ctx, logger, dbPool, api.AdminListTokenUsagesRequestObject{
+			Params: api.AdminListTokenUsagesParams{ActorId: lo.ToPtr(int64(1)), From: lo.ToPtr(usage.Day(now))},
+		})
+		require.NoError(t, err)
+		data := response.(api.AdminListTokenUsages200JSONResponse).Data
+		require.Len(t, data, 1)
+		assert.Equal(t, api.TokenUsage{
+			ApiTokenId:   "token1",
+			ActorId:      1,
+			ModelId:      "model1",
+			Day:          usage.Day(now),
+			Requests:     2,
+			InputTokens:  20,
+			OutputTokens: 10,
+			Cost:         0.2,
+		}, data[0])
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		response, err := admin.ListTokenUsages(ctx, logger, dbPool, api.AdminListTokenUsagesRequestObject{
+			Params: api.AdminListTokenUsagesParams{Page: lo.ToPtr(1), PageSize: lo.ToPtr(2)},
+		})
+		require.NoError(t, err)
+		data := response.(api.AdminListTokenUsages200JSONResponse)
+		require.Len(t, data.Data, 2)
+		assert.Equal(t, 2, data.Meta.TotalPages)
+	})
+}
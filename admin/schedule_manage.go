@@ -0,0 +1,347 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func ListSchedules(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminListSchedulesRequestObject) (api.AdminListSchedulesResponseObject, error) {
+	logger.Info("ListSchedules", "request", request)
+
+	page, _ := lo.Coalesce[*int](request.Params.Page, &defaultPage)
+	pageSize, _ := lo.Coalesce[*int](request.Params.PageSize, &defaultPageSize)
+	res, err := querier.ScheduleListPaginated(ctx, ds, &dbsqlc.ScheduleListPaginatedParams{
+		Name:     request.Params.Name,
+		Page:     int64(*page),
+		PageSize: int64(*pageSize),
+	})
+	if err != nil {
+		logger.Error("Cannot list schedules", "error", err)
+		return api.AdminListSchedules500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list schedules: %v", err)},
+		}, nil
+	}
+
+	data := make([]api.Schedule, 0, len(res))
+	for _, row := range res {
+		schedule, err := toApiSchedule(&row.Schedule, row.ActorName)
+		if err != nil {
+			logger.Error("Cannot list schedules", "error", err)
+			return api.AdminListSchedules500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list schedules: %v", err)},
+			}, nil
+		}
+		data = append(data, schedule)
+	}
+
+	response := api.AdminListSchedules200JSONResponse{Data: data}
+	if len(res) > 0 {
+		response.Meta.TotalPages = int((res[0].TotalCount + int64(*pageSize) - 1) / int64(*pageSize))
+	}
+	return response, nil
+}
+
+func CreateSchedule(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminCreateScheduleRequestObject) (api.AdminCreateScheduleResponseObject, error) {
+	logger.Info("CreateSchedule", "request", request.Body)
+
+	if request.Body.Name == "" {
+		return api.AdminCreateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "Missing required field: name"},
+		}, nil
+	}
+	if request.Body.Actor == "" {
+		return api.AdminCreateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "Missing required field: actor"},
+		}, nil
+	}
+
+	enabled := lo.FromPtrOr(request.Body.Enabled, true)
+	nextRunAt, err := nextScheduleRunAt(request.Body.CronExpression, enabled)
+	if err != nil {
+		return api.AdminCreateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+
+	payload, err := fromApiScheduleTemplate("payload", lo.FromPtrOr(request.Body.Payload, map[string]interface{}{}))
+	if err != nil {
+		return api.AdminCreateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+	metadata, err := fromApiScheduleTemplate("meta", request.Body.Meta)
+	if err != nil {
+		return api.AdminCreateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+
+	actor, err := querier.ActorFindByName(ctx, ds, request.Body.Actor)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminCreateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
+			}, nil
+		}
+
+		logger.Error("Cannot create schedule", "error", err)
+		return api.AdminCreateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create schedule: %v", err)},
+		}, nil
+	}
+
+	schedule, err := querier.ScheduleInsert(ctx, ds, &dbsqlc.ScheduleInsertParams{
+		Name:           request.Body.Name,
+		ActorId:        actor.ID,
+		CronExpression: request.Body.CronExpression,
+		Payload:        payload,
+		Metadata:       metadata,
+		Enabled:        enabled,
+		NextRunAt:      nextRunAt,
+	})
+	if err != nil {
+		if dbaccess.IsForeignKeyViolation(err) {
+			return api.AdminCreateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
+			}, nil
+		}
+		if dbaccess.IsUniqueViolation(err) {
+			return api.AdminCreateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "schedule name already exists"},
+			}, nil
+		}
+
+		logger.Error("Cannot create schedule", "error", err)
+		return api.AdminCreateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create schedule: %v", err)},
+		}, nil
+	}
+
+	data, err := toApiSchedule(schedule, actor.Name)
+	if err != nil {
+		return api.AdminCreateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create schedule: %v", err)},
+		}, nil
+	}
+	return api.AdminCreateSchedule201JSONResponse(data), nil
+}
+
+func GetSchedule(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminGetScheduleRequestObject) (api.AdminGetScheduleResponseObject, error) {
+	logger.Info("GetSchedule", "scheduleId", request.Id)
+
+	row, err := querier.ScheduleFindById(ctx, ds, request.Id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminGetSchedule404Response{}, nil
+		}
+
+		logger.Error("Cannot get schedule", "error", err)
+		return api.AdminGetSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get schedule: %v", err)},
+		}, nil
+	}
+
+	data, err := toApiSchedule(&row.Schedule, row.ActorName)
+	if err != nil {
+		return api.AdminGetSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get schedule: %v", err)},
+		}, nil
+	}
+	return api.AdminGetSchedule200JSONResponse{Data: data}, nil
+}
+
+func UpdateSchedule(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminUpdateScheduleRequestObject) (api.AdminUpdateScheduleResponseObject, error) {
+	logger.Info("UpdateSchedule", "scheduleId", request.Id, "name", lo.FromPtrOr(request.Body.Name, "<nil>"))
+
+	if request.Body.Name != nil && *request.Body.Name == "" {
+		return api.AdminUpdateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: "name cannot be empty"},
+		}, nil
+	}
+
+	var payload, metadata []byte
+	var err error
+	if request.Body.Payload != nil {
+		payload, err = fromApiScheduleTemplate("payload", *request.Body.Payload)
+		if err != nil {
+			return api.AdminUpdateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+			}, nil
+		}
+	}
+	if request.Body.Meta != nil {
+		metadata, err = fromApiScheduleTemplate("meta", *request.Body.Meta)
+		if err != nil {
+			return api.AdminUpdateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+			}, nil
+		}
+	}
+
+	existing, err := querier.ScheduleFindById(ctx, ds, request.Id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminUpdateSchedule404Response{}, nil
+		}
+
+		logger.Error("Cannot update schedule", "error", err)
+		return api.AdminUpdateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot update schedule: %v", err)},
+		}, nil
+	}
+
+	// the next run is always computed again, so that a changed expression or a
+	// re-enabled schedule doesn't fire for a time in the past.
+	cronExpression := lo.FromPtrOr(request.Body.CronExpression, existing.Schedule.CronExpression)
+	enabled := lo.FromPtrOr(request.Body.Enabled, existing.Schedule.Enabled)
+	nextRunAt, err := nextScheduleRunAt(cronExpression, enabled)
+	if err != nil {
+		return api.AdminUpdateSchedule400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
+		}, nil
+	}
+
+	actorName := existing.ActorName
+	var actorId *int64
+	if request.Body.Actor != nil {
+		actor, err := querier.ActorFindByName(ctx, ds, *request.Body.Actor)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return api.AdminUpdateSchedule400JSONResponse{
+					N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
+				}, nil
+			}
+
+			logger.Error("Cannot update schedule", "error", err)
+			return api.AdminUpdateSchedule500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot update schedule: %v", err)},
+			}, nil
+		}
+		actorName = actor.Name
+		actorId = &actor.ID
+	}
+
+	schedule, err := querier.ScheduleUpdate(ctx, ds, &dbsqlc.ScheduleUpdateParams{
+		ID:             request.Id,
+		Name:           request.Body.Name,
+		ActorId:        actorId,
+		CronExpression: request.Body.CronExpression,
+		Payload:        payload,
+		Metadata:       metadata,
+		Enabled:        request.Body.Enabled,
+		NextRunAt:      nextRunAt,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.AdminUpdateSchedule404Response{}, nil
+		}
+		if dbaccess.IsForeignKeyViolation(err) {
+			return api.AdminUpdateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
+			}, nil
+		}
+		if dbaccess.IsUniqueViolation(err) {
+			return api.AdminUpdateSchedule400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: "schedule name already exists"},
+			}, nil
+		}
+
+		logger.Error("Cannot update schedule", "error", err)
+		return api.AdminUpdateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot update schedule: %v", err)},
+		}, nil
+	}
+
+	data, err := toApiSchedule(schedule, actorName)
+	if err != nil {
+		return api.AdminUpdateSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot update schedule: %v", err)},
+		}, nil
+	}
+	return api.AdminUpdateSchedule200JSONResponse{Data: data}, nil
+}
+
+func DeleteSchedule(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminDeleteScheduleRequestObject) (api.AdminDeleteScheduleResponseObject, error) {
+	logger.Info("DeleteSchedule", "scheduleId", request.Id)
+
+	deleted, err := querier.ScheduleDelete(ctx, ds, request.Id)
+	if err != nil {
+		logger.Error("Cannot delete schedule", "error", err)
+		return api.AdminDeleteSchedule500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot delete schedule: %v", err)},
+		}, nil
+	}
+
+	if deleted == 0 {
+		return api.AdminDeleteSchedule404Response{}, nil
+	}
+	return api.AdminDeleteSchedule200Response{}, nil
+}
+
+func toApiSchedule(schedule *dbsqlc.Schedule, actorName string) (api.Schedule, error) {
+	var payload, meta map[string]interface{}
+	if err := json.Unmarshal(schedule.Payload, &payload); err != nil {
+		return api.Schedule{}, err
+	}
+	if err := json.Unmarshal(schedule.Metadata, &meta); err != nil {
+		return api.Schedule{}, err
+	}
+
+	var lastInvocationId *string
+	if schedule.LastInvocationID != nil {
+		lastInvocationId = lo.ToPtr(strconv.FormatInt(*schedule.LastInvocationID, 10))
+	}
+
+	return api.Schedule{
+		Id:               schedule.ID,
+		Name:             schedule.Name,
+		Actor:            actorName,
+		CronExpression:   schedule.CronExpression,
+		Payload:          payload,
+		Meta:             meta,
+		Enabled:          schedule.Enabled,
+		LastRunAt:        schedule.LastRunAt,
+		LastInvocationId: lastInvocationId,
+		LastError:        schedule.LastError,
+		NextRunAt:        schedule.NextRunAt,
+		CreatedAt:        schedule.CreatedAt,
+		UpdatedAt:        schedule.UpdatedAt,
+	}, nil
+}
+
+// fromApiScheduleTemplate checks that the templates of a payload or meta can
+// be rendered and returns it as JSON.
+func fromApiScheduleTemplate(field string, tmpl map[string]interface{}) ([]byte, error) {
+	if tmpl == nil {
+		tmpl = map[string]interface{}{}
+	}
+	if _, err := invocation.RenderScheduleTemplate(tmpl, invocation.ScheduleTemplateData{}); err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	return json.Marshal(tmpl)
+}
+
+func nextScheduleRunAt(cronExpression string, enabled bool) (*int64, error) {
+	if cronExpression == "" {
+		return nil, fmt.Errorf("Missing required field: cron_expression")
+	}
+	nextRunAt, err := invocation.NextScheduleRunAt(cronExpression, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+	return nextRunAt, nil
+}
@@ -0,0 +1,181 @@
+package admin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestScheduleManageWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+
+	createBody := func(actor string) *api.AdminCreateScheduleJSONRequestBody {
+		return &api.AdminCreateScheduleJSONRequestBody{
+			Name:           "nightly-report",
+			Actor:          actor,
+			CronExpression: "0 2 * * *",
+			Payload:        &map[string]interface{}{"date": "{{ .ScheduledTime }}"},
+			Meta:           map[string]interface{}{"kind": "report"},
+		}
+	}
+
+	t.Run("Create, get, list and delete", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+
+		response, err := admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: createBody("actor1")})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminCreateSchedule201JSONResponse{}, response)
+		created := response.(api.AdminCreateSchedule201JSONResponse)
+		assert.Equal(t, "nightly-report", created.Name)
+		assert.Equal(t, "actor1", created.Actor)
+		assert.True(t, created.Enabled)
+		assert.Equal(t, map[string]interface{}{"date": "{{ .ScheduledTime }}"}, created.Payload)
+		assert.Equal(t, map[string]interface{}{"kind": "report"}, created.Meta)
+		require.NotNil(t, created.NextRunAt)
+		assert.Zero(t, *created.NextRunAt%3600)
+		assert.Nil(t, created.LastRunAt)
+
+		getResponse, err := admin.GetSchedule(ctx, logger, dbPool, api.AdminGetScheduleRequestObject{Id: created.Id})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminGetSchedule200JSONResponse{}, getResponse)
+		assert.Equal(t, api.Schedule(created), getResponse.(api.AdminGetSchedule200JSONResponse).Data)
+
+		listResponse, err := admin.ListSchedules(ctx, logger, dbPool, api.AdminListSchedulesRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminListSchedules200JSONResponse{}, listResponse)
+		list := listResponse.(api.AdminListSchedules200JSONResponse)
+		assert.Len(t, list.Data, 1)
+		assert.Equal(t, 1, list.Meta.TotalPages)
+
+		deleteResponse, err := admin.DeleteSchedule(ctx, logger, dbPool, api.AdminDeleteScheduleRequestObject{Id: created.Id})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminDeleteSchedule200Response{}, deleteResponse)
+
+		deleteResponse, err = admin.DeleteSchedule(ctx, logger, dbPool, api.AdminDeleteScheduleRequestObject{Id: created.Id})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminDeleteSchedule404Response{}, deleteResponse)
+	})
+
+	t.Run("Actor referenced by schedule cannot be deleted", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		response, err := admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: createBody("actor1")})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminCreateSchedule201JSONResponse{}, response)
+
+		actorDelete, err := admin.DeleteActor(ctx, logger, dbPool, api.AdminDeleteActorRequestObject{Id: actor.ID})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminDeleteActor409Response{}, actorDelete)
+	})
+
+	t.Run("Duplicate name is rejected", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+		response, err := admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: createBody("actor1")})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminCreateSchedule201JSONResponse{}, response)
+
+		response, err = admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: createBody("actor1")})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminCreateSchedule400JSONResponse{}, response)
+		assert.Equal(t, "schedule name already exists", response.(api.AdminCreateSchedule400JSONResponse).Error)
+
+		other := createBody("actor1")
+		other.Name = "weekly-report"
+		response, err = admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: other})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminCreateSchedule201JSONResponse{}, response)
+
+		updateResponse, err := admin.UpdateSchedule(ctx, logger, dbPool, api.AdminUpdateScheduleRequestObject{
+			Id:   response.(api.AdminCreateSchedule201JSONResponse).Id,
+			Body: &api.AdminUpdateScheduleJSONRequestBody{Name: lo.ToPtr("nightly-report")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminUpdateSchedule400JSONResponse{}, updateResponse)
+	})
+
+	t.Run("Invalid create requests", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+
+		invalidCron := createBody("actor1")
+		invalidCron.CronExpression = "every day"
+		unknownActor := createBody("actor2")
+		invalidTemplate := createBody("actor1")
+		invalidTemplate.Meta = map[string]interface{}{"kind": "{{ .Unknown }}"}
+
+		for _, body := range []*api.AdminCreateScheduleJSONRequestBody{invalidCron, unknownActor, invalidTemplate} {
+			response, err := admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: body})
+			require.NoError(t, err)
+			assert.IsType(t, api.AdminCreateSchedule400JSONResponse{}, response)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+		fixture.InsertActor(t, ctx, dbPool, "actor2")
+
+		response, err := admin.CreateSchedule(ctx, logger, dbPool, api.AdminCreateScheduleRequestObject{Body: createBody("actor1")})
+		require.NoError(t, err)
+		created := response.(api.AdminCreateSchedule201JSONResponse)
+
+		updateResponse, err := admin.UpdateSchedule(ctx, logger, dbPool, api.AdminUpdateScheduleRequestObject{
+			Id: created.Id,
+			Body: &api.AdminUpdateScheduleJSONRequestBody{
+				Actor:          lo.ToPtr("actor2"),
+				CronExpression: lo.ToPtr("@every 90s"),
+				Enabled:        lo.ToPtr(false),
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminUpdateSchedule200JSONResponse{}, updateResponse)
+		updated := updateResponse.(api.AdminUpdateSchedule200JSONResponse).Data
+		assert.Equal(t, "actor2", updated.Actor)
+		assert.Equal(t, "@every 90s", updated.CronExpression)
+		assert.False(t, updated.Enabled)
+		assert.Nil(t, updated.NextRunAt)
+		assert.Equal(t, created.Meta, updated.Meta)
+		assert.NotNil(t, updated.UpdatedAt)
+
+		updateResponse, err = admin.UpdateSchedule(ctx, logger, dbPool, api.AdminUpdateScheduleRequestObject{
+			Id:   created.Id,
+			Body: &api.AdminUpdateScheduleJSONRequestBody{Enabled: lo.ToPtr(true)},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.AdminUpdateSchedule200JSONResponse{}, updateResponse)
+		assert.NotNil(t, updateResponse.(api.AdminUpdateSchedule200JSONResponse).Data.NextRunAt)
+
+		updateResponse, err = admin.UpdateSchedule(ctx, logger, dbPool, api.AdminUpdateScheduleRequestObject{
+			Id:   created.Id + 1000,
+			Body: &api.AdminUpdateScheduleJSONRequestBody{Enabled: lo.ToPtr(true)},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, api.AdminUpdateSchedule404Response{}, updateResponse)
+	})
+}
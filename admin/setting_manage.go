@@ -9,6 +9,7 @@ import (
 	"github.com/samber/lo"
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/retention"
 )
 
 type SettingType struct {
@@ -18,6 +19,15 @@ type SettingType struct {
 	SecretsBackupPublicKey    *string `json:"secrets_backup_public_key,omitempty"`
 	SecretsBackupBucket       *string `json:"secrets_backup_bucket,omitempty"`
 	SecretsBackupPrefix       *string `json:"secrets_backup_prefix,omitempty"`
+
+	InvocationRetentionCompletedDays *int    `json:"invocation_retention_completed_days,omitempty"`
+	InvocationRetentionCancelledDays *int    `json:"invocation_retention_cancelled_days,omitempty"`
+	InvocationRetentionDiscardedDays *int    `json:"invocation_retention_discarded_days,omitempty"`
+	EnableInvocationArchive          *bool   `json:"enable_invocation_archive,omitempty"`
+	InvocationArchiveBucket          *string `json:"invocation_archive_bucket,omitempty"`
+	InvocationArchivePrefix          *string `json:"invocation_archive_prefix,omitempty"`
+
+	VirtualModels *[]api.VirtualModel `json:"virtual_models,omitempty"`
 }
 
 func GetSetting(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.AdminGetSettingRequestObject) (api.AdminGetSettingResponseObject, error) {
@@ -52,6 +62,15 @@ func GetSetting(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource
 		SecretsBackupPublicKey:    settingContent.SecretsBackupPublicKey,
 		SecretsBackupBucket:       settingContent.SecretsBackupBucket,
 		SecretsBackupPrefix:       settingContent.SecretsBackupPrefix,
+
+		InvocationRetentionCompletedDays: settingContent.InvocationRetentionCompletedDays,
+		InvocationRetentionCancelledDays: settingContent.InvocationRetentionCancelledDays,
+		InvocationRetentionDiscardedDays: settingContent.InvocationRetentionDiscardedDays,
+		EnableInvocationArchive:          lo.FromPtrOr(settingContent.EnableInvocationArchive, false),
+		InvocationArchiveBucket:          settingContent.InvocationArchiveBucket,
+		InvocationArchivePrefix:          settingContent.InvocationArchivePrefix,
+
+		VirtualModels: settingContent.VirtualModels,
 	}, nil
 }
 
@@ -75,6 +94,24 @@ func UpdateSetting(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSou
 		}, nil
 	}
 
+	for _, days := range []*int{request.Body.InvocationRetentionCompletedDays, request.Body.InvocationRetentionCancelledDays, request.Body.InvocationRetentionDiscardedDays} {
+		if days != nil && *days < 0 {
+			return api.AdminUpdateSetting400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{
+					Error: "Invocation retention days cannot be negative",
+				},
+			}, nil
+		}
+	}
+
+	if err := validateVirtualModels(lo.FromPtr(request.Body.VirtualModels)); err != nil {
+		return api.AdminUpdateSetting400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{
+				Error: err.Error(),
+			},
+		}, nil
+	}
+
 	settingContent := SettingType{
 		DisplayName:               request.Body.DisplayName,
 		DeploymentApproveRequired: request.Body.DeploymentApproveRequired,
@@ -82,6 +119,15 @@ func UpdateSetting(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSou
 		SecretsBackupPublicKey:    request.Body.SecretsBackupPublicKey,
 		SecretsBackupBucket:       request.Body.SecretsBackupBucket,
 		SecretsBackupPrefix:       request.Body.SecretsBackupPrefix,
+
+		InvocationRetentionCompletedDays: request.Body.InvocationRetentionCompletedDays,
+		InvocationRetentionCancelledDays: request.Body.InvocationRetentionCancelledDays,
+		InvocationRetentionDiscardedDays: request.Body.InvocationRetentionDiscardedDays,
+		EnableInvocationArchive:          request.Body.EnableInvocationArchive,
+		InvocationArchiveBucket:          request.Body.InvocationArchiveBucket,
+		InvocationArchivePrefix:          request.Body.InvocationArchivePrefix,
+
+		VirtualModels: request.Body.VirtualModels,
 	}
 	// Marshal updated setting
 	updatedSettingBytes, err := json.Marshal(settingContent)
@@ -99,6 +145,39 @@ func UpdateSetting(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSou
 	return api.AdminUpdateSetting200Response{}, nil
 }
 
+// LoadSetting returns the system setting, which is empty if it was never updated.
+func LoadSetting(ctx context.Context, ds dbaccess.DataSource) (SettingType, error) {
+	setting, err := querier.SettingGetSystem(ctx, ds)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return SettingType{}, nil
+		}
+		return SettingType{}, err
+	}
+
+	var settingContent SettingType
+	err = json.Unmarshal(setting.Value, &settingContent)
+	return settingContent, err
+}
+
+// LoadRetentionPolicy loads the invocation retention policy of the system
+// setting.
+func LoadRetentionPolicy(ctx context.Context, ds dbaccess.DataSource) (retention.Policy, error) {
+	setting, err := LoadSetting(ctx, ds)
+	if err != nil {
+		return retention.Policy{}, err
+	}
+
+	return retention.Policy{
+		CompletedDays: lo.FromPtr(setting.InvocationRetentionCompletedDays),
+		CancelledDays: lo.FromPtr(setting.InvocationRetentionCancelledDays),
+		DiscardedDays: lo.FromPtr(setting.InvocationRetentionDiscardedDays),
+		EnableArchive: lo.FromPtr(setting.EnableInvocationArchive),
+		ArchiveBucket: lo.FromPtr(setting.InvocationArchiveBucket),
+		ArchivePrefix: lo.FromPtr(setting.InvocationArchivePrefix),
+	}, nil
+}
+
 func deserializeSetting(content []byte, logger *slog.Logger) (SettingType, error) {
 	var settingContent SettingType
 	err := json.Unmarshal(content, &settingContent)
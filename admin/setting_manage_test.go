@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"gitlab.com/navyx/ai/maos/maos-core/admin"
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
 )
 
 func TestGetSettingWithDB(t *testing.T) {
@@ -310,6 +312,98 @@ func TestUpdateSetting(t *testing.T) {
 		assert.Equal(t, "new-prefix", *settingContent.SecretsBackupPrefix)
 	})
 
+	t.Run("Update invocation retention", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		response, err := admin.UpdateSetting(ctx, logger, dbPool, api.AdminUpdateSettingRequestObject{
+			Body: &api.AdminUpdateSettingJSONRequestBody{
+				InvocationRetentionCompletedDays: lo.ToPtr(7),
+				InvocationRetentionDiscardedDays: lo.ToPtr(30),
+				EnableInvocationArchive:          lo.ToPtr(true),
+				InvocationArchiveBucket:          lo.ToPtr("archive-bucket"),
+				InvocationArchivePrefix:          lo.ToPtr("archive-prefix"),
+			},
+		})
+		assert.NoError(t, err)
+		require.IsType(t, api.AdminUpdateSetting200Response{}, response)
+
+		setting, err := admin.LoadSetting(ctx, dbPool)
+		require.NoError(t, err)
+		assert.Equal(t, 7, *setting.InvocationRetentionCompletedDays)
+		assert.Nil(t, setting.InvocationRetentionCancelledDays)
+		assert.Equal(t, 30, *setting.InvocationRetentionDiscardedDays)
+		assert.True(t, *setting.EnableInvocationArchive)
+		assert.Equal(t, "archive-bucket", *setting.InvocationArchiveBucket)
+		assert.Equal(t, "archive-prefix", *setting.InvocationArchivePrefix)
+
+		response, err = admin.UpdateSetting(ctx, logger, dbPool, api.AdminUpdateSettingRequestObject{
+			Body: &api.AdminUpdateSettingJSONRequestBody{InvocationRetentionCancelledDays: lo.ToPtr(-1)},
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, api.AdminUpdateSetting400JSONResponse{}, response)
+	})
+
+	t.Run("Update virtual models", func(t *testing.T) {
+		t.Parallel()
+		dbPool := testhelper.TestDB(ctx, t)
+		defer dbPool.Close()
+
+		gpt4o := "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o"
+		opus := "3db6db92-a091-4944-9f7e-9d43e70218d3-anthropic-claude-3-opus-20240229"
+		sonnet := "93d07ee3-c9fb-4f0e-9fc1-df1a7af10b6c-anthropic-claude-3.5-sonnet-20240620"
+		virtualModel := api.VirtualModel{
+			Id:         "smart",
+			Routes:     []api.VirtualModelRoute{{ModelId: opus, Weight: lo.ToPtr(3)}, {ModelId: sonnet}},
+			Fallbacks:  &[]string{gpt4o},
+			MaxRetries: lo.ToPtr(1),
+		}
+		response, err := admin.UpdateSetting(ctx, logger, dbPool, api.AdminUpdateSettingRequestObject{
+			Body: &api.AdminUpdateSettingJSONRequestBody{VirtualModels: &[]api.VirtualModel{virtualModel}},
+		})
+		assert.NoError(t, err)
+		require.IsType(t, api.AdminUpdateSetting200Response{}, response)
+
+		setting, err := admin.LoadSetting(ctx, dbPool)
+		require.NoError(t, err)
+		assert.Equal(t, []api.VirtualModel{virtualModel}, *setting.VirtualModels)
+		policy, ok := setting.RoutingPolicy("smart")
+		require.True(t, ok)
+		assert.Equal(t, adapter.RoutingPolicy{
+			Routes:         []adapter.Route{{ModelID: opus, Weight: 3}, {ModelID: sonnet, Weight: 1}},
+			Fallbacks:      []string{gpt4o},
+			MaxRetries:     1,
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     8 * time.Second,
+		}, policy)
+		_, ok = setting.RoutingPolicy(opus)
+		assert.False(t, ok)
+
+		invalidModels := map[string]api.VirtualModel{
+			"Model id":           {Id: opus, Routes: virtualModel.Routes},
+			"No routes":          {Id: "smart"},
+			"Unknown route":      {Id: "smart", Routes: []api.VirtualModelRoute{{ModelId: "unknown"}}},
+			"Unknown fallback":   {Id: "smart", Routes: virtualModel.Routes, Fallbacks: &[]string{"unknown"}},
+			"No positive weight": {Id: "smart", Routes: []api.VirtualModelRoute{{ModelId: opus, Weight: lo.ToPtr(0)}}},
+			"Too many retries":   {Id: "smart", Routes: virtualModel.Routes, MaxRetries: lo.ToPtr(11)},
+			"Backoff over max":   {Id: "smart", Routes: virtualModel.Routes, InitialBackoffMs: lo.ToPtr(1000), MaxBackoffMs: lo.ToPtr(500)},
+		}
+		for name, invalidModel := range invalidModels {
+			response, err := admin.UpdateSetting(ctx, logger, dbPool, api.AdminUpdateSettingRequestObject{
+				Body: &api.AdminUpdateSettingJSONRequestBody{VirtualModels: &[]api.VirtualModel{invalidModel}},
+			})
+			assert.NoError(t, err)
+			assert.IsType(t, api.AdminUpdateSetting400JSONResponse{}, response, name)
+		}
+
+		response, err = admin.UpdateSetting(ctx, logger, dbPool, api.AdminUpdateSettingRequestObject{
+			Body: &api.AdminUpdateSettingJSONRequestBody{VirtualModels: &[]api.VirtualModel{virtualModel, virtualModel}},
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, api.AdminUpdateSetting400JSONResponse{}, response)
+	})
+
 	t.Run("Invalid request body", func(t *testing.T) {
 		t.Parallel()
 		dbPool := testhelper.TestDB(ctx, t)
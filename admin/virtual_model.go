@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+const (
+	defaultVirtualModelMaxRetries       = 2
+	defaultVirtualModelInitialBackoffMs = 500
+	defaultVirtualModelMaxBackoffMs     = 8000
+	maxVirtualModelRetries              = 10
+)
+
+func validateVirtualModels(virtualModels []api.VirtualModel) error {
+	ids := make(map[string]bool, len(virtualModels))
+	for _, vm := range virtualModels {
+		if vm.Id == "" {
+			return fmt.Errorf("Virtual model id cannot be empty")
+		}
+		if ids[vm.Id] {
+			return fmt.Errorf("Duplicate virtual model %s", vm.Id)
+		}
+		ids[vm.Id] = true
+		if _, ok := adapter.GetModelByID(vm.Id); ok {
+			return fmt.Errorf("Virtual model %s cannot have the id of a model", vm.Id)
+		}
+
+		if len(vm.Routes) == 0 {
+			return fmt.Errorf("Virtual model %s must have routes", vm.Id)
+		}
+		positive := false
+		for _, route := range vm.Routes {
+			if _, ok := adapter.GetModelByID(route.ModelId); !ok {
+				return fmt.Errorf("Model %s of virtual model %s not found", route.ModelId, vm.Id)
+			}
+			weight := lo.FromPtrOr(route.Weight, 1)
+			if weight < 0 {
+				return fmt.Errorf("Route weight of virtual model %s cannot be negative", vm.Id)
+			}
+			positive = positive || weight > 0
+		}
+		if !positive {
+			return fmt.Errorf("Virtual model %s must have a route of positive weight", vm.Id)
+		}
+		for _, modelId := range lo.FromPtr(vm.Fallbacks) {
+			if _, ok := adapter.GetModelByID(modelId); !ok {
+				return fmt.Errorf("Model %s of virtual model %s not found", modelId, vm.Id)
+			}
+		}
+
+		if maxRetries := lo.FromPtr(vm.MaxRetries); maxRetries < 0 || maxRetries > maxVirtualModelRetries {
+			return fmt.Errorf("Max retries of virtual model %s must be between 0 and %d", vm.Id, maxVirtualModelRetries)
+		}
+		initialBackoff := lo.FromPtrOr(vm.InitialBackoffMs, defaultVirtualModelInitialBackoffMs)
+		maxBackoff := lo.FromPtrOr(vm.MaxBackoffMs, defaultVirtualModelMaxBackoffMs)
+		if initialBackoff < 0 || maxBackoff < 0 {
+			return fmt.Errorf("Backoff of virtual model %s cannot be negative", vm.Id)
+		}
+		if initialBackoff > maxBackoff {
+			return fmt.Errorf("Initial backoff of virtual model %s cannot exceed its max backoff", vm.Id)
+		}
+	}
+	return nil
+}
+
+// RoutingPolicy returns the routing policy of the virtual model with the id, if there is one.
+func (s SettingType) RoutingPolicy(id string) (adapter.RoutingPolicy, bool) {
+	vm, ok := lo.Find(lo.FromPtr(s.VirtualModels), func(vm api.VirtualModel) bool { return vm.Id == id })
+	if !ok {
+		return adapter.RoutingPolicy{}, false
+	}
+
+	return adapter.RoutingPolicy{
+		Routes: lo.Map(vm.Routes, func(route api.VirtualModelRoute, _ int) adapter.Route {
+			return adapter.Route{ModelID: route.ModelId, Weight: lo.FromPtrOr(route.Weight, 1)}
+		}),
+		Fallbacks:      lo.FromPtr(vm.Fallbacks),
+		MaxRetries:     lo.FromPtrOr(vm.MaxRetries, defaultVirtualModelMaxRetries),
+		InitialBackoff: time.Duration(lo.FromPtrOr(vm.InitialBackoffMs, defaultVirtualModelInitialBackoffMs)) * time.Millisecond,
+		MaxBackoff:     time.Duration(lo.FromPtrOr(vm.MaxBackoffMs, defaultVirtualModelMaxBackoffMs)) * time.Millisecond,
+	}, true
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -37,6 +38,37 @@ const (
 	ActorCreateRoleUser    ActorCreateRole = "user"
 )
 
+// Defines values for ActorQuotaPeriod.
+const (
+	ActorQuotaPeriodDaily   ActorQuotaPeriod = "daily"
+	ActorQuotaPeriodMonthly ActorQuotaPeriod = "monthly"
+)
+
+// Defines values for ActorQuotaSetPeriod.
+const (
+	ActorQuotaSetPeriodDaily   ActorQuotaSetPeriod = "daily"
+	ActorQuotaSetPeriodMonthly ActorQuotaSetPeriod = "monthly"
+)
+
+// Defines values for ChatCompletionMessageRole.
+const (
+	ChatCompletionMessageRoleAssistant ChatCompletionMessageRole = "assistant"
+	ChatCompletionMessageRoleDeveloper ChatCompletionMessageRole = "developer"
+	ChatCompletionMessageRoleSystem    ChatCompletionMessageRole = "system"
+	ChatCompletionMessageRoleTool      ChatCompletionMessageRole = "tool"
+	ChatCompletionMessageRoleUser      ChatCompletionMessageRole = "user"
+)
+
+// Defines values for ChatCompletionToolType.
+const (
+	ChatCompletionToolTypeFunction ChatCompletionToolType = "function"
+)
+
+// Defines values for ChatCompletionToolCallType.
+const (
+	ChatCompletionToolCallTypeFunction ChatCompletionToolCallType = "function"
+)
+
 // Defines values for CollectionDataType.
 const (
 	ARRAY             CollectionDataType = "ARRAY"
@@ -162,16 +194,26 @@ const (
 
 // Actor defines model for Actor.
 type Actor struct {
-	Configurable bool      `json:"configurable"`
-	CreatedAt    int64     `json:"created_at"`
-	Deployable   bool      `json:"deployable"`
-	Enabled      bool      `json:"enabled"`
-	Id           int64     `json:"id"`
-	Migratable   bool      `json:"migratable"`
-	Name         string    `json:"name"`
-	Renameable   bool      `json:"renameable"`
-	Role         ActorRole `json:"role"`
-	TokenCount   int64     `json:"token_count"`
+	Configurable bool  `json:"configurable"`
+	CreatedAt    int64 `json:"created_at"`
+	Deployable   bool  `json:"deployable"`
+	Enabled      bool  `json:"enabled"`
+	Id           int64 `json:"id"`
+
+	// LeaseSeconds How long (in seconds) an invocation may stay running without a heartbeat before it is taken back from the actor. Omitted when the invocations of the actor have no lease.
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
+
+	// MaxPriority The most urgent priority the actor can give to the invocation jobs it creates
+	MaxPriority int    `json:"max_priority"`
+	Migratable  bool   `json:"migratable"`
+	Name        string `json:"name"`
+	Renameable  bool   `json:"renameable"`
+
+	// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+	// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+	RetryPolicy RetryPolicy `json:"retry_policy"`
+	Role        ActorRole   `json:"role"`
+	TokenCount  int64       `json:"token_count"`
 }
 
 // ActorRole defines model for Actor.Role.
@@ -179,17 +221,114 @@ type ActorRole string
 
 // ActorCreate defines model for ActorCreate.
 type ActorCreate struct {
-	Configurable *bool           `json:"configurable,omitempty"`
-	Deployable   *bool           `json:"deployable,omitempty"`
-	Enabled      *bool           `json:"enabled,omitempty"`
-	Migratable   *bool           `json:"migratable,omitempty"`
-	Name         string          `json:"name"`
-	Role         ActorCreateRole `json:"role"`
+	Configurable *bool `json:"configurable,omitempty"`
+	Deployable   *bool `json:"deployable,omitempty"`
+	Enabled      *bool `json:"enabled,omitempty"`
+
+	// LeaseSeconds How long (in seconds) an invocation may stay running without a heartbeat before it is taken back from the actor. 0 removes the lease, and invocations without a lease are never taken back.
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
+
+	// MaxPriority The most urgent priority the actor can give to the invocation jobs it creates
+	MaxPriority *int   `json:"max_priority,omitempty"`
+	Migratable  *bool  `json:"migratable,omitempty"`
+	Name        string `json:"name"`
+
+	// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+	// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+	RetryPolicy *RetryPolicy    `json:"retry_policy,omitempty"`
+	Role        ActorCreateRole `json:"role"`
 }
 
 // ActorCreateRole defines model for ActorCreate.Role.
 type ActorCreateRole string
 
+// ActorQuota A token and cost quota of an actor over a period, with its usage in the current one. The completions of the actor
+// are rejected with 429 once a limit is used up, until the next period.
+type ActorQuota struct {
+	// MaxCost The maximum cost in USD in the period.
+	MaxCost *float64 `json:"max_cost,omitempty"`
+
+	// MaxTokens The maximum number of input and output tokens in the period.
+	MaxTokens *int64 `json:"max_tokens,omitempty"`
+
+	// Period The period of the quota, a UTC day or month.
+	Period ActorQuotaPeriod `json:"period"`
+
+	// UsedCost The cost in USD used in the current period.
+	UsedCost float64 `json:"used_cost"`
+
+	// UsedTokens The number of tokens used in the current period.
+	UsedTokens int64 `json:"used_tokens"`
+}
+
+// ActorQuotaPeriod The period of the quota, a UTC day or month.
+type ActorQuotaPeriod string
+
+// ActorQuotaSet A token and cost quota of an actor over a period. A limit that's absent isn't enforced.
+type ActorQuotaSet struct {
+	// MaxCost The maximum cost in USD in the period.
+	MaxCost *float64 `json:"max_cost,omitempty"`
+
+	// MaxTokens The maximum number of input and output tokens in the period.
+	MaxTokens *int64 `json:"max_tokens,omitempty"`
+
+	// Period The period of the quota, a UTC day or month.
+	Period ActorQuotaSetPeriod `json:"period"`
+}
+
+// ActorQuotaSetPeriod The period of the quota, a UTC day or month.
+type ActorQuotaSetPeriod string
+
+// AdminInvocation defines model for AdminInvocation.
+type AdminInvocation struct {
+	// Actor The name of the actor processing the invocation job
+	Actor       *string `json:"actor,omitempty"`
+	AttemptedAt *int64  `json:"attempted_at,omitempty"`
+
+	// Attempts The number of attempts made so far
+	Attempts  int   `json:"attempts"`
+	CreatedAt int64 `json:"created_at"`
+
+	// CreatedBy The id of the actor which created the invocation job
+	CreatedBy *int64 `json:"created_by,omitempty"`
+
+	// Errors The errors of the invocation job
+	Errors      *map[string]interface{} `json:"errors,omitempty"`
+	FinalizedAt *int64                  `json:"finalized_at,omitempty"`
+
+	// Id The unique identifier of the invocation job
+	Id          string `json:"id"`
+	MaxAttempts int    `json:"max_attempts"`
+
+	// Meta The metadata of the invocation job
+	Meta map[string]interface{} `json:"meta"`
+
+	// Payload The payload of the invocation job
+	Payload map[string]interface{} `json:"payload"`
+
+	// Priority The priority of the invocation job. 1 is the most urgent.
+	Priority int   `json:"priority"`
+	QueueId  int64 `json:"queue_id"`
+
+	// ReplayedFrom The id of the discarded invocation job this one is a replay of
+	ReplayedFrom *string `json:"replayed_from,omitempty"`
+
+	// Result The result of the invocation job
+	Result *map[string]interface{} `json:"result,omitempty"`
+
+	// ScheduledAt The timestamp before which the invocation job is not processed
+	ScheduledAt int64 `json:"scheduled_at"`
+
+	// State The state of the invocation job
+	// - available: The job is queued and waiting to be processed.
+	// - running: The job is currently being executed.
+	// - completed: The job has finished successfully.
+	// - cancelled: The job was cancelled before completion.
+	// - discarded: The job was discarded due to an error or system issue.
+	State InvocationState `json:"state"`
+	Tags  []string        `json:"tags"`
+}
+
 // ApiToken defines model for ApiToken.
 type ApiToken struct {
 	ActorId     int64        `json:"actor_id"`
@@ -208,24 +347,220 @@ type ApiTokenCreate struct {
 	Permissions []string `json:"permissions"`
 }
 
+// ChatCompletion A chat completion in the OpenAI format.
+type ChatCompletion struct {
+	Choices []ChatCompletionChoice `json:"choices"`
+
+	// Created The Unix timestamp in seconds when the completion was created.
+	Created int64 `json:"created"`
+
+	// Id The unique ID of the completion.
+	Id string `json:"id"`
+
+	// Model The ID of the model which generated the completion. When a virtual model is requested, it's the model of its routes or fallbacks which the completion was routed to.
+	Model string `json:"model"`
+
+	// Object Always `chat.completion`.
+	Object string `json:"object"`
+
+	// Usage The number of tokens consumed by a chat completion.
+	Usage ChatCompletionUsage `json:"usage"`
+}
+
+// ChatCompletionChoice A choice of a chat completion. A completion always has a single choice.
+type ChatCompletionChoice struct {
+	// FinishReason Either `tool_calls` when the model called tools, or `stop`.
+	FinishReason string `json:"finish_reason"`
+	Index        int    `json:"index"`
+
+	// Message A message of a chat completion in the OpenAI format.
+	Message ChatCompletionMessage `json:"message"`
+}
+
+// ChatCompletionChunk The data of an event of a streamed chat completion in the OpenAI format.
+type ChatCompletionChunk struct {
+	// Choices The increment of the single choice of the completion, which is empty in the chunk of the usage.
+	Choices []ChatCompletionChunkChoice `json:"choices"`
+
+	// Created The Unix timestamp in seconds when the completion was created.
+	Created int64 `json:"created"`
+
+	// Id The unique ID of the completion, which is the same for all of its chunks.
+	Id string `json:"id"`
+
+	// Model The ID of the model which generates the completion.
+	Model string `json:"model"`
+
+	// Object Always `chat.completion.chunk`.
+	Object string `json:"object"`
+
+	// Usage The number of tokens consumed by a chat completion.
+	Usage *ChatCompletionUsage `json:"usage,omitempty"`
+}
+
+// ChatCompletionChunkChoice An increment of a choice of a streamed chat completion.
+type ChatCompletionChunkChoice struct {
+	// Delta An increment of the message of a streamed chat completion.
+	Delta ChatCompletionDelta `json:"delta"`
+
+	// FinishReason Either `tool_calls` when the model called tools, or `stop`. It's null until the last chunk of the choice.
+	FinishReason *string `json:"finish_reason"`
+	Index        int     `json:"index"`
+}
+
+// ChatCompletionDelta An increment of the message of a streamed chat completion.
+type ChatCompletionDelta struct {
+	// Content The text appended to the message.
+	Content *string `json:"content,omitempty"`
+
+	// Role The role of the message, which only comes with the first increment.
+	Role      *string                        `json:"role,omitempty"`
+	ToolCalls *[]ChatCompletionToolCallDelta `json:"tool_calls,omitempty"`
+}
+
+// ChatCompletionFunction The definition of a function tool.
+type ChatCompletionFunction struct {
+	// Description The description of the function.
+	Description *string `json:"description,omitempty"`
+
+	// Name The name of the function.
+	Name string `json:"name"`
+
+	// Parameters The parameters of the function. It's defined by JSON schema.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ChatCompletionFunctionCall The function called by a tool call.
+type ChatCompletionFunctionCall struct {
+	// Arguments The JSON encoded arguments of the function. In a streamed tool call, they are split across the parts of the call.
+	Arguments string `json:"arguments"`
+
+	// Name The name of the function. In a streamed tool call, it only comes with the first part of the call.
+	Name *string `json:"name,omitempty"`
+}
+
+// ChatCompletionMessage A message of a chat completion in the OpenAI format.
+type ChatCompletionMessage struct {
+	// Content The content of the message, either a string or an array of content parts. A part is either a text `{"type":"text","text":"..."}` or an image `{"type":"image_url","image_url":{"url":"..."}}`, whose URL may be a base64 encoded data URL. It's omitted from an assistant message which only calls tools.
+	Content *interface{} `json:"content,omitempty"`
+
+	// Name The name of the author of the message, which is ignored.
+	Name *string `json:"name,omitempty"`
+
+	// Role The role of the author of the message. A developer message is handled as a system message.
+	Role ChatCompletionMessageRole `json:"role"`
+
+	// ToolCallId The ID of the tool call answered by a tool message.
+	ToolCallId *string `json:"tool_call_id,omitempty"`
+
+	// ToolCalls The tools called by an assistant message.
+	ToolCalls *[]ChatCompletionToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatCompletionMessageRole The role of the author of the message. A developer message is handled as a system message.
+type ChatCompletionMessageRole string
+
+// ChatCompletionTool A function tool the model may call.
+type ChatCompletionTool struct {
+	// Function The definition of a function tool.
+	Function ChatCompletionFunction `json:"function"`
+	Type     ChatCompletionToolType `json:"type"`
+}
+
+// ChatCompletionToolType defines model for ChatCompletionTool.Type.
+type ChatCompletionToolType string
+
+// ChatCompletionToolCall A call of a function tool by the model.
+type ChatCompletionToolCall struct {
+	// Function The function called by a tool call.
+	Function ChatCompletionFunctionCall `json:"function"`
+
+	// Id The ID of the tool call.
+	Id   string                     `json:"id"`
+	Type ChatCompletionToolCallType `json:"type"`
+}
+
+// ChatCompletionToolCallType defines model for ChatCompletionToolCall.Type.
+type ChatCompletionToolCallType string
+
+// ChatCompletionToolCallDelta A part of a streamed tool call. The id, type and function name come with the first part of a call.
+type ChatCompletionToolCallDelta struct {
+	// Function The function called by a tool call.
+	Function *ChatCompletionFunctionCall `json:"function,omitempty"`
+
+	// Id The ID of the tool call.
+	Id *string `json:"id,omitempty"`
+
+	// Index The position of the tool call among the tool calls of the message.
+	Index int `json:"index"`
+
+	// Type Always `function`.
+	Type *string `json:"type,omitempty"`
+}
+
+// ChatCompletionUsage The number of tokens consumed by a chat completion.
+type ChatCompletionUsage struct {
+	CompletionTokens int32 `json:"completion_tokens"`
+	PromptTokens     int32 `json:"prompt_tokens"`
+	TotalTokens      int32 `json:"total_tokens"`
+}
+
 // CollectionDataType defines model for CollectionDataType.
 type CollectionDataType string
 
+// CollectionEmbedding Generates the vector of the field from a text field with an embedding model, when the vector is omitted on upsert and when the query is a text.
+type CollectionEmbedding struct {
+	// ModelId The ID of the embedding model, which is listed by GET /v1/embedding/models.
+	ModelId string `json:"model_id"`
+
+	// TextField The VARCHAR field whose text is embedded.
+	TextField string `json:"text_field"`
+}
+
 // CollectionField defines model for CollectionField.
 type CollectionField struct {
-	DataType  *CollectionDataType `json:"data_type,omitempty"`
-	IsPrimary *bool               `json:"is_primary,omitempty"`
+	DataType CollectionDataType `json:"data_type"`
+
+	// Dim The dimension of the vector. It's mandatory for vector data types, except that it defaults to the dimension of the embedding model.
+	Dim *int `json:"dim,omitempty"`
+
+	// Embedding Generates the vector of the field from a text field with an embedding model, when the vector is omitted on upsert and when the query is a text.
+	Embedding *CollectionEmbedding `json:"embedding,omitempty"`
+
+	// IsPrimary Whether the field is the primary key. Exactly one INT64 or VARCHAR field must be the primary key.
+	IsPrimary *bool `json:"is_primary,omitempty"`
 
 	// MaxLength The maximum length of the field. It's mandatory for VARCHAR data type.
-	MaxLength *int    `json:"max_length,omitempty"`
-	Name      *string `json:"name,omitempty"`
+	MaxLength *int `json:"max_length,omitempty"`
+
+	// Name The name of the field. It must start with a letter or underscore, followed by letters, digits or underscores.
+	Name string `json:"name"`
+}
+
+// CollectionHit defines model for CollectionHit.
+type CollectionHit struct {
+	// Distance The distance between the query vector and the vector of the entity. Smaller is more similar.
+	Distance float64 `json:"distance"`
+
+	// Entity The output fields of the entity.
+	Entity map[string]interface{} `json:"entity"`
+
+	// RelevanceScore The relevance score of the entity to the query text given by the rerank model. Larger is more relevant.
+	RelevanceScore *float64 `json:"relevance_score,omitempty"`
 }
 
 // CollectionIndex defines model for CollectionIndex.
 type CollectionIndex struct {
-	FieldName  *string                    `json:"field_name,omitempty"`
-	IndexName  *string                    `json:"index_name,omitempty"`
-	IndexType  *CollectionIndexIndexType  `json:"index_type,omitempty"`
+	// FieldName The field to be indexed. Scalar fields are indexed with a B-tree, or GIN for JSON and ARRAY fields.
+	FieldName string `json:"field_name"`
+
+	// IndexName The name of the index. A name is derived from the collection and the field if omitted.
+	IndexName *string `json:"index_name,omitempty"`
+
+	// IndexType The index type of a vector field. FLAT and BIN_FLAT don't build an index, HNSW, IVF_FLAT and BIN_IVF_FLAT are supported by pgvector, and SPARSE_INVERTED_INDEX is built as HNSW. Other types are rejected. It defaults to HNSW, and is ignored for scalar fields.
+	IndexType *CollectionIndexIndexType `json:"index_type,omitempty"`
+
+	// MetricType The metric used to measure the distance of the vectors. It defaults to COSINE for float vectors and HAMMING for binary vectors. The metric of the index is the one used by the queries on the field.
 	MetricType *CollectionIndexMetricType `json:"metric_type,omitempty"`
 	Parameter  *struct {
 		// M M defines tha maximum number of outgoing connections in the graph. Higher M leads to higher accuracy/run_time at fixed ef/efConstruction. Required when index type is *HNSW*.
@@ -251,6 +586,30 @@ type CollectionIndexIndexType string
 // CollectionIndexMetricType defines model for CollectionIndex.MetricType.
 type CollectionIndexMetricType string
 
+// CollectionRerank Reranks the data found by a text query with a rerank model, on the text field of the embedding.
+type CollectionRerank struct {
+	// ModelId The ID of the rerank model, which is listed by GET /v1/rerank/models.
+	ModelId string `json:"model_id"`
+
+	// TopK The maximum number of data to return after reranking. All the data found are returned if omitted.
+	TopK *int `json:"top_k,omitempty"`
+}
+
+// CompletionDelta The data of a `delta` event of a streamed completion, carrying either some text or a part of a tool call.
+type CompletionDelta struct {
+	// Text The text appended to the completion.
+	Text *string `json:"text,omitempty"`
+
+	// ToolCall A part of a streamed tool call. The id and name come with the first part of a call, and its JSON encoded arguments are split across the following parts.
+	ToolCall *ToolCallDelta `json:"tool_call,omitempty"`
+}
+
+// CompletionUsage The number of tokens consumed by a completion
+type CompletionUsage struct {
+	InputTokens  int32 `json:"input_tokens"`
+	OutputTokens int32 `json:"output_tokens"`
+}
+
 // Config defines model for Config.
 type Config struct {
 	ActorId         int64             `json:"actor_id"`
@@ -267,6 +626,15 @@ type Config struct {
 // Configuration A key-value structure representing the caller's configuration
 type Configuration map[string]string
 
+// DeadLetterReplay defines model for DeadLetterReplay.
+type DeadLetterReplay struct {
+	// Id The id of the new invocation job
+	Id string `json:"id"`
+
+	// ReplayedFrom The id of the discarded invocation job
+	ReplayedFrom string `json:"replayed_from"`
+}
+
 // Deployment defines model for Deployment.
 type Deployment struct {
 	ApprovedAt    *int64                  `json:"approved_at,omitempty"`
@@ -305,6 +673,12 @@ type DeploymentDetail struct {
 // DeploymentDetailStatus defines model for DeploymentDetail.Status.
 type DeploymentDetailStatus string
 
+// DurationPercentiles Percentiles of a duration in seconds
+type DurationPercentiles struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+}
+
 // Embedding defines model for Embedding.
 type Embedding struct {
 	// Embedding The embedding of the text.
@@ -320,12 +694,57 @@ type Error struct {
 	Error string `json:"error"`
 }
 
+// InvocationBatchResult The result of one invocation job of a batch. Either id and state, or error, is set.
+type InvocationBatchResult struct {
+	// Error Why the invocation job could not be created
+	Error *string `json:"error,omitempty"`
+
+	// Id The unique identifier of the invocation job
+	Id *string `json:"id,omitempty"`
+
+	// State The state of the invocation job
+	// - available: The job is queued and waiting to be processed.
+	// - running: The job is currently being executed.
+	// - completed: The job has finished successfully.
+	// - cancelled: The job was cancelled before completion.
+	// - discarded: The job was discarded due to an error or system issue.
+	State *InvocationState `json:"state,omitempty"`
+}
+
+// InvocationCreateRequest defines model for InvocationCreateRequest.
+type InvocationCreateRequest struct {
+	// Actor The name of the actor to process the invocation job
+	Actor string `json:"actor"`
+
+	// DelaySeconds The delay (in seconds) before the invocation job is processed. It cannot be used with run_at.
+	DelaySeconds *int `json:"delay_seconds,omitempty"`
+
+	// Meta The metadata of the invocation job. If trace_id is not provided, it will be the id of the trace of the request, or generated without one. The W3C trace context of the request is stored as traceparent and tracestate unless provided. An idempotency_key can be given here instead of the Idempotency-Key header.
+	Meta map[string]interface{} `json:"meta"`
+
+	// Payload The payload for the invocation job
+	Payload map[string]interface{} `json:"payload"`
+
+	// Priority The priority of the invocation job. 1 is the most urgent. It is capped by the max_priority of the caller. Default is the max_priority of the caller.
+	Priority *int `json:"priority,omitempty"`
+
+	// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+	// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
+	// RunAt The timestamp before which the invocation job is not processed. It must be at most 30 days from now, and cannot be used with delay_seconds.
+	RunAt *int64 `json:"run_at,omitempty"`
+
+	// Tags The tags of the invocation job
+	Tags *[]string `json:"tags,omitempty"`
+}
+
 // InvocationJob defines model for InvocationJob.
 type InvocationJob struct {
 	// Id The unique identifier for the invocation job
 	Id string `json:"id"`
 
-	// Meta The metadata of the invocation job. It contains 'kind' to specify the type of the invocation job and 'trace_id' to trace the invocation job
+	// Meta The metadata of the invocation job. It contains 'kind' to specify the type of the invocation job and 'trace_id' to trace the invocation job. 'traceparent' and 'tracestate' hold the W3C trace context of the request which created the invocation job, to be continued by the actor processing it.
 	Meta map[string]interface{} `json:"meta"`
 
 	// Payload The payload for the invocation job
@@ -349,6 +768,9 @@ type InvocationResult struct {
 	// Meta The metadata of the invocation job. It contains 'kind' to specify the type of the invocation job and 'trace_id' to trace the invocation job
 	Meta map[string]interface{} `json:"meta"`
 
+	// Progress The latest progress reported by the actor working on the invocation job
+	Progress *map[string]interface{} `json:"progress,omitempty"`
+
 	// Result The result of the invocation job
 	Result *map[string]interface{} `json:"result,omitempty"`
 
@@ -369,6 +791,15 @@ type InvocationResult struct {
 // - discarded: The job was discarded due to an error or system issue.
 type InvocationState string
 
+// InvocationStateCounts The number of invocation jobs in each state
+type InvocationStateCounts struct {
+	Available int64 `json:"available"`
+	Cancelled int64 `json:"cancelled"`
+	Completed int64 `json:"completed"`
+	Discarded int64 `json:"discarded"`
+	Running   int64 `json:"running"`
+}
+
 // Message defines model for Message.
 type Message struct {
 	Content []MessageContent `json:"content"`
@@ -428,6 +859,21 @@ type MessageContent4 struct {
 	} `json:"tool_call"`
 }
 
+// NextInvocation A single invocation job, or with batch_size, the invocation jobs in data. Only one of the two shapes is returned.
+type NextInvocation struct {
+	// Data The invocation jobs locked to the caller when batch_size is given, at most batch_size of them
+	Data *[]InvocationJob `json:"data,omitempty"`
+
+	// Id The unique identifier for the invocation job
+	Id *string `json:"id,omitempty"`
+
+	// Meta The metadata of the invocation job. It contains 'kind' to specify the type of the invocation job and 'trace_id' to trace the invocation job. 'traceparent' and 'tracestate' hold the W3C trace context of the request which created the invocation job, to be continued by the actor processing it.
+	Meta *map[string]interface{} `json:"meta,omitempty"`
+
+	// Payload The payload for the invocation job
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
 // Permission defines model for Permission.
 type Permission string
 
@@ -443,6 +889,39 @@ type PodMetrics struct {
 	Name string `json:"name"`
 }
 
+// QueuePauseState defines model for QueuePauseState.
+type QueuePauseState struct {
+	// PausedAt The timestamp when the queue was paused, absent if the queue is not paused
+	PausedAt *int64 `json:"paused_at,omitempty"`
+	QueueId  int64  `json:"queue_id"`
+
+	// RejectInvocations Whether new invocation jobs are rejected with 503 while the queue is paused
+	RejectInvocations bool `json:"reject_invocations"`
+}
+
+// QueueStats defines model for QueueStats.
+type QueueStats struct {
+	// Actor Name of the actor of the queue
+	Actor *string `json:"actor,omitempty"`
+
+	// Counts The number of invocation jobs in each state
+	Counts InvocationStateCounts `json:"counts"`
+	Name   string                `json:"name"`
+
+	// OldestAvailableAge Seconds since the oldest available invocation job became due, absent if none is due
+	OldestAvailableAge *int64 `json:"oldest_available_age,omitempty"`
+
+	// PausedAt The timestamp when the queue was paused, absent if the queue is not paused
+	PausedAt *int64 `json:"paused_at,omitempty"`
+	QueueId  int64  `json:"queue_id"`
+
+	// RunTime Percentiles of a duration in seconds
+	RunTime *DurationPercentiles `json:"run_time,omitempty"`
+
+	// WaitTime Percentiles of a duration in seconds
+	WaitTime *DurationPercentiles `json:"wait_time,omitempty"`
+}
+
 // ReferenceConfigSuite defines model for ReferenceConfigSuite.
 type ReferenceConfigSuite struct {
 	ActorName    string `json:"actor_name"`
@@ -455,23 +934,111 @@ type ReferenceConfigSuite struct {
 // RerankResult defines model for RerankResult.
 type RerankResult struct {
 	// Index The index of the document in the original list.
-	Index *int `json:"index,omitempty"`
+	Index int `json:"index"`
 
 	// Score The score of the document.
-	Score *float32 `json:"score,omitempty"`
+	Score float32 `json:"score"`
 
-	// Text The document.
+	// Text The document. Only returned when return_documents is true.
 	Text *string `json:"text,omitempty"`
 }
 
+// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+type RetryPolicy struct {
+	// BackoffSeconds The delay (in seconds) before the first retry.
+	BackoffSeconds *int `json:"backoff_seconds,omitempty"`
+
+	// MaxAttempts The maximum number of attempts, including the first one. 1 means no retry.
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// Actor The name of the actor the scheduled invocation jobs are sent to
+	Actor     string `json:"actor"`
+	CreatedAt int64  `json:"created_at"`
+
+	// CronExpression A standard 5-field cron expression or a descriptor such as @daily, evaluated in UTC unless prefixed with CRON_TZ=<zone>
+	CronExpression string `json:"cron_expression"`
+	Enabled        bool   `json:"enabled"`
+	Id             int64  `json:"id"`
+
+	// LastError The error of the last run, absent when it succeeded
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastInvocationId The ID of the invocation job created by the last run
+	LastInvocationId *string `json:"last_invocation_id,omitempty"`
+
+	// LastRunAt The time (in unix seconds) of the last run
+	LastRunAt *int64 `json:"last_run_at,omitempty"`
+
+	// Meta The meta template of the scheduled invocation jobs. String values may refer to .ScheduleName, .ScheduledAt and .ScheduledTime in Go template syntax
+	Meta map[string]interface{} `json:"meta"`
+	Name string                 `json:"name"`
+
+	// NextRunAt The time (in unix seconds) of the next run, absent when the schedule is disabled
+	NextRunAt *int64 `json:"next_run_at,omitempty"`
+
+	// Payload The payload template of the scheduled invocation jobs. String values may refer to .ScheduleName, .ScheduledAt and .ScheduledTime in Go template syntax
+	Payload   map[string]interface{} `json:"payload"`
+	UpdatedAt *int64                 `json:"updated_at,omitempty"`
+}
+
+// ScheduleCreate defines model for ScheduleCreate.
+type ScheduleCreate struct {
+	// Actor The name of the actor the scheduled invocation jobs are sent to
+	Actor string `json:"actor"`
+
+	// CronExpression A standard 5-field cron expression or a descriptor such as @daily, evaluated in UTC unless prefixed with CRON_TZ=<zone>
+	CronExpression string `json:"cron_expression"`
+	Enabled        *bool  `json:"enabled,omitempty"`
+
+	// Meta The meta template of the scheduled invocation jobs. String values may refer to .ScheduleName, .ScheduledAt and .ScheduledTime in Go template syntax
+	Meta map[string]interface{} `json:"meta"`
+	Name string                 `json:"name"`
+
+	// Payload The payload template of the scheduled invocation jobs. String values may refer to .ScheduleName, .ScheduledAt and .ScheduledTime in Go template syntax
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
 // Setting defines model for Setting.
 type Setting struct {
 	DeploymentApproveRequired bool    `json:"deployment_approve_required"`
 	DisplayName               string  `json:"display_name"`
+	EnableInvocationArchive   bool    `json:"enable_invocation_archive"`
 	EnableSecretsBackup       bool    `json:"enable_secrets_backup"`
-	SecretsBackupBucket       *string `json:"secrets_backup_bucket,omitempty"`
-	SecretsBackupPrefix       *string `json:"secrets_backup_prefix,omitempty"`
-	SecretsBackupPublicKey    *string `json:"secrets_backup_public_key,omitempty"`
+	InvocationArchiveBucket   *string `json:"invocation_archive_bucket,omitempty"`
+	InvocationArchivePrefix   *string `json:"invocation_archive_prefix,omitempty"`
+
+	// InvocationRetentionCancelledDays The number of days cancelled invocation jobs are kept. They are kept forever if not set or 0.
+	InvocationRetentionCancelledDays *int `json:"invocation_retention_cancelled_days,omitempty"`
+
+	// InvocationRetentionCompletedDays The number of days completed invocation jobs are kept. They are kept forever if not set or 0.
+	InvocationRetentionCompletedDays *int `json:"invocation_retention_completed_days,omitempty"`
+
+	// InvocationRetentionDiscardedDays The number of days discarded invocation jobs are kept. They are kept forever if not set or 0.
+	InvocationRetentionDiscardedDays *int            `json:"invocation_retention_discarded_days,omitempty"`
+	SecretsBackupBucket              *string         `json:"secrets_backup_bucket,omitempty"`
+	SecretsBackupPrefix              *string         `json:"secrets_backup_prefix,omitempty"`
+	SecretsBackupPublicKey           *string         `json:"secrets_backup_public_key,omitempty"`
+	VirtualModels                    *[]VirtualModel `json:"virtual_models,omitempty"`
+}
+
+// TokenUsage The completion usage of an API token on a model in a UTC day.
+type TokenUsage struct {
+	ActorId    int64  `json:"actor_id"`
+	ApiTokenId string `json:"api_token_id"`
+
+	// Cost The cost in USD.
+	Cost float64 `json:"cost"`
+
+	// Day The start of the UTC day in epoch seconds.
+	Day          int64  `json:"day"`
+	InputTokens  int64  `json:"input_tokens"`
+	ModelId      string `json:"model_id"`
+	OutputTokens int64  `json:"output_tokens"`
+	Requests     int64  `json:"requests"`
 }
 
 // Tool The tool that is used to process the message.
@@ -486,12 +1053,61 @@ type Tool struct {
 	Parameters *map[string]interface{} `json:"parameters,omitempty"`
 }
 
+// ToolCallDelta A part of a streamed tool call. The id and name come with the first part of a call, and its JSON encoded arguments are split across the following parts.
+type ToolCallDelta struct {
+	// Arguments The next fragment of the JSON encoded arguments of the tool call.
+	Arguments *string `json:"arguments,omitempty"`
+
+	// Id The ID of the tool call.
+	Id *string `json:"id,omitempty"`
+
+	// Index The position of the tool call among the tool calls of the completion.
+	Index int `json:"index"`
+
+	// Name The name of the called tool.
+	Name *string `json:"name,omitempty"`
+}
+
+// VirtualModel A stable alias of completion models. Each completion is routed to one of its routes in proportion to the weights, and is retried on the other routes and then on the fallbacks when the model is rate limited or unavailable.
+type VirtualModel struct {
+	// Fallbacks The IDs of the completion models tried in order when all the routes fail.
+	Fallbacks *[]string `json:"fallbacks,omitempty"`
+
+	// Id The alias requested as the model_id of completions. It must not be the ID of a completion model.
+	Id string `json:"id"`
+
+	// InitialBackoffMs The delay in milliseconds before the first retry of a model, which doubles on every following retry.
+	InitialBackoffMs *int `json:"initial_backoff_ms,omitempty"`
+
+	// MaxBackoffMs The maximum delay in milliseconds between the retries of a model.
+	MaxBackoffMs *int `json:"max_backoff_ms,omitempty"`
+
+	// MaxRetries The number of times a model is retried on rate limit (429), server errors (5xx) and network failures before the next model is tried.
+	MaxRetries *int                `json:"max_retries,omitempty"`
+	Routes     []VirtualModelRoute `json:"routes"`
+}
+
+// VirtualModelRoute defines model for VirtualModelRoute.
+type VirtualModelRoute struct {
+	// ModelId The ID of the completion model.
+	ModelId string `json:"model_id"`
+
+	// Weight The share of completions routed to the model first. A route of weight 0 is only tried after the others fail.
+	Weight *int `json:"weight,omitempty"`
+}
+
 // N400 defines model for 400.
 type N400 = Error
 
+// N429 defines model for 429.
+type N429 = Error
+
 // N500 defines model for 500.
 type N500 = Error
 
+// N503 defines model for 503.
+type N503 = Error
+
 // AdminListActorsParams defines parameters for AdminListActors.
 type AdminListActorsParams struct {
 	// Page Page number (default 1)
@@ -506,17 +1122,38 @@ type AdminListActorsParams struct {
 
 // AdminUpdateActorJSONBody defines parameters for AdminUpdateActor.
 type AdminUpdateActorJSONBody struct {
-	Configurable *bool                         `json:"configurable,omitempty"`
-	Deployable   *bool                         `json:"deployable,omitempty"`
-	Enabled      *bool                         `json:"enabled,omitempty"`
-	Migratable   *bool                         `json:"migratable,omitempty"`
-	Name         *string                       `json:"name,omitempty"`
-	Role         *AdminUpdateActorJSONBodyRole `json:"role,omitempty"`
+	Configurable *bool `json:"configurable,omitempty"`
+	Deployable   *bool `json:"deployable,omitempty"`
+	Enabled      *bool `json:"enabled,omitempty"`
+
+	// LeaseSeconds How long (in seconds) an invocation may stay running without a heartbeat before it is taken back from the actor. 0 removes the lease, and invocations without a lease are never taken back.
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
+
+	// MaxPriority The most urgent priority the actor can give to the invocation jobs it creates
+	MaxPriority *int    `json:"max_priority,omitempty"`
+	Migratable  *bool   `json:"migratable,omitempty"`
+	Name        *string `json:"name,omitempty"`
+
+	// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+	// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+	RetryPolicy *RetryPolicy                  `json:"retry_policy,omitempty"`
+	Role        *AdminUpdateActorJSONBodyRole `json:"role,omitempty"`
 }
 
 // AdminUpdateActorJSONBodyRole defines parameters for AdminUpdateActor.
 type AdminUpdateActorJSONBodyRole string
 
+// AdminPauseActorQueueJSONBody defines parameters for AdminPauseActorQueue.
+type AdminPauseActorQueueJSONBody struct {
+	// RejectInvocations Whether to reject new invocation jobs while the queue is paused. Default is false.
+	RejectInvocations *bool `json:"reject_invocations,omitempty"`
+}
+
+// AdminSetActorQuotasJSONBody defines parameters for AdminSetActorQuotas.
+type AdminSetActorQuotasJSONBody struct {
+	Quotas []ActorQuotaSet `json:"quotas"`
+}
+
 // AdminListApiTokensParams defines parameters for AdminListApiTokens.
 type AdminListApiTokensParams struct {
 	// Page Page number (default 1)
@@ -539,19 +1176,58 @@ type AdminUpdateConfigJSONBody struct {
 	User            string             `json:"user"`
 }
 
-// AdminListDeploymentsParams defines parameters for AdminListDeployments.
-type AdminListDeploymentsParams struct {
-	// Page Page number (default 1)
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
+// AdminListDeadLettersParams defines parameters for AdminListDeadLetters.
+type AdminListDeadLettersParams struct {
+	// Cursor The next_cursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	// PageSize Page number (default 10)
+	// PageSize Page size (default 10, max 100)
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 
-	// Reviewer Filter by if the given user is a reviewer
-	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty"`
+	// Actor Filter by actor name
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
 
-	// Status Filter by deployment status.
-	Status *AdminListDeploymentsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	// QueueId Filter by queue id
+	QueueId *int64 `form:"queue_id,omitempty" json:"queue_id,omitempty"`
+}
+
+// AdminPurgeDeadLettersJSONBody defines parameters for AdminPurgeDeadLetters.
+type AdminPurgeDeadLettersJSONBody struct {
+	// Actor Delete the discarded invocation jobs of the actor
+	Actor *string `json:"actor,omitempty"`
+
+	// FinalizedBefore Only delete the discarded invocation jobs finalized before the given timestamp
+	FinalizedBefore *int64 `json:"finalized_before,omitempty"`
+
+	// Ids The ids of the discarded invocation jobs to delete
+	Ids *[]string `json:"ids,omitempty"`
+
+	// QueueId Delete the discarded invocation jobs of the queue
+	QueueId *int64 `json:"queue_id,omitempty"`
+}
+
+// AdminReplayDeadLettersJSONBody defines parameters for AdminReplayDeadLetters.
+type AdminReplayDeadLettersJSONBody struct {
+	// Ids The ids of the discarded invocation jobs to replay
+	Ids []string `json:"ids"`
+
+	// Payload The payload of the new invocation jobs. Default is the payload of the discarded ones.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// AdminListDeploymentsParams defines parameters for AdminListDeployments.
+type AdminListDeploymentsParams struct {
+	// Page Page number (default 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Page number (default 10)
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Reviewer Filter by if the given user is a reviewer
+	Reviewer *string `form:"reviewer,omitempty" json:"reviewer,omitempty"`
+
+	// Status Filter by deployment status.
+	Status *AdminListDeploymentsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
 	// Name Filter by deployment name
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
@@ -599,6 +1275,73 @@ type AdminRestartDeploymentJSONBody struct {
 	User string `json:"user"`
 }
 
+// AdminListInvocationsParams defines parameters for AdminListInvocations.
+type AdminListInvocationsParams struct {
+	// Cursor The next_cursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// PageSize Page size (default 10, max 100)
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Actor Filter by actor name
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// QueueId Filter by queue id
+	QueueId *int64 `form:"queue_id,omitempty" json:"queue_id,omitempty"`
+
+	// State Filter by invocation state
+	State *InvocationState `form:"state,omitempty" json:"state,omitempty"`
+
+	// Tag Filter by tags. An invocation job must have all the given tags.
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Kind Filter by meta.kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// TraceId Filter by meta.trace_id
+	TraceId *string `form:"trace_id,omitempty" json:"trace_id,omitempty"`
+
+	// CreatedAfter Filter by invocation jobs created at or after the given timestamp
+	CreatedAfter *int64 `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Filter by invocation jobs created before the given timestamp
+	CreatedBefore *int64 `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// FinalizedAfter Filter by invocation jobs finalized at or after the given timestamp
+	FinalizedAfter *int64 `form:"finalized_after,omitempty" json:"finalized_after,omitempty"`
+
+	// FinalizedBefore Filter by invocation jobs finalized before the given timestamp
+	FinalizedBefore *int64 `form:"finalized_before,omitempty" json:"finalized_before,omitempty"`
+}
+
+// AdminListQueueStatsParams defines parameters for AdminListQueueStats.
+type AdminListQueueStatsParams struct {
+	// Window Window in seconds of the finalized job counts and the wait and run time percentiles (default 3600)
+	Window *int `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// AdminListSchedulesParams defines parameters for AdminListSchedules.
+type AdminListSchedulesParams struct {
+	// Page Page number (default 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Page size (default 10)
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Name Filter by schedule name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// AdminUpdateScheduleJSONBody defines parameters for AdminUpdateSchedule.
+type AdminUpdateScheduleJSONBody struct {
+	Actor          *string                 `json:"actor,omitempty"`
+	CronExpression *string                 `json:"cron_expression,omitempty"`
+	Enabled        *bool                   `json:"enabled,omitempty"`
+	Meta           *map[string]interface{} `json:"meta,omitempty"`
+	Name           *string                 `json:"name,omitempty"`
+	Payload        *map[string]interface{} `json:"payload,omitempty"`
+}
+
 // AdminUpdateSecretJSONBody defines parameters for AdminUpdateSecret.
 type AdminUpdateSecretJSONBody map[string]string
 
@@ -606,7 +1349,25 @@ type AdminUpdateSecretJSONBody map[string]string
 type AdminUpdateSettingJSONBody struct {
 	DeploymentApproveRequired *bool   `json:"deployment_approve_required,omitempty"`
 	DisplayName               *string `json:"display_name,omitempty"`
-	EnableSecretsBackup       *bool   `json:"enable_secrets_backup,omitempty"`
+
+	// EnableInvocationArchive Whether expired invocation jobs are exported to S3 as gzipped JSONL before being deleted
+	EnableInvocationArchive *bool `json:"enable_invocation_archive,omitempty"`
+	EnableSecretsBackup     *bool `json:"enable_secrets_backup,omitempty"`
+
+	// InvocationArchiveBucket The S3 bucket for storing archived invocation jobs
+	InvocationArchiveBucket *string `json:"invocation_archive_bucket,omitempty"`
+
+	// InvocationArchivePrefix The S3 prefix for storing archived invocation jobs
+	InvocationArchivePrefix *string `json:"invocation_archive_prefix,omitempty"`
+
+	// InvocationRetentionCancelledDays The number of days cancelled invocation jobs are kept. 0 keeps them forever.
+	InvocationRetentionCancelledDays *int `json:"invocation_retention_cancelled_days,omitempty"`
+
+	// InvocationRetentionCompletedDays The number of days completed invocation jobs are kept. 0 keeps them forever.
+	InvocationRetentionCompletedDays *int `json:"invocation_retention_completed_days,omitempty"`
+
+	// InvocationRetentionDiscardedDays The number of days discarded invocation jobs are kept. 0 keeps them forever.
+	InvocationRetentionDiscardedDays *int `json:"invocation_retention_discarded_days,omitempty"`
 
 	// SecretsBackupBucket The S3 bucket for storing secrets backup
 	SecretsBackupBucket *string `json:"secrets_backup_bucket,omitempty"`
@@ -616,6 +1377,55 @@ type AdminUpdateSettingJSONBody struct {
 
 	// SecretsBackupPublicKey The public key for encrypting secrets backup
 	SecretsBackupPublicKey *string `json:"secrets_backup_public_key,omitempty"`
+
+	// VirtualModels The aliases of completion models with their routing policies. Their IDs must be unique.
+	VirtualModels *[]VirtualModel `json:"virtual_models,omitempty"`
+}
+
+// AdminListTokenUsagesParams defines parameters for AdminListTokenUsages.
+type AdminListTokenUsagesParams struct {
+	// Page Page number (default 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Page size (default 10)
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// ActorId Filter by actor ID
+	ActorId *int64 `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// From Only the days starting at or after this time in epoch seconds
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only the days starting at or before this time in epoch seconds
+	To *int64 `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// CreateChatCompletionJSONBody defines parameters for CreateChatCompletion.
+type CreateChatCompletionJSONBody struct {
+	// MaxCompletionTokens The maximum number of tokens of the completion. It's 8000 by default.
+	MaxCompletionTokens *int `json:"max_completion_tokens,omitempty"`
+
+	// MaxTokens Deprecated alias of max_completion_tokens.
+	MaxTokens *int                    `json:"max_tokens,omitempty"`
+	Messages  []ChatCompletionMessage `json:"messages"`
+
+	// Model The model id, or the id of a virtual model of the setting.
+	Model string `json:"model"`
+
+	// Stop A stop sequence, or an array of them.
+	Stop *interface{} `json:"stop,omitempty"`
+
+	// Stream Stream the completion as Server-Sent Events while it's generated instead of returning it as a whole.
+	Stream        *bool `json:"stream,omitempty"`
+	StreamOptions *struct {
+		// IncludeUsage Send a last chunk with the usage of the completion and no choices.
+		IncludeUsage *bool `json:"include_usage,omitempty"`
+	} `json:"stream_options,omitempty"`
+	Temperature *float32 `json:"temperature,omitempty"`
+
+	// ToolChoice Either `auto`, `none`, `required`, or `{"type":"function","function":{"name":"..."}}` to force a call of the function. The model decides whether to call the tools by default.
+	ToolChoice *interface{}          `json:"tool_choice,omitempty"`
+	Tools      *[]ChatCompletionTool `json:"tools,omitempty"`
 }
 
 // CreateCompletionJSONBody defines parameters for CreateCompletion.
@@ -623,13 +1433,16 @@ type CreateCompletionJSONBody struct {
 	MaxTokens *int      `json:"max_tokens,omitempty"`
 	Messages  []Message `json:"messages"`
 
-	// ModelId The model id.
+	// ModelId The model id, or the id of a virtual model of the setting.
 	ModelId string `json:"model_id"`
 
 	// StopSequences Custom text sequences that will cause the model to stop generating.
 	StopSequences *[]string `json:"stop_sequences,omitempty"`
-	Temperature   *float32  `json:"temperature,omitempty"`
-	Tools         *[]Tool   `json:"tools,omitempty"`
+
+	// Stream Stream the completion as Server-Sent Events while it's generated instead of returning it as a whole.
+	Stream      *bool    `json:"stream,omitempty"`
+	Temperature *float32 `json:"temperature,omitempty"`
+	Tools       *[]Tool  `json:"tools,omitempty"`
 
 	// TraceId A unique identifier for the request.
 	TraceId string `json:"trace_id"`
@@ -662,22 +1475,24 @@ type CreateEmbeddingJSONBody struct {
 // CreateEmbeddingJSONBodyInputType defines parameters for CreateEmbedding.
 type CreateEmbeddingJSONBodyInputType string
 
-// CreateInvocationAsyncJSONBody defines parameters for CreateInvocationAsync.
-type CreateInvocationAsyncJSONBody struct {
-	// Actor The name of the actor to process the invocation job
-	Actor string `json:"actor"`
-
-	// Meta The metadata of the invocation job. If trace_id is not provided, it will be generated.
-	Meta map[string]interface{} `json:"meta"`
+// CreateInvocationAsyncParams defines parameters for CreateInvocationAsync.
+type CreateInvocationAsyncParams struct {
+	// IdempotencyKey A key unique to the caller which makes retrying the request safe. A request with a key already used by the caller returns the invocation job created the first time. It takes precedence over meta.idempotency_key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
 
-	// Payload The payload for the invocation job
-	Payload map[string]interface{} `json:"payload"`
+// CreateInvocationBatchJSONBody defines parameters for CreateInvocationBatch.
+type CreateInvocationBatchJSONBody struct {
+	Invocations []InvocationCreateRequest `json:"invocations"`
 }
 
 // GetNextInvocationParams defines parameters for GetNextInvocation.
 type GetNextInvocationParams struct {
 	// Wait Maximum time (in seconds) to wait for a job if none are immediately available. Default is 10s.
 	Wait *int `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// BatchSize Maximum number of jobs to return. When given, the jobs are returned in data instead of as a single job.
+	BatchSize *int `form:"batch_size,omitempty" json:"batch_size,omitempty"`
 }
 
 // CreateInvocationSyncJSONBody defines parameters for CreateInvocationSync.
@@ -685,11 +1500,21 @@ type CreateInvocationSyncJSONBody struct {
 	// Actor The name of the actor to process the invocation job
 	Actor string `json:"actor"`
 
-	// Meta The metadata of the invocation job. If trace_id is not provided, it will be generated.
+	// Meta The metadata of the invocation job. If trace_id is not provided, it will be the id of the trace of the request, or generated without one. The W3C trace context of the request is stored as traceparent and tracestate unless provided.
 	Meta map[string]interface{} `json:"meta"`
 
 	// Payload The payload for the invocation job
 	Payload map[string]interface{} `json:"payload"`
+
+	// Priority The priority of the invocation job. 1 is the most urgent. It is capped by the max_priority of the caller. Default is the max_priority of the caller.
+	Priority *int `json:"priority,omitempty"`
+
+	// RetryPolicy The retry policy of invocation jobs. A failed job goes back to the queue until it has been attempted max_attempts times.
+	// The delay before the next attempt starts at backoff_seconds and doubles after every failed attempt, capped at one day.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
+	// Tags The tags of the invocation job
+	Tags *[]string `json:"tags,omitempty"`
 }
 
 // CreateInvocationSyncParams defines parameters for CreateInvocationSync.
@@ -710,6 +1535,12 @@ type ReturnInvocationErrorJSONBody struct {
 	Errors *map[string]interface{} `json:"errors,omitempty"`
 }
 
+// HeartbeatInvocationJSONBody defines parameters for HeartbeatInvocation.
+type HeartbeatInvocationJSONBody struct {
+	// Progress The progress of the invocation job
+	Progress *map[string]interface{} `json:"progress,omitempty"`
+}
+
 // ReturnInvocationResponseJSONBody defines parameters for ReturnInvocationResponse.
 type ReturnInvocationResponseJSONBody struct {
 	// Result The result of the invocation
@@ -726,6 +1557,12 @@ type CreateRerankJSONBody struct {
 
 	// Query The query.
 	Query string `json:"query"`
+
+	// ReturnDocuments Whether to include the text of the documents in the result.
+	ReturnDocuments *bool `json:"return_documents,omitempty"`
+
+	// TopK The number of the most relevant documents to return. All documents are returned if omitted.
+	TopK *int `json:"top_k,omitempty"`
 }
 
 // ListCollectionParams defines parameters for ListCollection.
@@ -739,7 +1576,7 @@ type CreateCollectionJSONBody struct {
 	Fields  []CollectionField  `json:"fields"`
 	Indexes *[]CollectionIndex `json:"indexes,omitempty"`
 
-	// Name The name of the collection.
+	// Name The name of the collection. It must start with a letter or underscore, followed by letters, digits or underscores.
 	Name string `json:"name"`
 }
 
@@ -750,7 +1587,46 @@ type CreateCollectionParams struct {
 }
 
 // UpsertCollectionJSONBody defines parameters for UpsertCollection.
-type UpsertCollectionJSONBody = map[string]interface{}
+type UpsertCollectionJSONBody struct {
+	// Data The data to be upserted. The key and value must be able to match with the collection scheme. The data with an existing primary key replaces the stored one. A vector generated by an embedding model is embedded from its text field if it's omitted.
+	Data []map[string]interface{} `json:"data"`
+}
+
+// UpsertCollectionParams defines parameters for UpsertCollection.
+type UpsertCollectionParams struct {
+	// MAOSVECTORDATABASENAME The name of the database to be accessed.
+	MAOSVECTORDATABASENAME string `json:"MAOS_VECTOR_DATABASE_NAME"`
+}
+
+// QueryCollectionJSONBody defines parameters for QueryCollection.
+type QueryCollectionJSONBody struct {
+	// Filter The metadata filter. Each key is a field name and the data must be equal to the value. An array value matches any of its elements, and a JSON field matches when it contains the value.
+	Filter *map[string]interface{} `json:"filter,omitempty"`
+
+	// OutputFields The fields to return. All the scalar fields are returned if omitted.
+	OutputFields *[]string `json:"output_fields,omitempty"`
+
+	// Rerank Reranks the data found by a text query with a rerank model, on the text field of the embedding.
+	Rerank *CollectionRerank `json:"rerank,omitempty"`
+
+	// Text The query text, which is embedded with the embedding model of the vector field. Either vector or text is required.
+	Text *string `json:"text,omitempty"`
+
+	// TopK The maximum number of data to return.
+	TopK *int `json:"top_k,omitempty"`
+
+	// Vector The query vector. It's an array of numbers for float vectors, an object of index to value for sparse vectors, and a string of 0 and 1 for binary vectors.
+	Vector *interface{} `json:"vector,omitempty"`
+
+	// VectorField The vector field to be searched. It can be omitted if the collection has only one vector field.
+	VectorField *string `json:"vector_field,omitempty"`
+}
+
+// QueryCollectionParams defines parameters for QueryCollection.
+type QueryCollectionParams struct {
+	// MAOSVECTORDATABASENAME The name of the database to be accessed.
+	MAOSVECTORDATABASENAME string `json:"MAOS_VECTOR_DATABASE_NAME"`
+}
 
 // AdminCreateActorJSONRequestBody defines body for AdminCreateActor for application/json ContentType.
 type AdminCreateActorJSONRequestBody = ActorCreate
@@ -758,12 +1634,24 @@ type AdminCreateActorJSONRequestBody = ActorCreate
 // AdminUpdateActorJSONRequestBody defines body for AdminUpdateActor for application/json ContentType.
 type AdminUpdateActorJSONRequestBody AdminUpdateActorJSONBody
 
+// AdminPauseActorQueueJSONRequestBody defines body for AdminPauseActorQueue for application/json ContentType.
+type AdminPauseActorQueueJSONRequestBody AdminPauseActorQueueJSONBody
+
+// AdminSetActorQuotasJSONRequestBody defines body for AdminSetActorQuotas for application/json ContentType.
+type AdminSetActorQuotasJSONRequestBody AdminSetActorQuotasJSONBody
+
 // AdminCreateApiTokenJSONRequestBody defines body for AdminCreateApiToken for application/json ContentType.
 type AdminCreateApiTokenJSONRequestBody = ApiTokenCreate
 
 // AdminUpdateConfigJSONRequestBody defines body for AdminUpdateConfig for application/json ContentType.
 type AdminUpdateConfigJSONRequestBody AdminUpdateConfigJSONBody
 
+// AdminPurgeDeadLettersJSONRequestBody defines body for AdminPurgeDeadLetters for application/json ContentType.
+type AdminPurgeDeadLettersJSONRequestBody AdminPurgeDeadLettersJSONBody
+
+// AdminReplayDeadLettersJSONRequestBody defines body for AdminReplayDeadLetters for application/json ContentType.
+type AdminReplayDeadLettersJSONRequestBody AdminReplayDeadLettersJSONBody
+
 // AdminCreateDeploymentJSONRequestBody defines body for AdminCreateDeployment for application/json ContentType.
 type AdminCreateDeploymentJSONRequestBody AdminCreateDeploymentJSONBody
 
@@ -779,12 +1667,21 @@ type AdminRejectDeploymentJSONRequestBody AdminRejectDeploymentJSONBody
 // AdminRestartDeploymentJSONRequestBody defines body for AdminRestartDeployment for application/json ContentType.
 type AdminRestartDeploymentJSONRequestBody AdminRestartDeploymentJSONBody
 
+// AdminCreateScheduleJSONRequestBody defines body for AdminCreateSchedule for application/json ContentType.
+type AdminCreateScheduleJSONRequestBody = ScheduleCreate
+
+// AdminUpdateScheduleJSONRequestBody defines body for AdminUpdateSchedule for application/json ContentType.
+type AdminUpdateScheduleJSONRequestBody AdminUpdateScheduleJSONBody
+
 // AdminUpdateSecretJSONRequestBody defines body for AdminUpdateSecret for application/json ContentType.
 type AdminUpdateSecretJSONRequestBody AdminUpdateSecretJSONBody
 
 // AdminUpdateSettingJSONRequestBody defines body for AdminUpdateSetting for application/json ContentType.
 type AdminUpdateSettingJSONRequestBody AdminUpdateSettingJSONBody
 
+// CreateChatCompletionJSONRequestBody defines body for CreateChatCompletion for application/json ContentType.
+type CreateChatCompletionJSONRequestBody CreateChatCompletionJSONBody
+
 // CreateCompletionJSONRequestBody defines body for CreateCompletion for application/json ContentType.
 type CreateCompletionJSONRequestBody CreateCompletionJSONBody
 
@@ -792,7 +1689,10 @@ type CreateCompletionJSONRequestBody CreateCompletionJSONBody
 type CreateEmbeddingJSONRequestBody CreateEmbeddingJSONBody
 
 // CreateInvocationAsyncJSONRequestBody defines body for CreateInvocationAsync for application/json ContentType.
-type CreateInvocationAsyncJSONRequestBody CreateInvocationAsyncJSONBody
+type CreateInvocationAsyncJSONRequestBody = InvocationCreateRequest
+
+// CreateInvocationBatchJSONRequestBody defines body for CreateInvocationBatch for application/json ContentType.
+type CreateInvocationBatchJSONRequestBody CreateInvocationBatchJSONBody
 
 // CreateInvocationSyncJSONRequestBody defines body for CreateInvocationSync for application/json ContentType.
 type CreateInvocationSyncJSONRequestBody CreateInvocationSyncJSONBody
@@ -800,6 +1700,9 @@ type CreateInvocationSyncJSONRequestBody CreateInvocationSyncJSONBody
 // ReturnInvocationErrorJSONRequestBody defines body for ReturnInvocationError for application/json ContentType.
 type ReturnInvocationErrorJSONRequestBody ReturnInvocationErrorJSONBody
 
+// HeartbeatInvocationJSONRequestBody defines body for HeartbeatInvocation for application/json ContentType.
+type HeartbeatInvocationJSONRequestBody HeartbeatInvocationJSONBody
+
 // ReturnInvocationResponseJSONRequestBody defines body for ReturnInvocationResponse for application/json ContentType.
 type ReturnInvocationResponseJSONRequestBody ReturnInvocationResponseJSONBody
 
@@ -810,7 +1713,10 @@ type CreateRerankJSONRequestBody CreateRerankJSONBody
 type CreateCollectionJSONRequestBody CreateCollectionJSONBody
 
 // UpsertCollectionJSONRequestBody defines body for UpsertCollection for application/json ContentType.
-type UpsertCollectionJSONRequestBody = UpsertCollectionJSONBody
+type UpsertCollectionJSONRequestBody UpsertCollectionJSONBody
+
+// QueryCollectionJSONRequestBody defines body for QueryCollection for application/json ContentType.
+type QueryCollectionJSONRequestBody QueryCollectionJSONBody
 
 // AsMessageContent0 returns the union data inside the MessageContent as a MessageContent0
 func (t MessageContent) AsMessageContent0() (MessageContent0, error) {
@@ -972,6 +1878,18 @@ type ServerInterface interface {
 	// Update one specific Actor
 	// (PATCH /v1/admin/actors/{id})
 	AdminUpdateActor(w http.ResponseWriter, r *http.Request, id int64)
+	// Pause the queue of an Actor
+	// (POST /v1/admin/actors/{id}/pause)
+	AdminPauseActorQueue(w http.ResponseWriter, r *http.Request, id int64)
+	// List the quotas of an Actor
+	// (GET /v1/admin/actors/{id}/quotas)
+	AdminListActorQuotas(w http.ResponseWriter, r *http.Request, id int64)
+	// Set the quotas of an Actor
+	// (PUT /v1/admin/actors/{id}/quotas)
+	AdminSetActorQuotas(w http.ResponseWriter, r *http.Request, id int64)
+	// Resume the queue of an Actor
+	// (POST /v1/admin/actors/{id}/resume)
+	AdminResumeActorQueue(w http.ResponseWriter, r *http.Request, id int64)
 	// List API tokens
 	// (GET /v1/admin/api_tokens)
 	AdminListApiTokens(w http.ResponseWriter, r *http.Request, params AdminListApiTokensParams)
@@ -984,6 +1902,15 @@ type ServerInterface interface {
 	// Update a specific Config. Only draft configs can be updated.
 	// (PATCH /v1/admin/configs/{id})
 	AdminUpdateConfig(w http.ResponseWriter, r *http.Request, id int64)
+	// List Dead Letters
+	// (GET /v1/admin/dead_letters)
+	AdminListDeadLetters(w http.ResponseWriter, r *http.Request, params AdminListDeadLettersParams)
+	// Purge Dead Letters
+	// (POST /v1/admin/dead_letters/purge)
+	AdminPurgeDeadLetters(w http.ResponseWriter, r *http.Request)
+	// Replay Dead Letters
+	// (POST /v1/admin/dead_letters/replay)
+	AdminReplayDeadLetters(w http.ResponseWriter, r *http.Request)
 	// List Deployments
 	// (GET /v1/admin/deployments)
 	AdminListDeployments(w http.ResponseWriter, r *http.Request, params AdminListDeploymentsParams)
@@ -1014,15 +1941,36 @@ type ServerInterface interface {
 	// Submit the Deployment for reviewing. Only draft deployments can be submitted. After submitting, the deployment will be in `reviewing` status. Reviewers will be notified.
 	// (POST /v1/admin/deployments/{id}/submit)
 	AdminSubmitDeployment(w http.ResponseWriter, r *http.Request, id int64)
+	// List Invocations
+	// (GET /v1/admin/invocations)
+	AdminListInvocations(w http.ResponseWriter, r *http.Request, params AdminListInvocationsParams)
 	// Get pod metrics
 	// (GET /v1/admin/metrics/pods)
 	AdminListPodMetrics(w http.ResponseWriter, r *http.Request)
+	// List Queue Statistics
+	// (GET /v1/admin/queues)
+	AdminListQueueStats(w http.ResponseWriter, r *http.Request, params AdminListQueueStatsParams)
 	// List reference config suites
 	// (GET /v1/admin/reference_config_suites)
 	AdminListReferenceConfigSuites(w http.ResponseWriter, r *http.Request)
 	// Sync reference config suites
 	// (POST /v1/admin/reference_config_suites/sync)
 	AdminSyncReferenceConfigSuites(w http.ResponseWriter, r *http.Request)
+	// List Schedules
+	// (GET /v1/admin/schedules)
+	AdminListSchedules(w http.ResponseWriter, r *http.Request, params AdminListSchedulesParams)
+	// Create a new Schedule
+	// (POST /v1/admin/schedules)
+	AdminCreateSchedule(w http.ResponseWriter, r *http.Request)
+	// Delete one specific Schedule
+	// (DELETE /v1/admin/schedules/{id})
+	AdminDeleteSchedule(w http.ResponseWriter, r *http.Request, id int64)
+	// Get one specific Schedule
+	// (GET /v1/admin/schedules/{id})
+	AdminGetSchedule(w http.ResponseWriter, r *http.Request, id int64)
+	// Update one specific Schedule
+	// (PATCH /v1/admin/schedules/{id})
+	AdminUpdateSchedule(w http.ResponseWriter, r *http.Request, id int64)
 	// List kubernetes secrets
 	// (GET /v1/admin/secrets)
 	AdminListSecrets(w http.ResponseWriter, r *http.Request)
@@ -1038,6 +1986,12 @@ type ServerInterface interface {
 	// Update system setting
 	// (PATCH /v1/admin/setting)
 	AdminUpdateSetting(w http.ResponseWriter, r *http.Request)
+	// List the token usage ledger
+	// (GET /v1/admin/token_usages)
+	AdminListTokenUsages(w http.ResponseWriter, r *http.Request, params AdminListTokenUsagesParams)
+	// Generate chat completion in the OpenAI format.
+	// (POST /v1/chat/completions)
+	CreateChatCompletion(w http.ResponseWriter, r *http.Request)
 	// Generate text completion.
 	// (POST /v1/completion)
 	CreateCompletion(w http.ResponseWriter, r *http.Request)
@@ -1055,7 +2009,10 @@ type ServerInterface interface {
 	ListEmbeddingModels(w http.ResponseWriter, r *http.Request)
 	// Create a new asynchronous invocation job.
 	// (POST /v1/invocations/async)
-	CreateInvocationAsync(w http.ResponseWriter, r *http.Request)
+	CreateInvocationAsync(w http.ResponseWriter, r *http.Request, params CreateInvocationAsyncParams)
+	// Create many asynchronous invocation jobs at once.
+	// (POST /v1/invocations/batch)
+	CreateInvocationBatch(w http.ResponseWriter, r *http.Request)
 	// Retrieve the next available invocation job for processing
 	// (GET /v1/invocations/next)
 	GetNextInvocation(w http.ResponseWriter, r *http.Request, params GetNextInvocationParams)
@@ -1065,9 +2022,15 @@ type ServerInterface interface {
 	// Get the status and result of an invocation job by ID
 	// (GET /v1/invocations/{id})
 	GetInvocationById(w http.ResponseWriter, r *http.Request, id string, params GetInvocationByIdParams)
+	// Cancel an invocation job
+	// (POST /v1/invocations/{id}/cancel)
+	CancelInvocation(w http.ResponseWriter, r *http.Request, id string)
 	// Return invocation error
 	// (POST /v1/invocations/{invoke_id}/error)
 	ReturnInvocationError(w http.ResponseWriter, r *http.Request, invokeId string)
+	// Send invocation heartbeat
+	// (POST /v1/invocations/{invoke_id}/heartbeat)
+	HeartbeatInvocation(w http.ResponseWriter, r *http.Request, invokeId string)
 	// Return invocation result
 	// (POST /v1/invocations/{invoke_id}/response)
 	ReturnInvocationResponse(w http.ResponseWriter, r *http.Request, invokeId string)
@@ -1083,12 +2046,12 @@ type ServerInterface interface {
 	// Create a collection.
 	// (POST /v1/vector/collection)
 	CreateCollection(w http.ResponseWriter, r *http.Request, params CreateCollectionParams)
-	// query data from a collection.
-	// (GET /v1/vector/collection/{name})
-	QueryCollection(w http.ResponseWriter, r *http.Request, name string)
 	// Upsert data into a collection.
 	// (POST /v1/vector/collection/{name})
-	UpsertCollection(w http.ResponseWriter, r *http.Request, name string)
+	UpsertCollection(w http.ResponseWriter, r *http.Request, name string, params UpsertCollectionParams)
+	// Query the most similar data from a collection.
+	// (POST /v1/vector/collection/{name}/query)
+	QueryCollection(w http.ResponseWriter, r *http.Request, name string, params QueryCollectionParams)
 	// List database.
 	// (GET /v1/vector/list)
 	ListVectoreStores(w http.ResponseWriter, r *http.Request)
@@ -1297,56 +2260,30 @@ func (siw *ServerInterfaceWrapper) AdminUpdateActor(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// AdminListApiTokens operation middleware
-func (siw *ServerInterfaceWrapper) AdminListApiTokens(w http.ResponseWriter, r *http.Request) {
+// AdminPauseActorQueue operation middleware
+func (siw *ServerInterfaceWrapper) AdminPauseActorQueue(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, TraceScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListApiTokensParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "page_size" -------------
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "actor_id" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "created_by" -------------
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "created_by", r.URL.Query(), &params.CreatedBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_by", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListApiTokens(w, r, params)
+		siw.Handler.AdminPauseActorQueue(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1356,8 +2293,19 @@ func (siw *ServerInterfaceWrapper) AdminListApiTokens(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// AdminCreateApiToken operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateApiToken(w http.ResponseWriter, r *http.Request) {
+// AdminListActorQuotas operation middleware
+func (siw *ServerInterfaceWrapper) AdminListActorQuotas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1368,7 +2316,7 @@ func (siw *ServerInterfaceWrapper) AdminCreateApiToken(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateApiToken(w, r)
+		siw.Handler.AdminListActorQuotas(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1378,13 +2326,13 @@ func (siw *ServerInterfaceWrapper) AdminCreateApiToken(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// AdminDeleteApiToken operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteApiToken(w http.ResponseWriter, r *http.Request) {
+// AdminSetActorQuotas operation middleware
+func (siw *ServerInterfaceWrapper) AdminSetActorQuotas(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id int64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1401,7 +2349,7 @@ func (siw *ServerInterfaceWrapper) AdminDeleteApiToken(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteApiToken(w, r, id)
+		siw.Handler.AdminSetActorQuotas(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1411,8 +2359,8 @@ func (siw *ServerInterfaceWrapper) AdminDeleteApiToken(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// AdminUpdateConfig operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateConfig(w http.ResponseWriter, r *http.Request) {
+// AdminResumeActorQueue operation middleware
+func (siw *ServerInterfaceWrapper) AdminResumeActorQueue(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1434,7 +2382,7 @@ func (siw *ServerInterfaceWrapper) AdminUpdateConfig(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateConfig(w, r, id)
+		siw.Handler.AdminResumeActorQueue(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1444,8 +2392,8 @@ func (siw *ServerInterfaceWrapper) AdminUpdateConfig(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AdminListDeployments operation middleware
-func (siw *ServerInterfaceWrapper) AdminListDeployments(w http.ResponseWriter, r *http.Request) {
+// AdminListApiTokens operation middleware
+func (siw *ServerInterfaceWrapper) AdminListApiTokens(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1458,7 +2406,7 @@ func (siw *ServerInterfaceWrapper) AdminListDeployments(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AdminListDeploymentsParams
+	var params AdminListApiTokensParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1476,40 +2424,24 @@ func (siw *ServerInterfaceWrapper) AdminListDeployments(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Optional query parameter "reviewer" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "reviewer", r.URL.Query(), &params.Reviewer)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Optional query parameter "actor_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "id" -------------
+	// ------------- Optional query parameter "created_by" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindQueryParameter("form", true, false, "created_by", r.URL.Query(), &params.CreatedBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_by", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListDeployments(w, r, params)
+		siw.Handler.AdminListApiTokens(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1519,8 +2451,8 @@ func (siw *ServerInterfaceWrapper) AdminListDeployments(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// AdminCreateDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminCreateDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminCreateApiToken operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateApiToken(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1531,7 +2463,7 @@ func (siw *ServerInterfaceWrapper) AdminCreateDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminCreateDeployment(w, r)
+		siw.Handler.AdminCreateApiToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1541,13 +2473,13 @@ func (siw *ServerInterfaceWrapper) AdminCreateDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminDeleteDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteApiToken operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteApiToken(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id int64
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1564,7 +2496,7 @@ func (siw *ServerInterfaceWrapper) AdminDeleteDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteDeployment(w, r, id)
+		siw.Handler.AdminDeleteApiToken(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1574,8 +2506,8 @@ func (siw *ServerInterfaceWrapper) AdminDeleteDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminGetDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminUpdateConfig operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateConfig(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1597,7 +2529,7 @@ func (siw *ServerInterfaceWrapper) AdminGetDeployment(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetDeployment(w, r, id)
+		siw.Handler.AdminUpdateConfig(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1607,20 +2539,11 @@ func (siw *ServerInterfaceWrapper) AdminGetDeployment(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// AdminUpdateDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminListDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) AdminListDeadLetters(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1629,31 +2552,55 @@ func (siw *ServerInterfaceWrapper) AdminUpdateDeployment(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateDeployment(w, r, id)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListDeadLettersParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-// AdminPublishDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminPublishDeployment(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "actor" -------------
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "queue_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "queue_id", r.URL.Query(), &params.QueueId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue_id", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdminPurgeDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) AdminPurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1663,7 +2610,7 @@ func (siw *ServerInterfaceWrapper) AdminPublishDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminPublishDeployment(w, r, id)
+		siw.Handler.AdminPurgeDeadLetters(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1673,19 +2620,8 @@ func (siw *ServerInterfaceWrapper) AdminPublishDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminRejectDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminRejectDeployment(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// AdminReplayDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) AdminReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1696,7 +2632,7 @@ func (siw *ServerInterfaceWrapper) AdminRejectDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminRejectDeployment(w, r, id)
+		siw.Handler.AdminReplayDeadLetters(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1706,20 +2642,84 @@ func (siw *ServerInterfaceWrapper) AdminRejectDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminRestartDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminRestartDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminListDeployments operation middleware
+func (siw *ServerInterfaceWrapper) AdminListDeployments(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListDeploymentsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer", r.URL.Query(), &params.Reviewer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminListDeployments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdminCreateDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateDeployment(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1729,7 +2729,7 @@ func (siw *ServerInterfaceWrapper) AdminRestartDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminRestartDeployment(w, r, id)
+		siw.Handler.AdminCreateDeployment(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1739,8 +2739,8 @@ func (siw *ServerInterfaceWrapper) AdminRestartDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminGetDeploymentResult operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetDeploymentResult(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteDeployment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1762,7 +2762,7 @@ func (siw *ServerInterfaceWrapper) AdminGetDeploymentResult(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetDeploymentResult(w, r, id)
+		siw.Handler.AdminDeleteDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1772,8 +2772,8 @@ func (siw *ServerInterfaceWrapper) AdminGetDeploymentResult(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// AdminSubmitDeployment operation middleware
-func (siw *ServerInterfaceWrapper) AdminSubmitDeployment(w http.ResponseWriter, r *http.Request) {
+// AdminGetDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetDeployment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1795,7 +2795,7 @@ func (siw *ServerInterfaceWrapper) AdminSubmitDeployment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminSubmitDeployment(w, r, id)
+		siw.Handler.AdminGetDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1805,31 +2805,20 @@ func (siw *ServerInterfaceWrapper) AdminSubmitDeployment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AdminListPodMetrics operation middleware
-func (siw *ServerInterfaceWrapper) AdminListPodMetrics(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, TraceScopes, []string{})
+// AdminUpdateDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateDeployment(w http.ResponseWriter, r *http.Request) {
 
-	r = r.WithContext(ctx)
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListPodMetrics(w, r)
-	}))
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// AdminListReferenceConfigSuites operation middleware
-func (siw *ServerInterfaceWrapper) AdminListReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1839,7 +2828,7 @@ func (siw *ServerInterfaceWrapper) AdminListReferenceConfigSuites(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListReferenceConfigSuites(w, r)
+		siw.Handler.AdminUpdateDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1849,8 +2838,19 @@ func (siw *ServerInterfaceWrapper) AdminListReferenceConfigSuites(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// AdminSyncReferenceConfigSuites operation middleware
-func (siw *ServerInterfaceWrapper) AdminSyncReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
+// AdminPublishDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminPublishDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1861,7 +2861,7 @@ func (siw *ServerInterfaceWrapper) AdminSyncReferenceConfigSuites(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminSyncReferenceConfigSuites(w, r)
+		siw.Handler.AdminPublishDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1871,8 +2871,19 @@ func (siw *ServerInterfaceWrapper) AdminSyncReferenceConfigSuites(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// AdminListSecrets operation middleware
-func (siw *ServerInterfaceWrapper) AdminListSecrets(w http.ResponseWriter, r *http.Request) {
+// AdminRejectDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminRejectDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1883,7 +2894,7 @@ func (siw *ServerInterfaceWrapper) AdminListSecrets(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminListSecrets(w, r)
+		siw.Handler.AdminRejectDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1893,17 +2904,17 @@ func (siw *ServerInterfaceWrapper) AdminListSecrets(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// AdminDeleteSecret operation middleware
-func (siw *ServerInterfaceWrapper) AdminDeleteSecret(w http.ResponseWriter, r *http.Request) {
+// AdminRestartDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminRestartDeployment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -1916,7 +2927,7 @@ func (siw *ServerInterfaceWrapper) AdminDeleteSecret(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminDeleteSecret(w, r, name)
+		siw.Handler.AdminRestartDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1926,17 +2937,17 @@ func (siw *ServerInterfaceWrapper) AdminDeleteSecret(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AdminUpdateSecret operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateSecret(w http.ResponseWriter, r *http.Request) {
+// AdminGetDeploymentResult operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetDeploymentResult(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -1949,7 +2960,7 @@ func (siw *ServerInterfaceWrapper) AdminUpdateSecret(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateSecret(w, r, name)
+		siw.Handler.AdminGetDeploymentResult(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1959,8 +2970,19 @@ func (siw *ServerInterfaceWrapper) AdminUpdateSecret(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AdminGetSetting operation middleware
-func (siw *ServerInterfaceWrapper) AdminGetSetting(w http.ResponseWriter, r *http.Request) {
+// AdminSubmitDeployment operation middleware
+func (siw *ServerInterfaceWrapper) AdminSubmitDeployment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1971,7 +2993,7 @@ func (siw *ServerInterfaceWrapper) AdminGetSetting(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminGetSetting(w, r)
+		siw.Handler.AdminSubmitDeployment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1981,8 +3003,10 @@ func (siw *ServerInterfaceWrapper) AdminGetSetting(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// AdminUpdateSetting operation middleware
-func (siw *ServerInterfaceWrapper) AdminUpdateSetting(w http.ResponseWriter, r *http.Request) {
+// AdminListInvocations operation middleware
+func (siw *ServerInterfaceWrapper) AdminListInvocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1992,72 +3016,107 @@ func (siw *ServerInterfaceWrapper) AdminUpdateSetting(w http.ResponseWriter, r *
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AdminUpdateSetting(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListInvocationsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-// CreateCompletion operation middleware
-func (siw *ServerInterfaceWrapper) CreateCompletion(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "actor" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, TraceScopes, []string{})
+	// ------------- Optional query parameter "queue_id" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "queue_id", r.URL.Query(), &params.QueueId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue_id", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCompletion(w, r)
-	}))
+	// ------------- Optional query parameter "state" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "tag" -------------
 
-// ListCompletionModels operation middleware
-func (siw *ServerInterfaceWrapper) ListCompletionModels(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "kind" -------------
 
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "trace_id" -------------
 
-	ctx = context.WithValue(ctx, TraceScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "trace_id", r.URL.Query(), &params.TraceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trace_id", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "created_after" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCompletionModelsParams
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
 
-	// ------------- Required query parameter "trace_id" -------------
+	// ------------- Optional query parameter "created_before" -------------
 
-	if paramValue := r.URL.Query().Get("trace_id"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "trace_id"})
+	// ------------- Optional query parameter "finalized_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finalized_after", r.URL.Query(), &params.FinalizedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "finalized_after", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "trace_id", r.URL.Query(), &params.TraceId)
+	// ------------- Optional query parameter "finalized_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finalized_before", r.URL.Query(), &params.FinalizedBefore)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trace_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "finalized_before", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCompletionModels(w, r, params)
+		siw.Handler.AdminListInvocations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2067,10 +3126,8 @@ func (siw *ServerInterfaceWrapper) ListCompletionModels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetCallerConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetCallerConfig(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// AdminListPodMetrics operation middleware
+func (siw *ServerInterfaceWrapper) AdminListPodMetrics(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2080,32 +3137,8 @@ func (siw *ServerInterfaceWrapper) GetCallerConfig(w http.ResponseWriter, r *htt
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCallerConfigParams
-
-	headers := r.Header
-
-	// ------------- Optional header parameter "X-Actor-Version" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Version")]; found {
-		var XActorVersion string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Version", Count: n})
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Version", valueList[0], &XActorVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Version", Err: err})
-			return
-		}
-
-		params.XActorVersion = &XActorVersion
-
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCallerConfig(w, r, params)
+		siw.Handler.AdminListPodMetrics(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2115,8 +3148,10 @@ func (siw *ServerInterfaceWrapper) GetCallerConfig(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEmbedding operation middleware
-func (siw *ServerInterfaceWrapper) CreateEmbedding(w http.ResponseWriter, r *http.Request) {
+// AdminListQueueStats operation middleware
+func (siw *ServerInterfaceWrapper) AdminListQueueStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -2126,8 +3161,19 @@ func (siw *ServerInterfaceWrapper) CreateEmbedding(w http.ResponseWriter, r *htt
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListQueueStatsParams
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", r.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEmbedding(w, r)
+		siw.Handler.AdminListQueueStats(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2137,8 +3183,8 @@ func (siw *ServerInterfaceWrapper) CreateEmbedding(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListEmbeddingModels operation middleware
-func (siw *ServerInterfaceWrapper) ListEmbeddingModels(w http.ResponseWriter, r *http.Request) {
+// AdminListReferenceConfigSuites operation middleware
+func (siw *ServerInterfaceWrapper) AdminListReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2149,7 +3195,7 @@ func (siw *ServerInterfaceWrapper) ListEmbeddingModels(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEmbeddingModels(w, r)
+		siw.Handler.AdminListReferenceConfigSuites(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2159,8 +3205,8 @@ func (siw *ServerInterfaceWrapper) ListEmbeddingModels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateInvocationAsync operation middleware
-func (siw *ServerInterfaceWrapper) CreateInvocationAsync(w http.ResponseWriter, r *http.Request) {
+// AdminSyncReferenceConfigSuites operation middleware
+func (siw *ServerInterfaceWrapper) AdminSyncReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2171,7 +3217,7 @@ func (siw *ServerInterfaceWrapper) CreateInvocationAsync(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateInvocationAsync(w, r)
+		siw.Handler.AdminSyncReferenceConfigSuites(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2181,8 +3227,8 @@ func (siw *ServerInterfaceWrapper) CreateInvocationAsync(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetNextInvocation operation middleware
-func (siw *ServerInterfaceWrapper) GetNextInvocation(w http.ResponseWriter, r *http.Request) {
+// AdminListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) AdminListSchedules(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2195,18 +3241,34 @@ func (siw *ServerInterfaceWrapper) GetNextInvocation(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNextInvocationParams
+	var params AdminListSchedulesParams
 
-	// ------------- Optional query parameter "wait" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNextInvocation(w, r, params)
+		siw.Handler.AdminListSchedules(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2216,10 +3278,8 @@ func (siw *ServerInterfaceWrapper) GetNextInvocation(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateInvocationSync operation middleware
-func (siw *ServerInterfaceWrapper) CreateInvocationSync(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// AdminCreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) AdminCreateSchedule(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2229,19 +3289,8 @@ func (siw *ServerInterfaceWrapper) CreateInvocationSync(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateInvocationSyncParams
-
-	// ------------- Optional query parameter "wait" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateInvocationSync(w, r, params)
+		siw.Handler.AdminCreateSchedule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2251,13 +3300,13 @@ func (siw *ServerInterfaceWrapper) CreateInvocationSync(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetInvocationById operation middleware
-func (siw *ServerInterfaceWrapper) GetInvocationById(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteSchedule operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id int64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -2273,19 +3322,8 @@ func (siw *ServerInterfaceWrapper) GetInvocationById(w http.ResponseWriter, r *h
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInvocationByIdParams
-
-	// ------------- Optional query parameter "wait" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInvocationById(w, r, id, params)
+		siw.Handler.AdminDeleteSchedule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2295,17 +3333,17 @@ func (siw *ServerInterfaceWrapper) GetInvocationById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ReturnInvocationError operation middleware
-func (siw *ServerInterfaceWrapper) ReturnInvocationError(w http.ResponseWriter, r *http.Request) {
+// AdminGetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "invoke_id" -------------
-	var invokeId string
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "invoke_id", mux.Vars(r)["invoke_id"], &invokeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoke_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -2318,7 +3356,7 @@ func (siw *ServerInterfaceWrapper) ReturnInvocationError(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReturnInvocationError(w, r, invokeId)
+		siw.Handler.AdminGetSchedule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2328,17 +3366,17 @@ func (siw *ServerInterfaceWrapper) ReturnInvocationError(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ReturnInvocationResponse operation middleware
-func (siw *ServerInterfaceWrapper) ReturnInvocationResponse(w http.ResponseWriter, r *http.Request) {
+// AdminUpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "invoke_id" -------------
-	var invokeId string
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "invoke_id", mux.Vars(r)["invoke_id"], &invokeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoke_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -2351,7 +3389,7 @@ func (siw *ServerInterfaceWrapper) ReturnInvocationResponse(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReturnInvocationResponse(w, r, invokeId)
+		siw.Handler.AdminUpdateSchedule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2361,8 +3399,8 @@ func (siw *ServerInterfaceWrapper) ReturnInvocationResponse(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRerank operation middleware
-func (siw *ServerInterfaceWrapper) CreateRerank(w http.ResponseWriter, r *http.Request) {
+// AdminListSecrets operation middleware
+func (siw *ServerInterfaceWrapper) AdminListSecrets(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2373,7 +3411,7 @@ func (siw *ServerInterfaceWrapper) CreateRerank(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRerank(w, r)
+		siw.Handler.AdminListSecrets(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2383,8 +3421,19 @@ func (siw *ServerInterfaceWrapper) CreateRerank(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListRerankModels operation middleware
-func (siw *ServerInterfaceWrapper) ListRerankModels(w http.ResponseWriter, r *http.Request) {
+// AdminDeleteSecret operation middleware
+func (siw *ServerInterfaceWrapper) AdminDeleteSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2395,7 +3444,7 @@ func (siw *ServerInterfaceWrapper) ListRerankModels(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRerankModels(w, r)
+		siw.Handler.AdminDeleteSecret(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2405,11 +3454,20 @@ func (siw *ServerInterfaceWrapper) ListRerankModels(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListCollection operation middleware
-func (siw *ServerInterfaceWrapper) ListCollection(w http.ResponseWriter, r *http.Request) {
+// AdminUpdateSecret operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateSecret(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2418,36 +3476,52 @@ func (siw *ServerInterfaceWrapper) ListCollection(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCollectionParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminUpdateSecret(w, r, name)
+	}))
 
-	headers := r.Header
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
-		var MAOSVECTORDATABASENAME string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
-			return
-		}
+	handler.ServeHTTP(w, r)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
-			return
-		}
+// AdminGetSetting operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetSetting(w http.ResponseWriter, r *http.Request) {
 
-		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
+	ctx := r.Context()
 
-	} else {
-		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
-		return
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetSetting(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// AdminUpdateSetting operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateSetting(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCollection(w, r, params)
+		siw.Handler.AdminUpdateSetting(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2457,8 +3531,8 @@ func (siw *ServerInterfaceWrapper) ListCollection(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCollection operation middleware
-func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
+// AdminListTokenUsages operation middleware
+func (siw *ServerInterfaceWrapper) AdminListTokenUsages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2471,35 +3545,50 @@ func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateCollectionParams
+	var params AdminListTokenUsagesParams
 
-	headers := r.Header
+	// ------------- Optional query parameter "page" -------------
 
-	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
-		var MAOSVECTORDATABASENAME string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
-			return
-		}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
-			return
-		}
+	// ------------- Optional query parameter "page_size" -------------
 
-		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-	} else {
-		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCollection(w, r, params)
+		siw.Handler.AdminListTokenUsages(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2509,20 +3598,31 @@ func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// QueryCollection operation middleware
-func (siw *ServerInterfaceWrapper) QueryCollection(w http.ResponseWriter, r *http.Request) {
+// CreateChatCompletion operation middleware
+func (siw *ServerInterfaceWrapper) CreateChatCompletion(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateChatCompletion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCompletion operation middleware
+func (siw *ServerInterfaceWrapper) CreateCompletion(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2532,7 +3632,7 @@ func (siw *ServerInterfaceWrapper) QueryCollection(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryCollection(w, r, name)
+		siw.Handler.CreateCompletion(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2542,20 +3642,53 @@ func (siw *ServerInterfaceWrapper) QueryCollection(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UpsertCollection operation middleware
-func (siw *ServerInterfaceWrapper) UpsertCollection(w http.ResponseWriter, r *http.Request) {
+// ListCompletionModels operation middleware
+func (siw *ServerInterfaceWrapper) ListCompletionModels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCompletionModelsParams
+
+	// ------------- Required query parameter "trace_id" -------------
+
+	if paramValue := r.URL.Query().Get("trace_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "trace_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "trace_id", r.URL.Query(), &params.TraceId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trace_id", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCompletionModels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCallerConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetCallerConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2564,8 +3697,32 @@ func (siw *ServerInterfaceWrapper) UpsertCollection(w http.ResponseWriter, r *ht
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCallerConfigParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Version")]; found {
+		var XActorVersion string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Version", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Version", valueList[0], &XActorVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Version", Err: err})
+			return
+		}
+
+		params.XActorVersion = &XActorVersion
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpsertCollection(w, r, name)
+		siw.Handler.GetCallerConfig(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2575,8 +3732,8 @@ func (siw *ServerInterfaceWrapper) UpsertCollection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListVectoreStores operation middleware
-func (siw *ServerInterfaceWrapper) ListVectoreStores(w http.ResponseWriter, r *http.Request) {
+// CreateEmbedding operation middleware
+func (siw *ServerInterfaceWrapper) CreateEmbedding(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2587,7 +3744,7 @@ func (siw *ServerInterfaceWrapper) ListVectoreStores(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListVectoreStores(w, r)
+		siw.Handler.CreateEmbedding(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2597,2089 +3754,3912 @@ func (siw *ServerInterfaceWrapper) ListVectoreStores(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListEmbeddingModels operation middleware
+func (siw *ServerInterfaceWrapper) ListEmbeddingModels(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEmbeddingModels(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// CreateInvocationAsync operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvocationAsync(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	var err error
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	r = r.WithContext(ctx)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateInvocationAsyncParams
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	headers := r.Header
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvocationAsync(w, r, params)
+	}))
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.HandleFunc(options.BaseURL+"/health", wrapper.GetHealth).Methods("GET")
-
-	r.HandleFunc(options.BaseURL+"/v1/admin/actors", wrapper.AdminListActors).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/actors", wrapper.AdminCreateActor).Methods("POST")
+// CreateInvocationBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvocationBatch(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminDeleteActor).Methods("DELETE")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminGetActor).Methods("GET")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminUpdateActor).Methods("PATCH")
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens", wrapper.AdminListApiTokens).Methods("GET")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens", wrapper.AdminCreateApiToken).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvocationBatch(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens/{id}", wrapper.AdminDeleteApiToken).Methods("DELETE")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/configs/{id}", wrapper.AdminUpdateConfig).Methods("PATCH")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments", wrapper.AdminListDeployments).Methods("GET")
+// GetNextInvocation operation middleware
+func (siw *ServerInterfaceWrapper) GetNextInvocation(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments", wrapper.AdminCreateDeployment).Methods("POST")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminDeleteDeployment).Methods("DELETE")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminGetDeployment).Methods("GET")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminUpdateDeployment).Methods("PATCH")
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/publish", wrapper.AdminPublishDeployment).Methods("POST")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/reject", wrapper.AdminRejectDeployment).Methods("POST")
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNextInvocationParams
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/restart", wrapper.AdminRestartDeployment).Methods("POST")
+	// ------------- Optional query parameter "wait" -------------
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/result", wrapper.AdminGetDeploymentResult).Methods("GET")
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/submit", wrapper.AdminSubmitDeployment).Methods("POST")
+	// ------------- Optional query parameter "batch_size" -------------
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/metrics/pods", wrapper.AdminListPodMetrics).Methods("GET")
+	err = runtime.BindQueryParameter("form", true, false, "batch_size", r.URL.Query(), &params.BatchSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batch_size", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/reference_config_suites", wrapper.AdminListReferenceConfigSuites).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNextInvocation(w, r, params)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/reference_config_suites/sync", wrapper.AdminSyncReferenceConfigSuites).Methods("POST")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/secrets", wrapper.AdminListSecrets).Methods("GET")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/secrets/{name}", wrapper.AdminDeleteSecret).Methods("DELETE")
+// CreateInvocationSync operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvocationSync(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/secrets/{name}", wrapper.AdminUpdateSecret).Methods("PATCH")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/setting", wrapper.AdminGetSetting).Methods("GET")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/admin/setting", wrapper.AdminUpdateSetting).Methods("PATCH")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/completion", wrapper.CreateCompletion).Methods("POST")
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/completion/models", wrapper.ListCompletionModels).Methods("GET")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/config", wrapper.GetCallerConfig).Methods("GET")
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateInvocationSyncParams
 
-	r.HandleFunc(options.BaseURL+"/v1/embedding", wrapper.CreateEmbedding).Methods("POST")
+	// ------------- Optional query parameter "wait" -------------
 
-	r.HandleFunc(options.BaseURL+"/v1/embedding/models", wrapper.ListEmbeddingModels).Methods("GET")
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/async", wrapper.CreateInvocationAsync).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvocationSync(w, r, params)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/next", wrapper.GetNextInvocation).Methods("GET")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/sync", wrapper.CreateInvocationSync).Methods("POST")
+	handler.ServeHTTP(w, r)
+}
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/{id}", wrapper.GetInvocationById).Methods("GET")
+// GetInvocationById operation middleware
+func (siw *ServerInterfaceWrapper) GetInvocationById(w http.ResponseWriter, r *http.Request) {
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/{invoke_id}/error", wrapper.ReturnInvocationError).Methods("POST")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/v1/invocations/{invoke_id}/response", wrapper.ReturnInvocationResponse).Methods("POST")
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	r.HandleFunc(options.BaseURL+"/v1/rerank", wrapper.CreateRerank).Methods("POST")
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/v1/rerank/models", wrapper.ListRerankModels).Methods("GET")
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/v1/vector/collection", wrapper.ListCollection).Methods("GET")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/vector/collection", wrapper.CreateCollection).Methods("POST")
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/v1/vector/collection/{name}", wrapper.QueryCollection).Methods("GET")
+	r = r.WithContext(ctx)
 
-	r.HandleFunc(options.BaseURL+"/v1/vector/collection/{name}", wrapper.UpsertCollection).Methods("POST")
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInvocationByIdParams
 
-	r.HandleFunc(options.BaseURL+"/v1/vector/list", wrapper.ListVectoreStores).Methods("GET")
+	// ------------- Optional query parameter "wait" -------------
 
-	return r
-}
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
 
-type N400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInvocationById(w, r, id, params)
+	}))
 
-type N500JSONResponse Error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetHealthRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+// CancelInvocation operation middleware
+func (siw *ServerInterfaceWrapper) CancelInvocation(w http.ResponseWriter, r *http.Request) {
 
-type GetHealth200JSONResponse struct {
-	// Status The health status of the service. it can be "healthy" or "unhealthy"
-	Status string `json:"status"`
-}
+	var err error
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type AdminListActorsRequestObject struct {
-	Params AdminListActorsParams
-}
+	ctx := r.Context()
 
-type AdminListActorsResponseObject interface {
-	VisitAdminListActorsResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AdminListActors200JSONResponse struct {
-	Data []Actor `json:"data"`
-	Meta struct {
-		TotalPages int `json:"total_pages"`
-	} `json:"meta"`
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-func (response AdminListActors200JSONResponse) VisitAdminListActorsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelInvocation(w, r, id)
+	}))
 
-type AdminListActors401Response struct {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response AdminListActors401Response) VisitAdminListActorsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// ReturnInvocationError operation middleware
+func (siw *ServerInterfaceWrapper) ReturnInvocationError(w http.ResponseWriter, r *http.Request) {
 
-type AdminListActors500JSONResponse struct{ N500JSONResponse }
+	var err error
 
-func (response AdminListActors500JSONResponse) VisitAdminListActorsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "invoke_id" -------------
+	var invokeId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "invoke_id", mux.Vars(r)["invoke_id"], &invokeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoke_id", Err: err})
+		return
+	}
 
-type AdminCreateActorRequestObject struct {
-	Body *AdminCreateActorJSONRequestBody
-}
+	ctx := r.Context()
 
-type AdminCreateActorResponseObject interface {
-	VisitAdminCreateActorResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AdminCreateActor201JSONResponse Actor
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-func (response AdminCreateActor201JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReturnInvocationError(w, r, invokeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type AdminCreateActor400JSONResponse struct{ N400JSONResponse }
+// HeartbeatInvocation operation middleware
+func (siw *ServerInterfaceWrapper) HeartbeatInvocation(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminCreateActor400JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "invoke_id" -------------
+	var invokeId string
 
-type AdminCreateActor401Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "invoke_id", mux.Vars(r)["invoke_id"], &invokeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoke_id", Err: err})
+		return
+	}
 
-func (response AdminCreateActor401Response) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type AdminCreateActor500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminCreateActor500JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type AdminDeleteActorRequestObject struct {
-	Id int64 `json:"id"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeartbeatInvocation(w, r, invokeId)
+	}))
 
-type AdminDeleteActorResponseObject interface {
-	VisitAdminDeleteActorResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AdminDeleteActor200Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response AdminDeleteActor200Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// ReturnInvocationResponse operation middleware
+func (siw *ServerInterfaceWrapper) ReturnInvocationResponse(w http.ResponseWriter, r *http.Request) {
 
-type AdminDeleteActor401Response struct {
-}
+	var err error
 
-func (response AdminDeleteActor401Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Path parameter "invoke_id" -------------
+	var invokeId string
 
-type AdminDeleteActor404Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "invoke_id", mux.Vars(r)["invoke_id"], &invokeId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoke_id", Err: err})
+		return
+	}
 
-func (response AdminDeleteActor404Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	ctx := r.Context()
 
-type AdminDeleteActor409Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminDeleteActor409Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminDeleteActor500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response AdminDeleteActor500JSONResponse) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReturnInvocationResponse(w, r, invokeId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AdminGetActorRequestObject struct {
-	Id int64 `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type AdminGetActorResponseObject interface {
-	VisitAdminGetActorResponse(w http.ResponseWriter) error
-}
+// CreateRerank operation middleware
+func (siw *ServerInterfaceWrapper) CreateRerank(w http.ResponseWriter, r *http.Request) {
 
-type AdminGetActor200JSONResponse struct {
-	Data Actor `json:"data"`
-}
+	ctx := r.Context()
 
-func (response AdminGetActor200JSONResponse) VisitAdminGetActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminGetActor401Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response AdminGetActor401Response) VisitAdminGetActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRerank(w, r)
+	}))
 
-type AdminGetActor404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response AdminGetActor404Response) VisitAdminGetActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type AdminGetActor500JSONResponse struct{ N500JSONResponse }
+// ListRerankModels operation middleware
+func (siw *ServerInterfaceWrapper) ListRerankModels(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminGetActor500JSONResponse) VisitAdminGetActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type AdminUpdateActorRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminUpdateActorJSONRequestBody
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminUpdateActorResponseObject interface {
-	VisitAdminUpdateActorResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type AdminUpdateActor200JSONResponse struct {
-	Data Actor `json:"data"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRerankModels(w, r)
+	}))
 
-func (response AdminUpdateActor200JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AdminUpdateActor400JSONResponse struct{ N400JSONResponse }
+// ListCollection operation middleware
+func (siw *ServerInterfaceWrapper) ListCollection(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminUpdateActor400JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type AdminUpdateActor401Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminUpdateActor401Response) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminUpdateActor404Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response AdminUpdateActor404Response) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCollectionParams
 
-type AdminUpdateActor500JSONResponse struct{ N500JSONResponse }
+	headers := r.Header
 
-func (response AdminUpdateActor500JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
+		var MAOSVECTORDATABASENAME string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+			return
+		}
 
-type AdminListApiTokensRequestObject struct {
-	Params AdminListApiTokensParams
-}
+		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
 
-type AdminListApiTokensResponseObject interface {
-	VisitAdminListApiTokensResponse(w http.ResponseWriter) error
-}
+	} else {
+		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+		return
+	}
 
-type AdminListApiTokens200JSONResponse struct {
-	Data []ApiToken `json:"data"`
-	Meta struct {
-		TotalPages int `json:"total_pages"`
-	} `json:"meta"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCollection(w, r, params)
+	}))
 
-func (response AdminListApiTokens200JSONResponse) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AdminListApiTokens401Response struct {
-}
+// CreateCollection operation middleware
+func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminListApiTokens401Response) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type AdminListApiTokens500JSONResponse struct{ N500JSONResponse }
+	ctx := r.Context()
 
-func (response AdminListApiTokens500JSONResponse) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminCreateApiTokenRequestObject struct {
-	Body *AdminCreateApiTokenJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type AdminCreateApiTokenResponseObject interface {
-	VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCollectionParams
 
-type AdminCreateApiToken201JSONResponse ApiToken
+	headers := r.Header
 
-func (response AdminCreateApiToken201JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
+		var MAOSVECTORDATABASENAME string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+			return
+		}
 
-type AdminCreateApiToken400JSONResponse struct{ N400JSONResponse }
+		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
 
-func (response AdminCreateApiToken400JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	} else {
+		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCollection(w, r, params)
+	}))
 
-type AdminCreateApiToken401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response AdminCreateApiToken401Response) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type AdminCreateApiToken500JSONResponse struct{ N500JSONResponse }
+// UpsertCollection operation middleware
+func (siw *ServerInterfaceWrapper) UpsertCollection(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminCreateApiToken500JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type AdminDeleteApiTokenRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type AdminDeleteApiTokenResponseObject interface {
-	VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type AdminDeleteApiToken204Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminDeleteApiToken204Response) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminDeleteApiToken401Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response AdminDeleteApiToken401Response) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpsertCollectionParams
 
-type AdminDeleteApiToken500JSONResponse struct{ N500JSONResponse }
+	headers := r.Header
 
-func (response AdminDeleteApiToken500JSONResponse) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
+		var MAOSVECTORDATABASENAME string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+			return
+		}
 
-type AdminUpdateConfigRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminUpdateConfigJSONRequestBody
-}
+		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
 
-type AdminUpdateConfigResponseObject interface {
-	VisitAdminUpdateConfigResponse(w http.ResponseWriter) error
-}
+	} else {
+		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+		return
+	}
 
-type AdminUpdateConfig200JSONResponse struct {
-	Data Config `json:"data"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertCollection(w, r, name, params)
+	}))
 
-func (response AdminUpdateConfig200JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AdminUpdateConfig400JSONResponse struct{ N400JSONResponse }
+// QueryCollection operation middleware
+func (siw *ServerInterfaceWrapper) QueryCollection(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminUpdateConfig400JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type AdminUpdateConfig401Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-func (response AdminUpdateConfig401Response) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type AdminUpdateConfig404Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminUpdateConfig404Response) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-type AdminUpdateConfig500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response AdminUpdateConfig500JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryCollectionParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := r.Header
 
-type AdminListDeploymentsRequestObject struct {
-	Params AdminListDeploymentsParams
-}
+	// ------------- Required header parameter "MAOS_VECTOR_DATABASE_NAME" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("MAOS_VECTOR_DATABASE_NAME")]; found {
+		var MAOSVECTORDATABASENAME string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Count: n})
+			return
+		}
 
-type AdminListDeploymentsResponseObject interface {
-	VisitAdminListDeploymentsResponse(w http.ResponseWriter) error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "MAOS_VECTOR_DATABASE_NAME", valueList[0], &MAOSVECTORDATABASENAME, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+			return
+		}
 
-type AdminListDeployments200JSONResponse struct {
-	Data []Deployment `json:"data"`
-	Meta struct {
-		// Page Current page number
-		Page int `json:"page"`
+		params.MAOSVECTORDATABASENAME = MAOSVECTORDATABASENAME
 
-		// PageSize Number of deployments per page
-		PageSize int `json:"page_size"`
+	} else {
+		err = fmt.Errorf("Header parameter MAOS_VECTOR_DATABASE_NAME is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "MAOS_VECTOR_DATABASE_NAME", Err: err})
+		return
+	}
 
-		// Total Total number of deployments
-		Total int64 `json:"total"`
-	} `json:"meta"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryCollection(w, r, name, params)
+	}))
 
-func (response AdminListDeployments200JSONResponse) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AdminListDeployments401Response struct {
-}
+// ListVectoreStores operation middleware
+func (siw *ServerInterfaceWrapper) ListVectoreStores(w http.ResponseWriter, r *http.Request) {
 
-func (response AdminListDeployments401Response) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type AdminListDeployments500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AdminListDeployments500JSONResponse) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, TraceScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type AdminCreateDeploymentRequestObject struct {
-	Body *AdminCreateDeploymentJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVectoreStores(w, r)
+	}))
 
-type AdminCreateDeploymentResponseObject interface {
-	VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AdminCreateDeployment201JSONResponse struct {
-	Data Deployment `json:"data"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response AdminCreateDeployment201JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type AdminCreateDeployment400JSONResponse struct{ N400JSONResponse }
-
-func (response AdminCreateDeployment400JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type AdminCreateDeployment401Response struct {
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-func (response AdminCreateDeployment401Response) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type AdminCreateDeployment500JSONResponse struct{ N500JSONResponse }
-
-func (response AdminCreateDeployment500JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type AdminDeleteDeploymentRequestObject struct {
-	Id int64 `json:"id"`
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type AdminDeleteDeploymentResponseObject interface {
-	VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error
+type RequiredParamError struct {
+	ParamName string
 }
 
-type AdminDeleteDeployment200Response struct {
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-func (response AdminDeleteDeployment200Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type AdminDeleteDeployment401Response struct {
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-func (response AdminDeleteDeployment401Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type AdminDeleteDeployment404Response struct {
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-func (response AdminDeleteDeployment404Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type AdminDeleteDeployment500JSONResponse struct{ N500JSONResponse }
-
-func (response AdminDeleteDeployment500JSONResponse) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type AdminGetDeploymentRequestObject struct {
-	Id int64 `json:"id"`
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type AdminGetDeploymentResponseObject interface {
-	VisitAdminGetDeploymentResponse(w http.ResponseWriter) error
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-type AdminGetDeployment200JSONResponse DeploymentDetail
-
-func (response AdminGetDeployment200JSONResponse) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
 }
 
-type AdminGetDeployment401Response struct {
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-func (response AdminGetDeployment401Response) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
 }
 
-type AdminGetDeployment404Response struct {
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-func (response AdminGetDeployment404Response) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
 
-type AdminGetDeployment500JSONResponse struct{ N500JSONResponse }
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
 
-func (response AdminGetDeployment500JSONResponse) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r.HandleFunc(options.BaseURL+"/health", wrapper.GetHealth).Methods("GET")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors", wrapper.AdminListActors).Methods("GET")
 
-type AdminUpdateDeploymentRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminUpdateDeploymentJSONRequestBody
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors", wrapper.AdminCreateActor).Methods("POST")
 
-type AdminUpdateDeploymentResponseObject interface {
-	VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminDeleteActor).Methods("DELETE")
 
-type AdminUpdateDeployment200JSONResponse struct {
-	Data Deployment `json:"data"`
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminGetActor).Methods("GET")
 
-func (response AdminUpdateDeployment200JSONResponse) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}", wrapper.AdminUpdateActor).Methods("PATCH")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}/pause", wrapper.AdminPauseActorQueue).Methods("POST")
 
-type AdminUpdateDeployment401Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}/quotas", wrapper.AdminListActorQuotas).Methods("GET")
 
-func (response AdminUpdateDeployment401Response) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}/quotas", wrapper.AdminSetActorQuotas).Methods("PUT")
 
-type AdminUpdateDeployment404Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/actors/{id}/resume", wrapper.AdminResumeActorQueue).Methods("POST")
 
-func (response AdminUpdateDeployment404Response) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens", wrapper.AdminListApiTokens).Methods("GET")
 
-type AdminUpdateDeployment500JSONResponse struct{ N500JSONResponse }
+	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens", wrapper.AdminCreateApiToken).Methods("POST")
 
-func (response AdminUpdateDeployment500JSONResponse) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r.HandleFunc(options.BaseURL+"/v1/admin/api_tokens/{id}", wrapper.AdminDeleteApiToken).Methods("DELETE")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/configs/{id}", wrapper.AdminUpdateConfig).Methods("PATCH")
 
-type AdminPublishDeploymentRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminPublishDeploymentJSONRequestBody
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/dead_letters", wrapper.AdminListDeadLetters).Methods("GET")
 
-type AdminPublishDeploymentResponseObject interface {
-	VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/dead_letters/purge", wrapper.AdminPurgeDeadLetters).Methods("POST")
 
-type AdminPublishDeployment201Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/dead_letters/replay", wrapper.AdminReplayDeadLetters).Methods("POST")
 
-func (response AdminPublishDeployment201Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments", wrapper.AdminListDeployments).Methods("GET")
 
-type AdminPublishDeployment400JSONResponse struct{ N400JSONResponse }
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments", wrapper.AdminCreateDeployment).Methods("POST")
 
-func (response AdminPublishDeployment400JSONResponse) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminDeleteDeployment).Methods("DELETE")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminGetDeployment).Methods("GET")
 
-type AdminPublishDeployment401Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}", wrapper.AdminUpdateDeployment).Methods("PATCH")
 
-func (response AdminPublishDeployment401Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/publish", wrapper.AdminPublishDeployment).Methods("POST")
 
-type AdminPublishDeployment404Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/reject", wrapper.AdminRejectDeployment).Methods("POST")
 
-func (response AdminPublishDeployment404Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/restart", wrapper.AdminRestartDeployment).Methods("POST")
 
-type AdminPublishDeployment500JSONResponse struct{ N500JSONResponse }
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/result", wrapper.AdminGetDeploymentResult).Methods("GET")
 
-func (response AdminPublishDeployment500JSONResponse) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r.HandleFunc(options.BaseURL+"/v1/admin/deployments/{id}/submit", wrapper.AdminSubmitDeployment).Methods("POST")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/invocations", wrapper.AdminListInvocations).Methods("GET")
 
-type AdminRejectDeploymentRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminRejectDeploymentJSONRequestBody
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/metrics/pods", wrapper.AdminListPodMetrics).Methods("GET")
 
-type AdminRejectDeploymentResponseObject interface {
-	VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/queues", wrapper.AdminListQueueStats).Methods("GET")
 
-type AdminRejectDeployment201Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/reference_config_suites", wrapper.AdminListReferenceConfigSuites).Methods("GET")
 
-func (response AdminRejectDeployment201Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/reference_config_suites/sync", wrapper.AdminSyncReferenceConfigSuites).Methods("POST")
 
-type AdminRejectDeployment400JSONResponse struct{ N400JSONResponse }
+	r.HandleFunc(options.BaseURL+"/v1/admin/schedules", wrapper.AdminListSchedules).Methods("GET")
 
-func (response AdminRejectDeployment400JSONResponse) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r.HandleFunc(options.BaseURL+"/v1/admin/schedules", wrapper.AdminCreateSchedule).Methods("POST")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/schedules/{id}", wrapper.AdminDeleteSchedule).Methods("DELETE")
 
-type AdminRejectDeployment401Response struct {
-}
+	r.HandleFunc(options.BaseURL+"/v1/admin/schedules/{id}", wrapper.AdminGetSchedule).Methods("GET")
 
-func (response AdminRejectDeployment401Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	r.HandleFunc(options.BaseURL+"/v1/admin/schedules/{id}", wrapper.AdminUpdateSchedule).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/secrets", wrapper.AdminListSecrets).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/secrets/{name}", wrapper.AdminDeleteSecret).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/secrets/{name}", wrapper.AdminUpdateSecret).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/setting", wrapper.AdminGetSetting).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/setting", wrapper.AdminUpdateSetting).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/v1/admin/token_usages", wrapper.AdminListTokenUsages).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/chat/completions", wrapper.CreateChatCompletion).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/completion", wrapper.CreateCompletion).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/completion/models", wrapper.ListCompletionModels).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/config", wrapper.GetCallerConfig).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/embedding", wrapper.CreateEmbedding).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/embedding/models", wrapper.ListEmbeddingModels).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/async", wrapper.CreateInvocationAsync).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/batch", wrapper.CreateInvocationBatch).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/next", wrapper.GetNextInvocation).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/sync", wrapper.CreateInvocationSync).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/{id}", wrapper.GetInvocationById).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/{id}/cancel", wrapper.CancelInvocation).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/{invoke_id}/error", wrapper.ReturnInvocationError).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/{invoke_id}/heartbeat", wrapper.HeartbeatInvocation).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/invocations/{invoke_id}/response", wrapper.ReturnInvocationResponse).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/rerank", wrapper.CreateRerank).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/rerank/models", wrapper.ListRerankModels).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/vector/collection", wrapper.ListCollection).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/v1/vector/collection", wrapper.CreateCollection).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/vector/collection/{name}", wrapper.UpsertCollection).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/vector/collection/{name}/query", wrapper.QueryCollection).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/v1/vector/list", wrapper.ListVectoreStores).Methods("GET")
+
+	return r
 }
 
-type AdminRejectDeployment404Response struct {
+type N400JSONResponse Error
+
+type N429JSONResponse Error
+
+type N500JSONResponse Error
+
+type N503JSONResponse Error
+
+type GetHealthRequestObject struct {
 }
 
-func (response AdminRejectDeployment404Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
 }
 
-type AdminRejectDeployment500JSONResponse struct{ N500JSONResponse }
+type GetHealth200JSONResponse struct {
+	// Status The health status of the service. it can be "healthy" or "unhealthy"
+	Status string `json:"status"`
+}
 
-func (response AdminRejectDeployment500JSONResponse) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminRestartDeploymentRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *AdminRestartDeploymentJSONRequestBody
-}
-
-type AdminRestartDeploymentResponseObject interface {
-	VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error
+type AdminListActorsRequestObject struct {
+	Params AdminListActorsParams
 }
 
-type AdminRestartDeployment201Response struct {
+type AdminListActorsResponseObject interface {
+	VisitAdminListActorsResponse(w http.ResponseWriter) error
 }
 
-func (response AdminRestartDeployment201Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type AdminListActors200JSONResponse struct {
+	Data []Actor `json:"data"`
+	Meta struct {
+		TotalPages int `json:"total_pages"`
+	} `json:"meta"`
 }
 
-type AdminRestartDeployment401Response struct {
-}
+func (response AdminListActors200JSONResponse) VisitAdminListActorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-func (response AdminRestartDeployment401Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminRestartDeployment404Response struct {
+type AdminListActors401Response struct {
 }
 
-func (response AdminRestartDeployment404Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response AdminListActors401Response) VisitAdminListActorsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type AdminRestartDeployment500JSONResponse struct{ N500JSONResponse }
+type AdminListActors500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminRestartDeployment500JSONResponse) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
+func (response AdminListActors500JSONResponse) VisitAdminListActorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminGetDeploymentResultRequestObject struct {
-	Id int64 `json:"id"`
+type AdminCreateActorRequestObject struct {
+	Body *AdminCreateActorJSONRequestBody
 }
 
-type AdminGetDeploymentResultResponseObject interface {
-	VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error
+type AdminCreateActorResponseObject interface {
+	VisitAdminCreateActorResponse(w http.ResponseWriter) error
 }
 
-type AdminGetDeploymentResult200JSONResponse struct {
-	Error  *string                            `json:"error,omitempty"`
-	Logs   *map[string]map[string]interface{} `json:"logs,omitempty"`
-	Status string                             `json:"status"`
-}
+type AdminCreateActor201JSONResponse Actor
 
-func (response AdminGetDeploymentResult200JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+func (response AdminCreateActor201JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminGetDeploymentResult400JSONResponse struct{ N400JSONResponse }
+type AdminCreateActor400JSONResponse struct{ N400JSONResponse }
 
-func (response AdminGetDeploymentResult400JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+func (response AdminCreateActor400JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminGetDeploymentResult401Response struct {
+type AdminCreateActor401Response struct {
 }
 
-func (response AdminGetDeploymentResult401Response) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+func (response AdminCreateActor401Response) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminGetDeploymentResult404Response struct {
-}
-
-func (response AdminGetDeploymentResult404Response) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type AdminGetDeploymentResult500JSONResponse struct{ N500JSONResponse }
+type AdminCreateActor500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminGetDeploymentResult500JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+func (response AdminCreateActor500JSONResponse) VisitAdminCreateActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminSubmitDeploymentRequestObject struct {
+type AdminDeleteActorRequestObject struct {
 	Id int64 `json:"id"`
 }
 
-type AdminSubmitDeploymentResponseObject interface {
-	VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error
+type AdminDeleteActorResponseObject interface {
+	VisitAdminDeleteActorResponse(w http.ResponseWriter) error
 }
 
-type AdminSubmitDeployment200Response struct {
+type AdminDeleteActor200Response struct {
 }
 
-func (response AdminSubmitDeployment200Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
+func (response AdminDeleteActor200Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type AdminSubmitDeployment400JSONResponse struct{ N400JSONResponse }
-
-func (response AdminSubmitDeployment400JSONResponse) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type AdminDeleteActor401Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response AdminDeleteActor401Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type AdminSubmitDeployment401Response struct {
+type AdminDeleteActor404Response struct {
 }
 
-func (response AdminSubmitDeployment401Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response AdminDeleteActor404Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type AdminSubmitDeployment404Response struct {
+type AdminDeleteActor409Response struct {
 }
 
-func (response AdminSubmitDeployment404Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response AdminDeleteActor409Response) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
 	return nil
 }
 
-type AdminSubmitDeployment500JSONResponse struct{ N500JSONResponse }
+type AdminDeleteActor500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminSubmitDeployment500JSONResponse) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
+func (response AdminDeleteActor500JSONResponse) VisitAdminDeleteActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListPodMetricsRequestObject struct {
+type AdminGetActorRequestObject struct {
+	Id int64 `json:"id"`
 }
 
-type AdminListPodMetricsResponseObject interface {
-	VisitAdminListPodMetricsResponse(w http.ResponseWriter) error
+type AdminGetActorResponseObject interface {
+	VisitAdminGetActorResponse(w http.ResponseWriter) error
 }
 
-type AdminListPodMetrics200JSONResponse struct {
-	Pods []PodMetrics `json:"pods"`
+type AdminGetActor200JSONResponse struct {
+	Data Actor `json:"data"`
 }
 
-func (response AdminListPodMetrics200JSONResponse) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+func (response AdminGetActor200JSONResponse) VisitAdminGetActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListPodMetrics401Response struct {
+type AdminGetActor401Response struct {
 }
 
-func (response AdminListPodMetrics401Response) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+func (response AdminGetActor401Response) VisitAdminGetActorResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminListPodMetrics500JSONResponse struct{ N500JSONResponse }
+type AdminGetActor404Response struct {
+}
 
-func (response AdminListPodMetrics500JSONResponse) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+func (response AdminGetActor404Response) VisitAdminGetActorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminGetActor500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminGetActor500JSONResponse) VisitAdminGetActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListReferenceConfigSuitesRequestObject struct {
+type AdminUpdateActorRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminUpdateActorJSONRequestBody
 }
 
-type AdminListReferenceConfigSuitesResponseObject interface {
-	VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error
+type AdminUpdateActorResponseObject interface {
+	VisitAdminUpdateActorResponse(w http.ResponseWriter) error
 }
 
-type AdminListReferenceConfigSuites200JSONResponse struct {
-	Data []ReferenceConfigSuite `json:"data"`
+type AdminUpdateActor200JSONResponse struct {
+	Data Actor `json:"data"`
 }
 
-func (response AdminListReferenceConfigSuites200JSONResponse) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+func (response AdminUpdateActor200JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListReferenceConfigSuites401Response struct {
+type AdminUpdateActor400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminUpdateActor400JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AdminListReferenceConfigSuites401Response) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+type AdminUpdateActor401Response struct {
+}
+
+func (response AdminUpdateActor401Response) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminListReferenceConfigSuites500JSONResponse struct{ N500JSONResponse }
+type AdminUpdateActor404Response struct {
+}
 
-func (response AdminListReferenceConfigSuites500JSONResponse) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+func (response AdminUpdateActor404Response) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminUpdateActor500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminUpdateActor500JSONResponse) VisitAdminUpdateActorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminSyncReferenceConfigSuitesRequestObject struct {
+type AdminPauseActorQueueRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminPauseActorQueueJSONRequestBody
 }
 
-type AdminSyncReferenceConfigSuitesResponseObject interface {
-	VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error
+type AdminPauseActorQueueResponseObject interface {
+	VisitAdminPauseActorQueueResponse(w http.ResponseWriter) error
 }
 
-type AdminSyncReferenceConfigSuites201Response struct {
+type AdminPauseActorQueue200JSONResponse QueuePauseState
+
+func (response AdminPauseActorQueue200JSONResponse) VisitAdminPauseActorQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AdminSyncReferenceConfigSuites201Response) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+type AdminPauseActorQueue401Response struct {
+}
+
+func (response AdminPauseActorQueue401Response) VisitAdminPauseActorQueueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type AdminSyncReferenceConfigSuites401Response struct {
+type AdminPauseActorQueue404Response struct {
 }
 
-func (response AdminSyncReferenceConfigSuites401Response) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response AdminPauseActorQueue404Response) VisitAdminPauseActorQueueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type AdminSyncReferenceConfigSuites500JSONResponse struct{ N500JSONResponse }
+type AdminPauseActorQueue500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminSyncReferenceConfigSuites500JSONResponse) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+func (response AdminPauseActorQueue500JSONResponse) VisitAdminPauseActorQueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListSecretsRequestObject struct {
+type AdminListActorQuotasRequestObject struct {
+	Id int64 `json:"id"`
 }
 
-type AdminListSecretsResponseObject interface {
-	VisitAdminListSecretsResponse(w http.ResponseWriter) error
+type AdminListActorQuotasResponseObject interface {
+	VisitAdminListActorQuotasResponse(w http.ResponseWriter) error
 }
 
-type AdminListSecrets200JSONResponse struct {
-	Data []struct {
-		Keys []string `json:"keys"`
-		Name string   `json:"name"`
-	} `json:"data"`
+type AdminListActorQuotas200JSONResponse struct {
+	Data []ActorQuota `json:"data"`
 }
 
-func (response AdminListSecrets200JSONResponse) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+func (response AdminListActorQuotas200JSONResponse) VisitAdminListActorQuotasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminListSecrets401Response struct {
+type AdminListActorQuotas401Response struct {
 }
 
-func (response AdminListSecrets401Response) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+func (response AdminListActorQuotas401Response) VisitAdminListActorQuotasResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminListSecrets500JSONResponse struct{ N500JSONResponse }
+type AdminListActorQuotas404Response struct {
+}
 
-func (response AdminListSecrets500JSONResponse) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+func (response AdminListActorQuotas404Response) VisitAdminListActorQuotasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminListActorQuotas500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListActorQuotas500JSONResponse) VisitAdminListActorQuotasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminDeleteSecretRequestObject struct {
-	Name string `json:"name"`
+type AdminSetActorQuotasRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminSetActorQuotasJSONRequestBody
 }
 
-type AdminDeleteSecretResponseObject interface {
-	VisitAdminDeleteSecretResponse(w http.ResponseWriter) error
+type AdminSetActorQuotasResponseObject interface {
+	VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error
 }
 
-type AdminDeleteSecret200Response struct {
+type AdminSetActorQuotas200JSONResponse struct {
+	Data []ActorQuota `json:"data"`
 }
 
-func (response AdminDeleteSecret200Response) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
+func (response AdminSetActorQuotas200JSONResponse) VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
-
-type AdminDeleteSecret401Response struct {
-}
 
-func (response AdminDeleteSecret401Response) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminDeleteSecret500JSONResponse struct{ N500JSONResponse }
+type AdminSetActorQuotas400JSONResponse struct{ N400JSONResponse }
 
-func (response AdminDeleteSecret500JSONResponse) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
+func (response AdminSetActorQuotas400JSONResponse) VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminUpdateSecretRequestObject struct {
-	Name string `json:"name"`
-	Body *AdminUpdateSecretJSONRequestBody
-}
-
-type AdminUpdateSecretResponseObject interface {
-	VisitAdminUpdateSecretResponse(w http.ResponseWriter) error
-}
-
-type AdminUpdateSecret200Response struct {
+type AdminSetActorQuotas401Response struct {
 }
 
-func (response AdminUpdateSecret200Response) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response AdminSetActorQuotas401Response) VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type AdminUpdateSecret401Response struct {
+type AdminSetActorQuotas404Response struct {
 }
 
-func (response AdminUpdateSecret401Response) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response AdminSetActorQuotas404Response) VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type AdminUpdateSecret500JSONResponse struct{ N500JSONResponse }
+type AdminSetActorQuotas500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminUpdateSecret500JSONResponse) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
+func (response AdminSetActorQuotas500JSONResponse) VisitAdminSetActorQuotasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminGetSettingRequestObject struct {
+type AdminResumeActorQueueRequestObject struct {
+	Id int64 `json:"id"`
 }
 
-type AdminGetSettingResponseObject interface {
-	VisitAdminGetSettingResponse(w http.ResponseWriter) error
+type AdminResumeActorQueueResponseObject interface {
+	VisitAdminResumeActorQueueResponse(w http.ResponseWriter) error
 }
 
-type AdminGetSetting200JSONResponse Setting
+type AdminResumeActorQueue200JSONResponse QueuePauseState
 
-func (response AdminGetSetting200JSONResponse) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+func (response AdminResumeActorQueue200JSONResponse) VisitAdminResumeActorQueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminGetSetting401Response struct {
+type AdminResumeActorQueue401Response struct {
 }
 
-func (response AdminGetSetting401Response) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+func (response AdminResumeActorQueue401Response) VisitAdminResumeActorQueueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminGetSetting500JSONResponse struct{ N500JSONResponse }
+type AdminResumeActorQueue404Response struct {
+}
 
-func (response AdminGetSetting500JSONResponse) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+func (response AdminResumeActorQueue404Response) VisitAdminResumeActorQueueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminResumeActorQueue500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminResumeActorQueue500JSONResponse) VisitAdminResumeActorQueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminUpdateSettingRequestObject struct {
-	Body *AdminUpdateSettingJSONRequestBody
-}
-
-type AdminUpdateSettingResponseObject interface {
-	VisitAdminUpdateSettingResponse(w http.ResponseWriter) error
+type AdminListApiTokensRequestObject struct {
+	Params AdminListApiTokensParams
 }
 
-type AdminUpdateSetting200Response struct {
+type AdminListApiTokensResponseObject interface {
+	VisitAdminListApiTokensResponse(w http.ResponseWriter) error
 }
 
-func (response AdminUpdateSetting200Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type AdminListApiTokens200JSONResponse struct {
+	Data []ApiToken `json:"data"`
+	Meta struct {
+		TotalPages int `json:"total_pages"`
+	} `json:"meta"`
 }
 
-type AdminUpdateSetting400JSONResponse struct{ N400JSONResponse }
-
-func (response AdminUpdateSetting400JSONResponse) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+func (response AdminListApiTokens200JSONResponse) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AdminUpdateSetting401Response struct {
+type AdminListApiTokens401Response struct {
 }
 
-func (response AdminUpdateSetting401Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+func (response AdminListApiTokens401Response) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AdminUpdateSetting404Response struct {
-}
-
-func (response AdminUpdateSetting404Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type AdminUpdateSetting500JSONResponse struct{ N500JSONResponse }
+type AdminListApiTokens500JSONResponse struct{ N500JSONResponse }
 
-func (response AdminUpdateSetting500JSONResponse) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+func (response AdminListApiTokens500JSONResponse) VisitAdminListApiTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCompletionRequestObject struct {
-	Body *CreateCompletionJSONRequestBody
+type AdminCreateApiTokenRequestObject struct {
+	Body *AdminCreateApiTokenJSONRequestBody
 }
 
-type CreateCompletionResponseObject interface {
-	VisitCreateCompletionResponse(w http.ResponseWriter) error
+type AdminCreateApiTokenResponseObject interface {
+	VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error
 }
 
-type CreateCompletion200JSONResponse struct {
-	Messages []Message `json:"messages"`
-}
+type AdminCreateApiToken201JSONResponse ApiToken
 
-func (response CreateCompletion200JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+func (response AdminCreateApiToken201JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCompletion400JSONResponse struct{ N400JSONResponse }
+type AdminCreateApiToken400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateCompletion400JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+func (response AdminCreateApiToken400JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCompletion401Response struct {
+type AdminCreateApiToken401Response struct {
 }
 
-func (response CreateCompletion401Response) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+func (response AdminCreateApiToken401Response) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateCompletion500JSONResponse struct{ N500JSONResponse }
+type AdminCreateApiToken500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateCompletion500JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+func (response AdminCreateApiToken500JSONResponse) VisitAdminCreateApiTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCompletionModelsRequestObject struct {
-	Params ListCompletionModelsParams
+type AdminDeleteApiTokenRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ListCompletionModelsResponseObject interface {
-	VisitListCompletionModelsResponse(w http.ResponseWriter) error
+type AdminDeleteApiTokenResponseObject interface {
+	VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error
 }
 
-type ListCompletionModels200JSONResponse struct {
-	Data []struct {
-		Id       string `json:"id"`
-		Name     string `json:"name"`
-		Provider string `json:"provider"`
-	} `json:"data"`
+type AdminDeleteApiToken204Response struct {
 }
 
-func (response ListCompletionModels200JSONResponse) VisitListCompletionModelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response AdminDeleteApiToken204Response) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type ListCompletionModels401Response struct {
+type AdminDeleteApiToken401Response struct {
 }
 
-func (response ListCompletionModels401Response) VisitListCompletionModelsResponse(w http.ResponseWriter) error {
+func (response AdminDeleteApiToken401Response) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetCallerConfigRequestObject struct {
-	Params GetCallerConfigParams
-}
+type AdminDeleteApiToken500JSONResponse struct{ N500JSONResponse }
 
-type GetCallerConfigResponseObject interface {
-	VisitGetCallerConfigResponse(w http.ResponseWriter) error
-}
+func (response AdminDeleteApiToken500JSONResponse) VisitAdminDeleteApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-type GetCallerConfig200JSONResponse struct {
-	// Data A key-value structure representing the caller's configuration
-	Data Configuration `json:"data"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetCallerConfig200JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+type AdminUpdateConfigRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminUpdateConfigJSONRequestBody
+}
+
+type AdminUpdateConfigResponseObject interface {
+	VisitAdminUpdateConfigResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateConfig200JSONResponse struct {
+	Data Config `json:"data"`
+}
+
+func (response AdminUpdateConfig200JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCallerConfig400JSONResponse struct{ N400JSONResponse }
+type AdminUpdateConfig400JSONResponse struct{ N400JSONResponse }
 
-func (response GetCallerConfig400JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+func (response AdminUpdateConfig400JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCallerConfig401Response struct {
+type AdminUpdateConfig401Response struct {
 }
 
-func (response GetCallerConfig401Response) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+func (response AdminUpdateConfig401Response) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetCallerConfig404Response struct {
+type AdminUpdateConfig404Response struct {
 }
 
-func (response GetCallerConfig404Response) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+func (response AdminUpdateConfig404Response) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GetCallerConfig500JSONResponse struct{ N500JSONResponse }
+type AdminUpdateConfig500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCallerConfig500JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+func (response AdminUpdateConfig500JSONResponse) VisitAdminUpdateConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEmbeddingRequestObject struct {
-	Body *CreateEmbeddingJSONRequestBody
+type AdminListDeadLettersRequestObject struct {
+	Params AdminListDeadLettersParams
 }
 
-type CreateEmbeddingResponseObject interface {
-	VisitCreateEmbeddingResponse(w http.ResponseWriter) error
+type AdminListDeadLettersResponseObject interface {
+	VisitAdminListDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type CreateEmbedding200JSONResponse struct {
-	// Data The embeddings of the text.
-	Data *[]Embedding `json:"data,omitempty"`
+type AdminListDeadLetters200JSONResponse struct {
+	Data []AdminInvocation `json:"data"`
+	Meta struct {
+		// NextCursor The cursor of the next page, absent on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+	} `json:"meta"`
 }
 
-func (response CreateEmbedding200JSONResponse) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
+func (response AdminListDeadLetters200JSONResponse) VisitAdminListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEmbedding401Response struct {
-}
+type AdminListDeadLetters400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateEmbedding401Response) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response AdminListDeadLetters400JSONResponse) VisitAdminListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type ListEmbeddingModelsRequestObject struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListEmbeddingModelsResponseObject interface {
-	VisitListEmbeddingModelsResponse(w http.ResponseWriter) error
+type AdminListDeadLetters401Response struct {
 }
 
-type ListEmbeddingModels200JSONResponse struct {
-	Data *[]struct {
-		// Dimension The dimension of the output vector.
-		Dimension *int    `json:"dimension,omitempty"`
-		Id        *string `json:"id,omitempty"`
-		Name      *string `json:"name,omitempty"`
-		Provider  *string `json:"provider,omitempty"`
-	} `json:"data,omitempty"`
+func (response AdminListDeadLetters401Response) VisitAdminListDeadLettersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (response ListEmbeddingModels200JSONResponse) VisitListEmbeddingModelsResponse(w http.ResponseWriter) error {
+type AdminListDeadLetters500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListDeadLetters500JSONResponse) VisitAdminListDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListEmbeddingModels401Response struct {
-}
-
-func (response ListEmbeddingModels401Response) VisitListEmbeddingModelsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
-
-type CreateInvocationAsyncRequestObject struct {
-	Body *CreateInvocationAsyncJSONRequestBody
+type AdminPurgeDeadLettersRequestObject struct {
+	Body *AdminPurgeDeadLettersJSONRequestBody
 }
 
-type CreateInvocationAsyncResponseObject interface {
-	VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error
+type AdminPurgeDeadLettersResponseObject interface {
+	VisitAdminPurgeDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type CreateInvocationAsync201JSONResponse struct {
-	// Id The unique identifier of the created invocation job
-	Id string `json:"id"`
+type AdminPurgeDeadLetters200JSONResponse struct {
+	// Purged The number of deleted invocation jobs
+	Purged int64 `json:"purged"`
 }
 
-func (response CreateInvocationAsync201JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+func (response AdminPurgeDeadLetters200JSONResponse) VisitAdminPurgeDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvocationAsync400JSONResponse struct{ N400JSONResponse }
+type AdminPurgeDeadLetters400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateInvocationAsync400JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+func (response AdminPurgeDeadLetters400JSONResponse) VisitAdminPurgeDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvocationAsync401Response struct {
+type AdminPurgeDeadLetters401Response struct {
 }
 
-func (response CreateInvocationAsync401Response) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+func (response AdminPurgeDeadLetters401Response) VisitAdminPurgeDeadLettersResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateInvocationAsync500JSONResponse struct{ N500JSONResponse }
+type AdminPurgeDeadLetters500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateInvocationAsync500JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+func (response AdminPurgeDeadLetters500JSONResponse) VisitAdminPurgeDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNextInvocationRequestObject struct {
-	Params GetNextInvocationParams
+type AdminReplayDeadLettersRequestObject struct {
+	Body *AdminReplayDeadLettersJSONRequestBody
 }
 
-type GetNextInvocationResponseObject interface {
-	VisitGetNextInvocationResponse(w http.ResponseWriter) error
+type AdminReplayDeadLettersResponseObject interface {
+	VisitAdminReplayDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type GetNextInvocation200JSONResponse InvocationJob
+type AdminReplayDeadLetters200JSONResponse struct {
+	Data []DeadLetterReplay `json:"data"`
 
-func (response GetNextInvocation200JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	// Skipped The given ids which were not found, not discarded or already replayed
+	Skipped []string `json:"skipped"`
+}
+
+func (response AdminReplayDeadLetters200JSONResponse) VisitAdminReplayDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNextInvocation400JSONResponse struct{ N400JSONResponse }
+type AdminReplayDeadLetters400JSONResponse struct{ N400JSONResponse }
 
-func (response GetNextInvocation400JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+func (response AdminReplayDeadLetters400JSONResponse) VisitAdminReplayDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNextInvocation401Response struct {
+type AdminReplayDeadLetters401Response struct {
 }
 
-func (response GetNextInvocation401Response) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+func (response AdminReplayDeadLetters401Response) VisitAdminReplayDeadLettersResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetNextInvocation404Response struct {
-}
-
-func (response GetNextInvocation404Response) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type GetNextInvocation500JSONResponse struct{ N500JSONResponse }
+type AdminReplayDeadLetters500JSONResponse struct{ N500JSONResponse }
 
-func (response GetNextInvocation500JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+func (response AdminReplayDeadLetters500JSONResponse) VisitAdminReplayDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvocationSyncRequestObject struct {
-	Params CreateInvocationSyncParams
-	Body   *CreateInvocationSyncJSONRequestBody
+type AdminListDeploymentsRequestObject struct {
+	Params AdminListDeploymentsParams
 }
 
-type CreateInvocationSyncResponseObject interface {
-	VisitCreateInvocationSyncResponse(w http.ResponseWriter) error
+type AdminListDeploymentsResponseObject interface {
+	VisitAdminListDeploymentsResponse(w http.ResponseWriter) error
 }
 
-type CreateInvocationSync201JSONResponse InvocationResult
+type AdminListDeployments200JSONResponse struct {
+	Data []Deployment `json:"data"`
+	Meta struct {
+		// Page Current page number
+		Page int `json:"page"`
 
-func (response CreateInvocationSync201JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+		// PageSize Number of deployments per page
+		PageSize int `json:"page_size"`
 
-	return json.NewEncoder(w).Encode(response)
+		// Total Total number of deployments
+		Total int64 `json:"total"`
+	} `json:"meta"`
 }
 
-type CreateInvocationSync400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateInvocationSync400JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+func (response AdminListDeployments200JSONResponse) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvocationSync401Response struct {
+type AdminListDeployments401Response struct {
 }
 
-func (response CreateInvocationSync401Response) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+func (response AdminListDeployments401Response) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateInvocationSync408Response struct {
-}
-
-func (response CreateInvocationSync408Response) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
-	w.WriteHeader(408)
-	return nil
-}
-
-type CreateInvocationSync500JSONResponse struct{ N500JSONResponse }
+type AdminListDeployments500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateInvocationSync500JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+func (response AdminListDeployments500JSONResponse) VisitAdminListDeploymentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInvocationByIdRequestObject struct {
-	Id     string `json:"id"`
-	Params GetInvocationByIdParams
+type AdminCreateDeploymentRequestObject struct {
+	Body *AdminCreateDeploymentJSONRequestBody
 }
 
-type GetInvocationByIdResponseObject interface {
-	VisitGetInvocationByIdResponse(w http.ResponseWriter) error
+type AdminCreateDeploymentResponseObject interface {
+	VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error
 }
 
-type GetInvocationById200JSONResponse InvocationResult
-
-func (response GetInvocationById200JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type AdminCreateDeployment201JSONResponse struct {
+	Data Deployment `json:"data"`
 }
 
-type GetInvocationById202JSONResponse InvocationResult
-
-func (response GetInvocationById202JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+func (response AdminCreateDeployment201JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInvocationById400JSONResponse struct{ N400JSONResponse }
+type AdminCreateDeployment400JSONResponse struct{ N400JSONResponse }
 
-func (response GetInvocationById400JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+func (response AdminCreateDeployment400JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInvocationById401Response struct {
+type AdminCreateDeployment401Response struct {
 }
 
-func (response GetInvocationById401Response) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+func (response AdminCreateDeployment401Response) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetInvocationById404Response struct {
-}
-
-func (response GetInvocationById404Response) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type GetInvocationById500JSONResponse struct{ N500JSONResponse }
+type AdminCreateDeployment500JSONResponse struct{ N500JSONResponse }
 
-func (response GetInvocationById500JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+func (response AdminCreateDeployment500JSONResponse) VisitAdminCreateDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReturnInvocationErrorRequestObject struct {
-	InvokeId string `json:"invoke_id"`
-	Body     *ReturnInvocationErrorJSONRequestBody
+type AdminDeleteDeploymentRequestObject struct {
+	Id int64 `json:"id"`
 }
 
-type ReturnInvocationErrorResponseObject interface {
-	VisitReturnInvocationErrorResponse(w http.ResponseWriter) error
+type AdminDeleteDeploymentResponseObject interface {
+	VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error
 }
 
-type ReturnInvocationError200Response struct {
+type AdminDeleteDeployment200Response struct {
 }
 
-func (response ReturnInvocationError200Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+func (response AdminDeleteDeployment200Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type ReturnInvocationError400JSONResponse struct{ N400JSONResponse }
-
-func (response ReturnInvocationError400JSONResponse) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ReturnInvocationError401Response struct {
+type AdminDeleteDeployment401Response struct {
 }
 
-func (response ReturnInvocationError401Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+func (response AdminDeleteDeployment401Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ReturnInvocationError404Response struct {
+type AdminDeleteDeployment404Response struct {
 }
 
-func (response ReturnInvocationError404Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+func (response AdminDeleteDeployment404Response) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ReturnInvocationError500JSONResponse struct{ N500JSONResponse }
+type AdminDeleteDeployment500JSONResponse struct{ N500JSONResponse }
 
-func (response ReturnInvocationError500JSONResponse) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+func (response AdminDeleteDeployment500JSONResponse) VisitAdminDeleteDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReturnInvocationResponseRequestObject struct {
-	InvokeId string `json:"invoke_id"`
-	Body     *ReturnInvocationResponseJSONRequestBody
-}
-
-type ReturnInvocationResponseResponseObject interface {
-	VisitReturnInvocationResponseResponse(w http.ResponseWriter) error
-}
-
-type ReturnInvocationResponse200Response struct {
+type AdminGetDeploymentRequestObject struct {
+	Id int64 `json:"id"`
 }
 
-func (response ReturnInvocationResponse200Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type AdminGetDeploymentResponseObject interface {
+	VisitAdminGetDeploymentResponse(w http.ResponseWriter) error
 }
 
-type ReturnInvocationResponse400JSONResponse struct{ N400JSONResponse }
+type AdminGetDeployment200JSONResponse DeploymentDetail
 
-func (response ReturnInvocationResponse400JSONResponse) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+func (response AdminGetDeployment200JSONResponse) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReturnInvocationResponse401Response struct {
+type AdminGetDeployment401Response struct {
 }
 
-func (response ReturnInvocationResponse401Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+func (response AdminGetDeployment401Response) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ReturnInvocationResponse404Response struct {
+type AdminGetDeployment404Response struct {
 }
 
-func (response ReturnInvocationResponse404Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+func (response AdminGetDeployment404Response) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ReturnInvocationResponse500JSONResponse struct{ N500JSONResponse }
+type AdminGetDeployment500JSONResponse struct{ N500JSONResponse }
 
-func (response ReturnInvocationResponse500JSONResponse) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+func (response AdminGetDeployment500JSONResponse) VisitAdminGetDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRerankRequestObject struct {
-	Body *CreateRerankJSONRequestBody
+type AdminUpdateDeploymentRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminUpdateDeploymentJSONRequestBody
 }
 
-type CreateRerankResponseObject interface {
-	VisitCreateRerankResponse(w http.ResponseWriter) error
+type AdminUpdateDeploymentResponseObject interface {
+	VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error
 }
 
-type CreateRerank201JSONResponse struct {
-	Data *[]RerankResult `json:"data,omitempty"`
+type AdminUpdateDeployment200JSONResponse struct {
+	Data Deployment `json:"data"`
 }
 
-func (response CreateRerank201JSONResponse) VisitCreateRerankResponse(w http.ResponseWriter) error {
+func (response AdminUpdateDeployment200JSONResponse) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRerank401Response struct {
+type AdminUpdateDeployment401Response struct {
 }
 
-func (response CreateRerank401Response) VisitCreateRerankResponse(w http.ResponseWriter) error {
+func (response AdminUpdateDeployment401Response) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListRerankModelsRequestObject struct {
+type AdminUpdateDeployment404Response struct {
 }
 
-type ListRerankModelsResponseObject interface {
-	VisitListRerankModelsResponse(w http.ResponseWriter) error
+func (response AdminUpdateDeployment404Response) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type ListRerankModels200JSONResponse struct {
-	Data *[]struct {
-		Id       *string `json:"id,omitempty"`
-		Name     *string `json:"name,omitempty"`
-		Provider *string `json:"provider,omitempty"`
-	} `json:"data,omitempty"`
+type AdminUpdateDeployment500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminUpdateDeployment500JSONResponse) VisitAdminUpdateDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ListRerankModels200JSONResponse) VisitListRerankModelsResponse(w http.ResponseWriter) error {
+type AdminPublishDeploymentRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminPublishDeploymentJSONRequestBody
+}
+
+type AdminPublishDeploymentResponseObject interface {
+	VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error
+}
+
+type AdminPublishDeployment201Response struct {
+}
+
+func (response AdminPublishDeployment201Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AdminPublishDeployment400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminPublishDeployment400JSONResponse) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRerankModels401Response struct {
+type AdminPublishDeployment401Response struct {
 }
 
-func (response ListRerankModels401Response) VisitListRerankModelsResponse(w http.ResponseWriter) error {
+func (response AdminPublishDeployment401Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListCollectionRequestObject struct {
-	Params ListCollectionParams
+type AdminPublishDeployment404Response struct {
 }
 
-type ListCollectionResponseObject interface {
-	VisitListCollectionResponse(w http.ResponseWriter) error
+func (response AdminPublishDeployment404Response) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type ListCollection200JSONResponse struct {
-	// Data The list of collections.
-	Data *[]string `json:"data,omitempty"`
+type AdminPublishDeployment500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminPublishDeployment500JSONResponse) VisitAdminPublishDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ListCollection200JSONResponse) VisitListCollectionResponse(w http.ResponseWriter) error {
+type AdminRejectDeploymentRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminRejectDeploymentJSONRequestBody
+}
+
+type AdminRejectDeploymentResponseObject interface {
+	VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error
+}
+
+type AdminRejectDeployment201Response struct {
+}
+
+func (response AdminRejectDeployment201Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AdminRejectDeployment400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminRejectDeployment400JSONResponse) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCollection401Response struct {
+type AdminRejectDeployment401Response struct {
 }
 
-func (response ListCollection401Response) VisitListCollectionResponse(w http.ResponseWriter) error {
+func (response AdminRejectDeployment401Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type CreateCollectionRequestObject struct {
-	Params CreateCollectionParams
-	Body   *CreateCollectionJSONRequestBody
+type AdminRejectDeployment404Response struct {
 }
 
-type CreateCollectionResponseObject interface {
-	VisitCreateCollectionResponse(w http.ResponseWriter) error
+func (response AdminRejectDeployment404Response) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type CreateCollection200JSONResponse struct {
-	// Data The name of the collection.
-	Data *string `json:"data,omitempty"`
-}
+type AdminRejectDeployment500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateCollection200JSONResponse) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+func (response AdminRejectDeployment500JSONResponse) VisitAdminRejectDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCollection401Response struct {
+type AdminRestartDeploymentRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminRestartDeploymentJSONRequestBody
 }
 
-func (response CreateCollection401Response) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+type AdminRestartDeploymentResponseObject interface {
+	VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error
+}
+
+type AdminRestartDeployment201Response struct {
+}
+
+func (response AdminRestartDeployment201Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AdminRestartDeployment401Response struct {
+}
+
+func (response AdminRestartDeployment401Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type QueryCollectionRequestObject struct {
-	Name string `json:"name"`
+type AdminRestartDeployment404Response struct {
 }
 
-type QueryCollectionResponseObject interface {
-	VisitQueryCollectionResponse(w http.ResponseWriter) error
+func (response AdminRestartDeployment404Response) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminRestartDeployment500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminRestartDeployment500JSONResponse) VisitAdminRestartDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryCollection200JSONResponse map[string]interface{}
+type AdminGetDeploymentResultRequestObject struct {
+	Id int64 `json:"id"`
+}
 
-func (response QueryCollection200JSONResponse) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+type AdminGetDeploymentResultResponseObject interface {
+	VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error
+}
+
+type AdminGetDeploymentResult200JSONResponse struct {
+	Error  *string                            `json:"error,omitempty"`
+	Logs   *map[string]map[string]interface{} `json:"logs,omitempty"`
+	Status string                             `json:"status"`
+}
+
+func (response AdminGetDeploymentResult200JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type QueryCollection401Response struct {
+type AdminGetDeploymentResult400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminGetDeploymentResult400JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response QueryCollection401Response) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+type AdminGetDeploymentResult401Response struct {
+}
+
+func (response AdminGetDeploymentResult401Response) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type UpsertCollectionRequestObject struct {
-	Name string `json:"name"`
-	Body *UpsertCollectionJSONRequestBody
+type AdminGetDeploymentResult404Response struct {
 }
 
-type UpsertCollectionResponseObject interface {
-	VisitUpsertCollectionResponse(w http.ResponseWriter) error
+func (response AdminGetDeploymentResult404Response) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type UpsertCollection200JSONResponse map[string]interface{}
+type AdminGetDeploymentResult500JSONResponse struct{ N500JSONResponse }
 
-func (response UpsertCollection200JSONResponse) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+func (response AdminGetDeploymentResult500JSONResponse) VisitAdminGetDeploymentResultResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminSubmitDeploymentRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type AdminSubmitDeploymentResponseObject interface {
+	VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error
+}
+
+type AdminSubmitDeployment200Response struct {
+}
+
+func (response AdminSubmitDeployment200Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
+
+type AdminSubmitDeployment400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminSubmitDeployment400JSONResponse) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertCollection401Response struct {
+type AdminSubmitDeployment401Response struct {
 }
 
-func (response UpsertCollection401Response) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+func (response AdminSubmitDeployment401Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ListVectoreStoresRequestObject struct {
+type AdminSubmitDeployment404Response struct {
 }
 
-type ListVectoreStoresResponseObject interface {
-	VisitListVectoreStoresResponse(w http.ResponseWriter) error
+func (response AdminSubmitDeployment404Response) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type ListVectoreStores200JSONResponse struct {
-	// Data The list of databases.
-	Data *[]string `json:"data,omitempty"`
-}
+type AdminSubmitDeployment500JSONResponse struct{ N500JSONResponse }
 
-func (response ListVectoreStores200JSONResponse) VisitListVectoreStoresResponse(w http.ResponseWriter) error {
+func (response AdminSubmitDeployment500JSONResponse) VisitAdminSubmitDeploymentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListVectoreStores401Response struct {
+type AdminListInvocationsRequestObject struct {
+	Params AdminListInvocationsParams
 }
 
-func (response ListVectoreStores401Response) VisitListVectoreStoresResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type AdminListInvocationsResponseObject interface {
+	VisitAdminListInvocationsResponse(w http.ResponseWriter) error
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+type AdminListInvocations200JSONResponse struct {
+	Data []AdminInvocation `json:"data"`
+	Meta struct {
+		// NextCursor The cursor of the next page, absent on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+	} `json:"meta"`
+}
+
+func (response AdminListInvocations200JSONResponse) VisitAdminListInvocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListInvocations400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminListInvocations400JSONResponse) VisitAdminListInvocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListInvocations401Response struct {
+}
+
+func (response AdminListInvocations401Response) VisitAdminListInvocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListInvocations500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListInvocations500JSONResponse) VisitAdminListInvocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListPodMetricsRequestObject struct {
+}
+
+type AdminListPodMetricsResponseObject interface {
+	VisitAdminListPodMetricsResponse(w http.ResponseWriter) error
+}
+
+type AdminListPodMetrics200JSONResponse struct {
+	Pods []PodMetrics `json:"pods"`
+}
+
+func (response AdminListPodMetrics200JSONResponse) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListPodMetrics401Response struct {
+}
+
+func (response AdminListPodMetrics401Response) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListPodMetrics500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListPodMetrics500JSONResponse) VisitAdminListPodMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListQueueStatsRequestObject struct {
+	Params AdminListQueueStatsParams
+}
+
+type AdminListQueueStatsResponseObject interface {
+	VisitAdminListQueueStatsResponse(w http.ResponseWriter) error
+}
+
+type AdminListQueueStats200JSONResponse struct {
+	Data []QueueStats `json:"data"`
+}
+
+func (response AdminListQueueStats200JSONResponse) VisitAdminListQueueStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListQueueStats400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminListQueueStats400JSONResponse) VisitAdminListQueueStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListQueueStats401Response struct {
+}
+
+func (response AdminListQueueStats401Response) VisitAdminListQueueStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListQueueStats500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListQueueStats500JSONResponse) VisitAdminListQueueStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListReferenceConfigSuitesRequestObject struct {
+}
+
+type AdminListReferenceConfigSuitesResponseObject interface {
+	VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error
+}
+
+type AdminListReferenceConfigSuites200JSONResponse struct {
+	Data []ReferenceConfigSuite `json:"data"`
+}
+
+func (response AdminListReferenceConfigSuites200JSONResponse) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListReferenceConfigSuites401Response struct {
+}
+
+func (response AdminListReferenceConfigSuites401Response) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListReferenceConfigSuites500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListReferenceConfigSuites500JSONResponse) VisitAdminListReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminSyncReferenceConfigSuitesRequestObject struct {
+}
+
+type AdminSyncReferenceConfigSuitesResponseObject interface {
+	VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error
+}
+
+type AdminSyncReferenceConfigSuites201Response struct {
+}
+
+func (response AdminSyncReferenceConfigSuites201Response) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type AdminSyncReferenceConfigSuites401Response struct {
+}
+
+func (response AdminSyncReferenceConfigSuites401Response) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminSyncReferenceConfigSuites500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminSyncReferenceConfigSuites500JSONResponse) VisitAdminSyncReferenceConfigSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListSchedulesRequestObject struct {
+	Params AdminListSchedulesParams
+}
+
+type AdminListSchedulesResponseObject interface {
+	VisitAdminListSchedulesResponse(w http.ResponseWriter) error
+}
+
+type AdminListSchedules200JSONResponse struct {
+	Data []Schedule `json:"data"`
+	Meta struct {
+		TotalPages int `json:"total_pages"`
+	} `json:"meta"`
+}
+
+func (response AdminListSchedules200JSONResponse) VisitAdminListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListSchedules401Response struct {
+}
+
+func (response AdminListSchedules401Response) VisitAdminListSchedulesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListSchedules500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListSchedules500JSONResponse) VisitAdminListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminCreateScheduleRequestObject struct {
+	Body *AdminCreateScheduleJSONRequestBody
+}
+
+type AdminCreateScheduleResponseObject interface {
+	VisitAdminCreateScheduleResponse(w http.ResponseWriter) error
+}
+
+type AdminCreateSchedule201JSONResponse Schedule
+
+func (response AdminCreateSchedule201JSONResponse) VisitAdminCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminCreateSchedule400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminCreateSchedule400JSONResponse) VisitAdminCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminCreateSchedule401Response struct {
+}
+
+func (response AdminCreateSchedule401Response) VisitAdminCreateScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminCreateSchedule500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminCreateSchedule500JSONResponse) VisitAdminCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDeleteScheduleRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type AdminDeleteScheduleResponseObject interface {
+	VisitAdminDeleteScheduleResponse(w http.ResponseWriter) error
+}
+
+type AdminDeleteSchedule200Response struct {
+}
+
+func (response AdminDeleteSchedule200Response) VisitAdminDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AdminDeleteSchedule401Response struct {
+}
+
+func (response AdminDeleteSchedule401Response) VisitAdminDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminDeleteSchedule404Response struct {
+}
+
+func (response AdminDeleteSchedule404Response) VisitAdminDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminDeleteSchedule500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminDeleteSchedule500JSONResponse) VisitAdminDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetScheduleRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type AdminGetScheduleResponseObject interface {
+	VisitAdminGetScheduleResponse(w http.ResponseWriter) error
+}
+
+type AdminGetSchedule200JSONResponse struct {
+	Data Schedule `json:"data"`
+}
+
+func (response AdminGetSchedule200JSONResponse) VisitAdminGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetSchedule401Response struct {
+}
+
+func (response AdminGetSchedule401Response) VisitAdminGetScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminGetSchedule404Response struct {
+}
+
+func (response AdminGetSchedule404Response) VisitAdminGetScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminGetSchedule500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminGetSchedule500JSONResponse) VisitAdminGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateScheduleRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdminUpdateScheduleJSONRequestBody
+}
+
+type AdminUpdateScheduleResponseObject interface {
+	VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateSchedule200JSONResponse struct {
+	Data Schedule `json:"data"`
+}
+
+func (response AdminUpdateSchedule200JSONResponse) VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateSchedule400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminUpdateSchedule400JSONResponse) VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateSchedule401Response struct {
+}
+
+func (response AdminUpdateSchedule401Response) VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminUpdateSchedule404Response struct {
+}
+
+func (response AdminUpdateSchedule404Response) VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminUpdateSchedule500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminUpdateSchedule500JSONResponse) VisitAdminUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListSecretsRequestObject struct {
+}
+
+type AdminListSecretsResponseObject interface {
+	VisitAdminListSecretsResponse(w http.ResponseWriter) error
+}
+
+type AdminListSecrets200JSONResponse struct {
+	Data []struct {
+		Keys []string `json:"keys"`
+		Name string   `json:"name"`
+	} `json:"data"`
+}
+
+func (response AdminListSecrets200JSONResponse) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListSecrets401Response struct {
+}
+
+func (response AdminListSecrets401Response) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListSecrets500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListSecrets500JSONResponse) VisitAdminListSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminDeleteSecretRequestObject struct {
+	Name string `json:"name"`
+}
+
+type AdminDeleteSecretResponseObject interface {
+	VisitAdminDeleteSecretResponse(w http.ResponseWriter) error
+}
+
+type AdminDeleteSecret200Response struct {
+}
+
+func (response AdminDeleteSecret200Response) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AdminDeleteSecret401Response struct {
+}
+
+func (response AdminDeleteSecret401Response) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminDeleteSecret500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminDeleteSecret500JSONResponse) VisitAdminDeleteSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateSecretRequestObject struct {
+	Name string `json:"name"`
+	Body *AdminUpdateSecretJSONRequestBody
+}
+
+type AdminUpdateSecretResponseObject interface {
+	VisitAdminUpdateSecretResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateSecret200Response struct {
+}
+
+func (response AdminUpdateSecret200Response) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AdminUpdateSecret401Response struct {
+}
+
+func (response AdminUpdateSecret401Response) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminUpdateSecret500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminUpdateSecret500JSONResponse) VisitAdminUpdateSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetSettingRequestObject struct {
+}
+
+type AdminGetSettingResponseObject interface {
+	VisitAdminGetSettingResponse(w http.ResponseWriter) error
+}
+
+type AdminGetSetting200JSONResponse Setting
+
+func (response AdminGetSetting200JSONResponse) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminGetSetting401Response struct {
+}
+
+func (response AdminGetSetting401Response) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminGetSetting500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminGetSetting500JSONResponse) VisitAdminGetSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateSettingRequestObject struct {
+	Body *AdminUpdateSettingJSONRequestBody
+}
+
+type AdminUpdateSettingResponseObject interface {
+	VisitAdminUpdateSettingResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateSetting200Response struct {
+}
+
+func (response AdminUpdateSetting200Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AdminUpdateSetting400JSONResponse struct{ N400JSONResponse }
+
+func (response AdminUpdateSetting400JSONResponse) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminUpdateSetting401Response struct {
+}
+
+func (response AdminUpdateSetting401Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminUpdateSetting404Response struct {
+}
+
+func (response AdminUpdateSetting404Response) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AdminUpdateSetting500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminUpdateSetting500JSONResponse) VisitAdminUpdateSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListTokenUsagesRequestObject struct {
+	Params AdminListTokenUsagesParams
+}
+
+type AdminListTokenUsagesResponseObject interface {
+	VisitAdminListTokenUsagesResponse(w http.ResponseWriter) error
+}
+
+type AdminListTokenUsages200JSONResponse struct {
+	Data []TokenUsage `json:"data"`
+	Meta struct {
+		TotalPages int `json:"total_pages"`
+	} `json:"meta"`
+}
+
+func (response AdminListTokenUsages200JSONResponse) VisitAdminListTokenUsagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdminListTokenUsages401Response struct {
+}
+
+func (response AdminListTokenUsages401Response) VisitAdminListTokenUsagesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AdminListTokenUsages500JSONResponse struct{ N500JSONResponse }
+
+func (response AdminListTokenUsages500JSONResponse) VisitAdminListTokenUsagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChatCompletionRequestObject struct {
+	Body *CreateChatCompletionJSONRequestBody
+}
+
+type CreateChatCompletionResponseObject interface {
+	VisitCreateChatCompletionResponse(w http.ResponseWriter) error
+}
+
+type CreateChatCompletion200JSONResponse ChatCompletion
+
+func (response CreateChatCompletion200JSONResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChatCompletion200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response CreateChatCompletion200TexteventStreamResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type CreateChatCompletion400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateChatCompletion400JSONResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChatCompletion401Response struct {
+}
+
+func (response CreateChatCompletion401Response) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateChatCompletion429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateChatCompletion429JSONResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChatCompletion500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateChatCompletion500JSONResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCompletionRequestObject struct {
+	Body *CreateCompletionJSONRequestBody
+}
+
+type CreateCompletionResponseObject interface {
+	VisitCreateCompletionResponse(w http.ResponseWriter) error
+}
+
+type CreateCompletion200JSONResponse struct {
+	Messages []Message `json:"messages"`
+
+	// Usage The number of tokens consumed by a completion
+	Usage CompletionUsage `json:"usage"`
+}
+
+func (response CreateCompletion200JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCompletion200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response CreateCompletion200TexteventStreamResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type CreateCompletion400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCompletion400JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCompletion401Response struct {
+}
+
+func (response CreateCompletion401Response) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCompletion429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateCompletion429JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCompletion500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCompletion500JSONResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCompletionModelsRequestObject struct {
+	Params ListCompletionModelsParams
+}
+
+type ListCompletionModelsResponseObject interface {
+	VisitListCompletionModelsResponse(w http.ResponseWriter) error
+}
+
+type ListCompletionModels200JSONResponse struct {
+	Data []struct {
+		Id       string `json:"id"`
+		Name     string `json:"name"`
+		Provider string `json:"provider"`
+	} `json:"data"`
+}
+
+func (response ListCompletionModels200JSONResponse) VisitListCompletionModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCompletionModels401Response struct {
+}
+
+func (response ListCompletionModels401Response) VisitListCompletionModelsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCallerConfigRequestObject struct {
+	Params GetCallerConfigParams
+}
+
+type GetCallerConfigResponseObject interface {
+	VisitGetCallerConfigResponse(w http.ResponseWriter) error
+}
+
+type GetCallerConfig200JSONResponse struct {
+	// Data A key-value structure representing the caller's configuration
+	Data Configuration `json:"data"`
+}
+
+func (response GetCallerConfig200JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCallerConfig400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCallerConfig400JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCallerConfig401Response struct {
+}
+
+func (response GetCallerConfig401Response) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCallerConfig404Response struct {
+}
+
+func (response GetCallerConfig404Response) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetCallerConfig500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCallerConfig500JSONResponse) VisitGetCallerConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEmbeddingRequestObject struct {
+	Body *CreateEmbeddingJSONRequestBody
+}
+
+type CreateEmbeddingResponseObject interface {
+	VisitCreateEmbeddingResponse(w http.ResponseWriter) error
+}
+
+type CreateEmbedding200JSONResponse struct {
+	// Data The embeddings of the text.
+	Data *[]Embedding `json:"data,omitempty"`
+}
+
+func (response CreateEmbedding200JSONResponse) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEmbedding400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateEmbedding400JSONResponse) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEmbedding401Response struct {
+}
+
+func (response CreateEmbedding401Response) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateEmbedding500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateEmbedding500JSONResponse) VisitCreateEmbeddingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEmbeddingModelsRequestObject struct {
+}
+
+type ListEmbeddingModelsResponseObject interface {
+	VisitListEmbeddingModelsResponse(w http.ResponseWriter) error
+}
+
+type ListEmbeddingModels200JSONResponse struct {
+	Data []struct {
+		// Dimension The dimension of the output vector.
+		Dimension int    `json:"dimension"`
+		Id        string `json:"id"`
+		Name      string `json:"name"`
+		Provider  string `json:"provider"`
+	} `json:"data"`
+}
+
+func (response ListEmbeddingModels200JSONResponse) VisitListEmbeddingModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEmbeddingModels401Response struct {
+}
+
+func (response ListEmbeddingModels401Response) VisitListEmbeddingModelsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInvocationAsyncRequestObject struct {
+	Params CreateInvocationAsyncParams
+	Body   *CreateInvocationAsyncJSONRequestBody
+}
+
+type CreateInvocationAsyncResponseObject interface {
+	VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error
+}
+
+type CreateInvocationAsync200JSONResponse struct {
+	// Id The unique identifier of the invocation job created the first time
+	Id string `json:"id"`
+
+	// State The state of the invocation job
+	// - available: The job is queued and waiting to be processed.
+	// - running: The job is currently being executed.
+	// - completed: The job has finished successfully.
+	// - cancelled: The job was cancelled before completion.
+	// - discarded: The job was discarded due to an error or system issue.
+	State InvocationState `json:"state"`
+}
+
+func (response CreateInvocationAsync200JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationAsync201JSONResponse struct {
+	// Id The unique identifier of the created invocation job
+	Id string `json:"id"`
+}
+
+func (response CreateInvocationAsync201JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationAsync400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateInvocationAsync400JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationAsync401Response struct {
+}
+
+func (response CreateInvocationAsync401Response) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInvocationAsync500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateInvocationAsync500JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationAsync503JSONResponse struct{ N503JSONResponse }
+
+func (response CreateInvocationAsync503JSONResponse) VisitCreateInvocationAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationBatchRequestObject struct {
+	Body *CreateInvocationBatchJSONRequestBody
+}
+
+type CreateInvocationBatchResponseObject interface {
+	VisitCreateInvocationBatchResponse(w http.ResponseWriter) error
+}
+
+type CreateInvocationBatch201JSONResponse struct {
+	Results []InvocationBatchResult `json:"results"`
+}
+
+func (response CreateInvocationBatch201JSONResponse) VisitCreateInvocationBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationBatch400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateInvocationBatch400JSONResponse) VisitCreateInvocationBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationBatch401Response struct {
+}
+
+func (response CreateInvocationBatch401Response) VisitCreateInvocationBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInvocationBatch500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateInvocationBatch500JSONResponse) VisitCreateInvocationBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNextInvocationRequestObject struct {
+	Params GetNextInvocationParams
+}
+
+type GetNextInvocationResponseObject interface {
+	VisitGetNextInvocationResponse(w http.ResponseWriter) error
+}
+
+type GetNextInvocation200JSONResponse NextInvocation
+
+func (response GetNextInvocation200JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNextInvocation400JSONResponse struct{ N400JSONResponse }
+
+func (response GetNextInvocation400JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNextInvocation401Response struct {
+}
+
+func (response GetNextInvocation401Response) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetNextInvocation404Response struct {
+}
+
+func (response GetNextInvocation404Response) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetNextInvocation500JSONResponse struct{ N500JSONResponse }
+
+func (response GetNextInvocation500JSONResponse) VisitGetNextInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationSyncRequestObject struct {
+	Params CreateInvocationSyncParams
+	Body   *CreateInvocationSyncJSONRequestBody
+}
+
+type CreateInvocationSyncResponseObject interface {
+	VisitCreateInvocationSyncResponse(w http.ResponseWriter) error
+}
+
+type CreateInvocationSync201JSONResponse InvocationResult
+
+func (response CreateInvocationSync201JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationSync400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateInvocationSync400JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationSync401Response struct {
+}
+
+func (response CreateInvocationSync401Response) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInvocationSync408Response struct {
+}
+
+func (response CreateInvocationSync408Response) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.WriteHeader(408)
+	return nil
+}
+
+type CreateInvocationSync500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateInvocationSync500JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvocationSync503JSONResponse struct{ N503JSONResponse }
+
+func (response CreateInvocationSync503JSONResponse) VisitCreateInvocationSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvocationByIdRequestObject struct {
+	Id     string `json:"id"`
+	Params GetInvocationByIdParams
+}
+
+type GetInvocationByIdResponseObject interface {
+	VisitGetInvocationByIdResponse(w http.ResponseWriter) error
+}
+
+type GetInvocationById200JSONResponse InvocationResult
+
+func (response GetInvocationById200JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvocationById202JSONResponse InvocationResult
+
+func (response GetInvocationById202JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvocationById400JSONResponse struct{ N400JSONResponse }
+
+func (response GetInvocationById400JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvocationById401Response struct {
+}
+
+func (response GetInvocationById401Response) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetInvocationById404Response struct {
+}
+
+func (response GetInvocationById404Response) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetInvocationById500JSONResponse struct{ N500JSONResponse }
+
+func (response GetInvocationById500JSONResponse) VisitGetInvocationByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelInvocationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type CancelInvocationResponseObject interface {
+	VisitCancelInvocationResponse(w http.ResponseWriter) error
+}
+
+type CancelInvocation200JSONResponse struct {
+	// Id The unique identifier of the invocation job
+	Id string `json:"id"`
+
+	// State The state of the invocation job
+	// - available: The job is queued and waiting to be processed.
+	// - running: The job is currently being executed.
+	// - completed: The job has finished successfully.
+	// - cancelled: The job was cancelled before completion.
+	// - discarded: The job was discarded due to an error or system issue.
+	State InvocationState `json:"state"`
+}
+
+func (response CancelInvocation200JSONResponse) VisitCancelInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelInvocation401Response struct {
+}
+
+func (response CancelInvocation401Response) VisitCancelInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CancelInvocation404Response struct {
+}
+
+func (response CancelInvocation404Response) VisitCancelInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type CancelInvocation409Response struct {
+}
+
+func (response CancelInvocation409Response) VisitCancelInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type CancelInvocation500JSONResponse struct{ N500JSONResponse }
+
+func (response CancelInvocation500JSONResponse) VisitCancelInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnInvocationErrorRequestObject struct {
+	InvokeId string `json:"invoke_id"`
+	Body     *ReturnInvocationErrorJSONRequestBody
+}
+
+type ReturnInvocationErrorResponseObject interface {
+	VisitReturnInvocationErrorResponse(w http.ResponseWriter) error
+}
+
+type ReturnInvocationError200Response struct {
+}
+
+func (response ReturnInvocationError200Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReturnInvocationError400JSONResponse struct{ N400JSONResponse }
+
+func (response ReturnInvocationError400JSONResponse) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnInvocationError401Response struct {
+}
+
+func (response ReturnInvocationError401Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ReturnInvocationError404Response struct {
+}
+
+func (response ReturnInvocationError404Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ReturnInvocationError409Response struct {
+}
+
+func (response ReturnInvocationError409Response) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ReturnInvocationError500JSONResponse struct{ N500JSONResponse }
+
+func (response ReturnInvocationError500JSONResponse) VisitReturnInvocationErrorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeartbeatInvocationRequestObject struct {
+	InvokeId string `json:"invoke_id"`
+	Body     *HeartbeatInvocationJSONRequestBody
+}
+
+type HeartbeatInvocationResponseObject interface {
+	VisitHeartbeatInvocationResponse(w http.ResponseWriter) error
+}
+
+type HeartbeatInvocation200JSONResponse struct {
+	// Cancelled Whether the invocation job has been cancelled. The actor should stop working on it.
+	Cancelled bool `json:"cancelled"`
+
+	// LeaseExpiresAt The timestamp when the extended lease expires. Omitted when the invocation has no lease.
+	LeaseExpiresAt *int64 `json:"lease_expires_at,omitempty"`
+}
+
+func (response HeartbeatInvocation200JSONResponse) VisitHeartbeatInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeartbeatInvocation400JSONResponse struct{ N400JSONResponse }
+
+func (response HeartbeatInvocation400JSONResponse) VisitHeartbeatInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeartbeatInvocation401Response struct {
+}
+
+func (response HeartbeatInvocation401Response) VisitHeartbeatInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type HeartbeatInvocation404Response struct {
+}
+
+func (response HeartbeatInvocation404Response) VisitHeartbeatInvocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type HeartbeatInvocation500JSONResponse struct{ N500JSONResponse }
+
+func (response HeartbeatInvocation500JSONResponse) VisitHeartbeatInvocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnInvocationResponseRequestObject struct {
+	InvokeId string `json:"invoke_id"`
+	Body     *ReturnInvocationResponseJSONRequestBody
+}
+
+type ReturnInvocationResponseResponseObject interface {
+	VisitReturnInvocationResponseResponse(w http.ResponseWriter) error
+}
+
+type ReturnInvocationResponse200Response struct {
+}
+
+func (response ReturnInvocationResponse200Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReturnInvocationResponse400JSONResponse struct{ N400JSONResponse }
+
+func (response ReturnInvocationResponse400JSONResponse) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnInvocationResponse401Response struct {
+}
+
+func (response ReturnInvocationResponse401Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ReturnInvocationResponse404Response struct {
+}
+
+func (response ReturnInvocationResponse404Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ReturnInvocationResponse409Response struct {
+}
+
+func (response ReturnInvocationResponse409Response) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ReturnInvocationResponse500JSONResponse struct{ N500JSONResponse }
+
+func (response ReturnInvocationResponse500JSONResponse) VisitReturnInvocationResponseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRerankRequestObject struct {
+	Body *CreateRerankJSONRequestBody
+}
+
+type CreateRerankResponseObject interface {
+	VisitCreateRerankResponse(w http.ResponseWriter) error
+}
+
+type CreateRerank201JSONResponse struct {
+	// Data The documents in descending order of relevance.
+	Data []RerankResult `json:"data"`
+}
+
+func (response CreateRerank201JSONResponse) VisitCreateRerankResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRerank400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateRerank400JSONResponse) VisitCreateRerankResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRerank401Response struct {
+}
+
+func (response CreateRerank401Response) VisitCreateRerankResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateRerank500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateRerank500JSONResponse) VisitCreateRerankResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRerankModelsRequestObject struct {
+}
+
+type ListRerankModelsResponseObject interface {
+	VisitListRerankModelsResponse(w http.ResponseWriter) error
+}
+
+type ListRerankModels200JSONResponse struct {
+	Data []struct {
+		Id       string `json:"id"`
+		Name     string `json:"name"`
+		Provider string `json:"provider"`
+	} `json:"data"`
+}
+
+func (response ListRerankModels200JSONResponse) VisitListRerankModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRerankModels401Response struct {
+}
+
+func (response ListRerankModels401Response) VisitListRerankModelsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListCollectionRequestObject struct {
+	Params ListCollectionParams
+}
+
+type ListCollectionResponseObject interface {
+	VisitListCollectionResponse(w http.ResponseWriter) error
+}
+
+type ListCollection200JSONResponse struct {
+	// Data The list of collections.
+	Data []string `json:"data"`
+}
+
+func (response ListCollection200JSONResponse) VisitListCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCollection401Response struct {
+}
+
+func (response ListCollection401Response) VisitListCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListCollection500JSONResponse struct{ N500JSONResponse }
+
+func (response ListCollection500JSONResponse) VisitListCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCollectionRequestObject struct {
+	Params CreateCollectionParams
+	Body   *CreateCollectionJSONRequestBody
+}
+
+type CreateCollectionResponseObject interface {
+	VisitCreateCollectionResponse(w http.ResponseWriter) error
+}
+
+type CreateCollection200JSONResponse struct {
+	// Data The name of the collection.
+	Data string `json:"data"`
+}
+
+func (response CreateCollection200JSONResponse) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCollection400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCollection400JSONResponse) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCollection401Response struct {
+}
+
+func (response CreateCollection401Response) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCollection409Response struct {
+}
+
+func (response CreateCollection409Response) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type CreateCollection500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCollection500JSONResponse) VisitCreateCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertCollectionRequestObject struct {
+	Name   string `json:"name"`
+	Params UpsertCollectionParams
+	Body   *UpsertCollectionJSONRequestBody
+}
+
+type UpsertCollectionResponseObject interface {
+	VisitUpsertCollectionResponse(w http.ResponseWriter) error
+}
+
+type UpsertCollection200JSONResponse struct {
+	// UpsertCount The number of upserted data.
+	UpsertCount int `json:"upsert_count"`
+}
+
+func (response UpsertCollection200JSONResponse) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertCollection400JSONResponse struct{ N400JSONResponse }
+
+func (response UpsertCollection400JSONResponse) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpsertCollection401Response struct {
+}
+
+func (response UpsertCollection401Response) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpsertCollection404Response struct {
+}
+
+func (response UpsertCollection404Response) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpsertCollection500JSONResponse struct{ N500JSONResponse }
+
+func (response UpsertCollection500JSONResponse) VisitUpsertCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCollectionRequestObject struct {
+	Name   string `json:"name"`
+	Params QueryCollectionParams
+	Body   *QueryCollectionJSONRequestBody
+}
+
+type QueryCollectionResponseObject interface {
+	VisitQueryCollectionResponse(w http.ResponseWriter) error
+}
+
+type QueryCollection200JSONResponse struct {
+	// Data The data in ascending order of distance, or in descending order of relevance if reranked.
+	Data []CollectionHit `json:"data"`
+}
+
+func (response QueryCollection200JSONResponse) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCollection400JSONResponse struct{ N400JSONResponse }
+
+func (response QueryCollection400JSONResponse) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryCollection401Response struct {
+}
+
+func (response QueryCollection401Response) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type QueryCollection404Response struct {
+}
+
+func (response QueryCollection404Response) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type QueryCollection500JSONResponse struct{ N500JSONResponse }
+
+func (response QueryCollection500JSONResponse) VisitQueryCollectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVectoreStoresRequestObject struct {
+}
+
+type ListVectoreStoresResponseObject interface {
+	VisitListVectoreStoresResponse(w http.ResponseWriter) error
+}
+
+type ListVectoreStores200JSONResponse struct {
+	// Data The list of databases which have at least one collection.
+	Data []string `json:"data"`
+}
+
+func (response ListVectoreStores200JSONResponse) VisitListVectoreStoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVectoreStores401Response struct {
+}
+
+func (response ListVectoreStores401Response) VisitListVectoreStoresResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListVectoreStores500JSONResponse struct{ N500JSONResponse }
+
+func (response ListVectoreStores500JSONResponse) VisitListVectoreStoresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 	// Get health status
 	// (GET /health)
 	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
@@ -4698,6 +7678,18 @@ type StrictServerInterface interface {
 	// Update one specific Actor
 	// (PATCH /v1/admin/actors/{id})
 	AdminUpdateActor(ctx context.Context, request AdminUpdateActorRequestObject) (AdminUpdateActorResponseObject, error)
+	// Pause the queue of an Actor
+	// (POST /v1/admin/actors/{id}/pause)
+	AdminPauseActorQueue(ctx context.Context, request AdminPauseActorQueueRequestObject) (AdminPauseActorQueueResponseObject, error)
+	// List the quotas of an Actor
+	// (GET /v1/admin/actors/{id}/quotas)
+	AdminListActorQuotas(ctx context.Context, request AdminListActorQuotasRequestObject) (AdminListActorQuotasResponseObject, error)
+	// Set the quotas of an Actor
+	// (PUT /v1/admin/actors/{id}/quotas)
+	AdminSetActorQuotas(ctx context.Context, request AdminSetActorQuotasRequestObject) (AdminSetActorQuotasResponseObject, error)
+	// Resume the queue of an Actor
+	// (POST /v1/admin/actors/{id}/resume)
+	AdminResumeActorQueue(ctx context.Context, request AdminResumeActorQueueRequestObject) (AdminResumeActorQueueResponseObject, error)
 	// List API tokens
 	// (GET /v1/admin/api_tokens)
 	AdminListApiTokens(ctx context.Context, request AdminListApiTokensRequestObject) (AdminListApiTokensResponseObject, error)
@@ -4710,6 +7702,15 @@ type StrictServerInterface interface {
 	// Update a specific Config. Only draft configs can be updated.
 	// (PATCH /v1/admin/configs/{id})
 	AdminUpdateConfig(ctx context.Context, request AdminUpdateConfigRequestObject) (AdminUpdateConfigResponseObject, error)
+	// List Dead Letters
+	// (GET /v1/admin/dead_letters)
+	AdminListDeadLetters(ctx context.Context, request AdminListDeadLettersRequestObject) (AdminListDeadLettersResponseObject, error)
+	// Purge Dead Letters
+	// (POST /v1/admin/dead_letters/purge)
+	AdminPurgeDeadLetters(ctx context.Context, request AdminPurgeDeadLettersRequestObject) (AdminPurgeDeadLettersResponseObject, error)
+	// Replay Dead Letters
+	// (POST /v1/admin/dead_letters/replay)
+	AdminReplayDeadLetters(ctx context.Context, request AdminReplayDeadLettersRequestObject) (AdminReplayDeadLettersResponseObject, error)
 	// List Deployments
 	// (GET /v1/admin/deployments)
 	AdminListDeployments(ctx context.Context, request AdminListDeploymentsRequestObject) (AdminListDeploymentsResponseObject, error)
@@ -4740,15 +7741,36 @@ type StrictServerInterface interface {
 	// Submit the Deployment for reviewing. Only draft deployments can be submitted. After submitting, the deployment will be in `reviewing` status. Reviewers will be notified.
 	// (POST /v1/admin/deployments/{id}/submit)
 	AdminSubmitDeployment(ctx context.Context, request AdminSubmitDeploymentRequestObject) (AdminSubmitDeploymentResponseObject, error)
+	// List Invocations
+	// (GET /v1/admin/invocations)
+	AdminListInvocations(ctx context.Context, request AdminListInvocationsRequestObject) (AdminListInvocationsResponseObject, error)
 	// Get pod metrics
 	// (GET /v1/admin/metrics/pods)
 	AdminListPodMetrics(ctx context.Context, request AdminListPodMetricsRequestObject) (AdminListPodMetricsResponseObject, error)
+	// List Queue Statistics
+	// (GET /v1/admin/queues)
+	AdminListQueueStats(ctx context.Context, request AdminListQueueStatsRequestObject) (AdminListQueueStatsResponseObject, error)
 	// List reference config suites
 	// (GET /v1/admin/reference_config_suites)
 	AdminListReferenceConfigSuites(ctx context.Context, request AdminListReferenceConfigSuitesRequestObject) (AdminListReferenceConfigSuitesResponseObject, error)
 	// Sync reference config suites
 	// (POST /v1/admin/reference_config_suites/sync)
 	AdminSyncReferenceConfigSuites(ctx context.Context, request AdminSyncReferenceConfigSuitesRequestObject) (AdminSyncReferenceConfigSuitesResponseObject, error)
+	// List Schedules
+	// (GET /v1/admin/schedules)
+	AdminListSchedules(ctx context.Context, request AdminListSchedulesRequestObject) (AdminListSchedulesResponseObject, error)
+	// Create a new Schedule
+	// (POST /v1/admin/schedules)
+	AdminCreateSchedule(ctx context.Context, request AdminCreateScheduleRequestObject) (AdminCreateScheduleResponseObject, error)
+	// Delete one specific Schedule
+	// (DELETE /v1/admin/schedules/{id})
+	AdminDeleteSchedule(ctx context.Context, request AdminDeleteScheduleRequestObject) (AdminDeleteScheduleResponseObject, error)
+	// Get one specific Schedule
+	// (GET /v1/admin/schedules/{id})
+	AdminGetSchedule(ctx context.Context, request AdminGetScheduleRequestObject) (AdminGetScheduleResponseObject, error)
+	// Update one specific Schedule
+	// (PATCH /v1/admin/schedules/{id})
+	AdminUpdateSchedule(ctx context.Context, request AdminUpdateScheduleRequestObject) (AdminUpdateScheduleResponseObject, error)
 	// List kubernetes secrets
 	// (GET /v1/admin/secrets)
 	AdminListSecrets(ctx context.Context, request AdminListSecretsRequestObject) (AdminListSecretsResponseObject, error)
@@ -4764,6 +7786,12 @@ type StrictServerInterface interface {
 	// Update system setting
 	// (PATCH /v1/admin/setting)
 	AdminUpdateSetting(ctx context.Context, request AdminUpdateSettingRequestObject) (AdminUpdateSettingResponseObject, error)
+	// List the token usage ledger
+	// (GET /v1/admin/token_usages)
+	AdminListTokenUsages(ctx context.Context, request AdminListTokenUsagesRequestObject) (AdminListTokenUsagesResponseObject, error)
+	// Generate chat completion in the OpenAI format.
+	// (POST /v1/chat/completions)
+	CreateChatCompletion(ctx context.Context, request CreateChatCompletionRequestObject) (CreateChatCompletionResponseObject, error)
 	// Generate text completion.
 	// (POST /v1/completion)
 	CreateCompletion(ctx context.Context, request CreateCompletionRequestObject) (CreateCompletionResponseObject, error)
@@ -4782,6 +7810,9 @@ type StrictServerInterface interface {
 	// Create a new asynchronous invocation job.
 	// (POST /v1/invocations/async)
 	CreateInvocationAsync(ctx context.Context, request CreateInvocationAsyncRequestObject) (CreateInvocationAsyncResponseObject, error)
+	// Create many asynchronous invocation jobs at once.
+	// (POST /v1/invocations/batch)
+	CreateInvocationBatch(ctx context.Context, request CreateInvocationBatchRequestObject) (CreateInvocationBatchResponseObject, error)
 	// Retrieve the next available invocation job for processing
 	// (GET /v1/invocations/next)
 	GetNextInvocation(ctx context.Context, request GetNextInvocationRequestObject) (GetNextInvocationResponseObject, error)
@@ -4791,9 +7822,15 @@ type StrictServerInterface interface {
 	// Get the status and result of an invocation job by ID
 	// (GET /v1/invocations/{id})
 	GetInvocationById(ctx context.Context, request GetInvocationByIdRequestObject) (GetInvocationByIdResponseObject, error)
+	// Cancel an invocation job
+	// (POST /v1/invocations/{id}/cancel)
+	CancelInvocation(ctx context.Context, request CancelInvocationRequestObject) (CancelInvocationResponseObject, error)
 	// Return invocation error
 	// (POST /v1/invocations/{invoke_id}/error)
 	ReturnInvocationError(ctx context.Context, request ReturnInvocationErrorRequestObject) (ReturnInvocationErrorResponseObject, error)
+	// Send invocation heartbeat
+	// (POST /v1/invocations/{invoke_id}/heartbeat)
+	HeartbeatInvocation(ctx context.Context, request HeartbeatInvocationRequestObject) (HeartbeatInvocationResponseObject, error)
 	// Return invocation result
 	// (POST /v1/invocations/{invoke_id}/response)
 	ReturnInvocationResponse(ctx context.Context, request ReturnInvocationResponseRequestObject) (ReturnInvocationResponseResponseObject, error)
@@ -4809,63 +7846,439 @@ type StrictServerInterface interface {
 	// Create a collection.
 	// (POST /v1/vector/collection)
 	CreateCollection(ctx context.Context, request CreateCollectionRequestObject) (CreateCollectionResponseObject, error)
-	// query data from a collection.
-	// (GET /v1/vector/collection/{name})
-	QueryCollection(ctx context.Context, request QueryCollectionRequestObject) (QueryCollectionResponseObject, error)
 	// Upsert data into a collection.
 	// (POST /v1/vector/collection/{name})
 	UpsertCollection(ctx context.Context, request UpsertCollectionRequestObject) (UpsertCollectionResponseObject, error)
+	// Query the most similar data from a collection.
+	// (POST /v1/vector/collection/{name}/query)
+	QueryCollection(ctx context.Context, request QueryCollectionRequestObject) (QueryCollectionResponseObject, error)
 	// List database.
 	// (GET /v1/vector/list)
 	ListVectoreStores(ctx context.Context, request ListVectoreStoresRequestObject) (ListVectoreStoresResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminListActors operation middleware
+func (sh *strictHandler) AdminListActors(w http.ResponseWriter, r *http.Request, params AdminListActorsParams) {
+	var request AdminListActorsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListActors(ctx, request.(AdminListActorsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListActors")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListActorsResponseObject); ok {
+		if err := validResponse.VisitAdminListActorsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminCreateActor operation middleware
+func (sh *strictHandler) AdminCreateActor(w http.ResponseWriter, r *http.Request) {
+	var request AdminCreateActorRequestObject
+
+	var body AdminCreateActorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateActor(ctx, request.(AdminCreateActorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateActor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminCreateActorResponseObject); ok {
+		if err := validResponse.VisitAdminCreateActorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminDeleteActor operation middleware
+func (sh *strictHandler) AdminDeleteActor(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminDeleteActorRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDeleteActor(ctx, request.(AdminDeleteActorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDeleteActor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminDeleteActorResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteActorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminGetActor operation middleware
+func (sh *strictHandler) AdminGetActor(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminGetActorRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminGetActor(ctx, request.(AdminGetActorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminGetActor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminGetActorResponseObject); ok {
+		if err := validResponse.VisitAdminGetActorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// AdminUpdateActor operation middleware
+func (sh *strictHandler) AdminUpdateActor(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminUpdateActorRequestObject
+
+	request.Id = id
+
+	var body AdminUpdateActorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateActor(ctx, request.(AdminUpdateActorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateActor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminUpdateActorResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateActorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// AdminPauseActorQueue operation middleware
+func (sh *strictHandler) AdminPauseActorQueue(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminPauseActorQueueRequestObject
+
+	request.Id = id
+
+	var body AdminPauseActorQueueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminPauseActorQueue(ctx, request.(AdminPauseActorQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminPauseActorQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminPauseActorQueueResponseObject); ok {
+		if err := validResponse.VisitAdminPauseActorQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// AdminListActorQuotas operation middleware
+func (sh *strictHandler) AdminListActorQuotas(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminListActorQuotasRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListActorQuotas(ctx, request.(AdminListActorQuotasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListActorQuotas")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListActorQuotasResponseObject); ok {
+		if err := validResponse.VisitAdminListActorQuotasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// AdminSetActorQuotas operation middleware
+func (sh *strictHandler) AdminSetActorQuotas(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminSetActorQuotasRequestObject
+
+	request.Id = id
+
+	var body AdminSetActorQuotasJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminSetActorQuotas(ctx, request.(AdminSetActorQuotasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminSetActorQuotas")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminSetActorQuotasResponseObject); ok {
+		if err := validResponse.VisitAdminSetActorQuotasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminResumeActorQueue operation middleware
+func (sh *strictHandler) AdminResumeActorQueue(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminResumeActorQueueRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminResumeActorQueue(ctx, request.(AdminResumeActorQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminResumeActorQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminResumeActorQueueResponseObject); ok {
+		if err := validResponse.VisitAdminResumeActorQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminListApiTokens operation middleware
+func (sh *strictHandler) AdminListApiTokens(w http.ResponseWriter, r *http.Request, params AdminListApiTokensParams) {
+	var request AdminListApiTokensRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListApiTokens(ctx, request.(AdminListApiTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListApiTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListApiTokensResponseObject); ok {
+		if err := validResponse.VisitAdminListApiTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminCreateApiToken operation middleware
+func (sh *strictHandler) AdminCreateApiToken(w http.ResponseWriter, r *http.Request) {
+	var request AdminCreateApiTokenRequestObject
+
+	var body AdminCreateApiTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminCreateApiToken(ctx, request.(AdminCreateApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminCreateApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminCreateApiTokenResponseObject); ok {
+		if err := validResponse.VisitAdminCreateApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminDeleteApiToken operation middleware
+func (sh *strictHandler) AdminDeleteApiToken(w http.ResponseWriter, r *http.Request, id string) {
+	var request AdminDeleteApiTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminDeleteApiToken(ctx, request.(AdminDeleteApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminDeleteApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminDeleteApiTokenResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminUpdateConfig operation middleware
+func (sh *strictHandler) AdminUpdateConfig(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminUpdateConfigRequestObject
+
+	request.Id = id
+
+	var body AdminUpdateConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.AdminUpdateConfig(ctx, request.(AdminUpdateConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "AdminUpdateConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminUpdateConfigResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4873,25 +8286,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AdminListActors operation middleware
-func (sh *strictHandler) AdminListActors(w http.ResponseWriter, r *http.Request, params AdminListActorsParams) {
-	var request AdminListActorsRequestObject
+// AdminListDeadLetters operation middleware
+func (sh *strictHandler) AdminListDeadLetters(w http.ResponseWriter, r *http.Request, params AdminListDeadLettersParams) {
+	var request AdminListDeadLettersRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminListActors(ctx, request.(AdminListActorsRequestObject))
+		return sh.ssi.AdminListDeadLetters(ctx, request.(AdminListDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminListActors")
+		handler = middleware(handler, "AdminListDeadLetters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminListActorsResponseObject); ok {
-		if err := validResponse.VisitAdminListActorsResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListDeadLettersResponseObject); ok {
+		if err := validResponse.VisitAdminListDeadLettersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4899,11 +8312,11 @@ func (sh *strictHandler) AdminListActors(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// AdminCreateActor operation middleware
-func (sh *strictHandler) AdminCreateActor(w http.ResponseWriter, r *http.Request) {
-	var request AdminCreateActorRequestObject
+// AdminPurgeDeadLetters operation middleware
+func (sh *strictHandler) AdminPurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var request AdminPurgeDeadLettersRequestObject
 
-	var body AdminCreateActorJSONRequestBody
+	var body AdminPurgeDeadLettersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4911,18 +8324,18 @@ func (sh *strictHandler) AdminCreateActor(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminCreateActor(ctx, request.(AdminCreateActorRequestObject))
+		return sh.ssi.AdminPurgeDeadLetters(ctx, request.(AdminPurgeDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminCreateActor")
+		handler = middleware(handler, "AdminPurgeDeadLetters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminCreateActorResponseObject); ok {
-		if err := validResponse.VisitAdminCreateActorResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminPurgeDeadLettersResponseObject); ok {
+		if err := validResponse.VisitAdminPurgeDeadLettersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4930,25 +8343,30 @@ func (sh *strictHandler) AdminCreateActor(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// AdminDeleteActor operation middleware
-func (sh *strictHandler) AdminDeleteActor(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminDeleteActorRequestObject
+// AdminReplayDeadLetters operation middleware
+func (sh *strictHandler) AdminReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var request AdminReplayDeadLettersRequestObject
 
-	request.Id = id
+	var body AdminReplayDeadLettersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminDeleteActor(ctx, request.(AdminDeleteActorRequestObject))
+		return sh.ssi.AdminReplayDeadLetters(ctx, request.(AdminReplayDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminDeleteActor")
+		handler = middleware(handler, "AdminReplayDeadLetters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminDeleteActorResponseObject); ok {
-		if err := validResponse.VisitAdminDeleteActorResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminReplayDeadLettersResponseObject); ok {
+		if err := validResponse.VisitAdminReplayDeadLettersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4956,25 +8374,25 @@ func (sh *strictHandler) AdminDeleteActor(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// AdminGetActor operation middleware
-func (sh *strictHandler) AdminGetActor(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminGetActorRequestObject
+// AdminListDeployments operation middleware
+func (sh *strictHandler) AdminListDeployments(w http.ResponseWriter, r *http.Request, params AdminListDeploymentsParams) {
+	var request AdminListDeploymentsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminGetActor(ctx, request.(AdminGetActorRequestObject))
+		return sh.ssi.AdminListDeployments(ctx, request.(AdminListDeploymentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminGetActor")
+		handler = middleware(handler, "AdminListDeployments")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminGetActorResponseObject); ok {
-		if err := validResponse.VisitAdminGetActorResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListDeploymentsResponseObject); ok {
+		if err := validResponse.VisitAdminListDeploymentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4982,13 +8400,11 @@ func (sh *strictHandler) AdminGetActor(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// AdminUpdateActor operation middleware
-func (sh *strictHandler) AdminUpdateActor(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminUpdateActorRequestObject
-
-	request.Id = id
+// AdminCreateDeployment operation middleware
+func (sh *strictHandler) AdminCreateDeployment(w http.ResponseWriter, r *http.Request) {
+	var request AdminCreateDeploymentRequestObject
 
-	var body AdminUpdateActorJSONRequestBody
+	var body AdminCreateDeploymentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4996,18 +8412,18 @@ func (sh *strictHandler) AdminUpdateActor(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminUpdateActor(ctx, request.(AdminUpdateActorRequestObject))
+		return sh.ssi.AdminCreateDeployment(ctx, request.(AdminCreateDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminUpdateActor")
+		handler = middleware(handler, "AdminCreateDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminUpdateActorResponseObject); ok {
-		if err := validResponse.VisitAdminUpdateActorResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminCreateDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminCreateDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5015,25 +8431,25 @@ func (sh *strictHandler) AdminUpdateActor(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// AdminListApiTokens operation middleware
-func (sh *strictHandler) AdminListApiTokens(w http.ResponseWriter, r *http.Request, params AdminListApiTokensParams) {
-	var request AdminListApiTokensRequestObject
+// AdminDeleteDeployment operation middleware
+func (sh *strictHandler) AdminDeleteDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminDeleteDeploymentRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminListApiTokens(ctx, request.(AdminListApiTokensRequestObject))
+		return sh.ssi.AdminDeleteDeployment(ctx, request.(AdminDeleteDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminListApiTokens")
+		handler = middleware(handler, "AdminDeleteDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminListApiTokensResponseObject); ok {
-		if err := validResponse.VisitAdminListApiTokensResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminDeleteDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5041,30 +8457,25 @@ func (sh *strictHandler) AdminListApiTokens(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// AdminCreateApiToken operation middleware
-func (sh *strictHandler) AdminCreateApiToken(w http.ResponseWriter, r *http.Request) {
-	var request AdminCreateApiTokenRequestObject
+// AdminGetDeployment operation middleware
+func (sh *strictHandler) AdminGetDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminGetDeploymentRequestObject
 
-	var body AdminCreateApiTokenJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminCreateApiToken(ctx, request.(AdminCreateApiTokenRequestObject))
+		return sh.ssi.AdminGetDeployment(ctx, request.(AdminGetDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminCreateApiToken")
+		handler = middleware(handler, "AdminGetDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminCreateApiTokenResponseObject); ok {
-		if err := validResponse.VisitAdminCreateApiTokenResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminGetDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminGetDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5072,25 +8483,32 @@ func (sh *strictHandler) AdminCreateApiToken(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// AdminDeleteApiToken operation middleware
-func (sh *strictHandler) AdminDeleteApiToken(w http.ResponseWriter, r *http.Request, id string) {
-	var request AdminDeleteApiTokenRequestObject
+// AdminUpdateDeployment operation middleware
+func (sh *strictHandler) AdminUpdateDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminUpdateDeploymentRequestObject
 
 	request.Id = id
 
+	var body AdminUpdateDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminDeleteApiToken(ctx, request.(AdminDeleteApiTokenRequestObject))
+		return sh.ssi.AdminUpdateDeployment(ctx, request.(AdminUpdateDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminDeleteApiToken")
+		handler = middleware(handler, "AdminUpdateDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminDeleteApiTokenResponseObject); ok {
-		if err := validResponse.VisitAdminDeleteApiTokenResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminUpdateDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5098,13 +8516,13 @@ func (sh *strictHandler) AdminDeleteApiToken(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// AdminUpdateConfig operation middleware
-func (sh *strictHandler) AdminUpdateConfig(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminUpdateConfigRequestObject
+// AdminPublishDeployment operation middleware
+func (sh *strictHandler) AdminPublishDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminPublishDeploymentRequestObject
 
 	request.Id = id
 
-	var body AdminUpdateConfigJSONRequestBody
+	var body AdminPublishDeploymentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5112,18 +8530,18 @@ func (sh *strictHandler) AdminUpdateConfig(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminUpdateConfig(ctx, request.(AdminUpdateConfigRequestObject))
+		return sh.ssi.AdminPublishDeployment(ctx, request.(AdminPublishDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminUpdateConfig")
+		handler = middleware(handler, "AdminPublishDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminUpdateConfigResponseObject); ok {
-		if err := validResponse.VisitAdminUpdateConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminPublishDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminPublishDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5131,25 +8549,32 @@ func (sh *strictHandler) AdminUpdateConfig(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// AdminListDeployments operation middleware
-func (sh *strictHandler) AdminListDeployments(w http.ResponseWriter, r *http.Request, params AdminListDeploymentsParams) {
-	var request AdminListDeploymentsRequestObject
+// AdminRejectDeployment operation middleware
+func (sh *strictHandler) AdminRejectDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminRejectDeploymentRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body AdminRejectDeploymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminListDeployments(ctx, request.(AdminListDeploymentsRequestObject))
+		return sh.ssi.AdminRejectDeployment(ctx, request.(AdminRejectDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminListDeployments")
+		handler = middleware(handler, "AdminRejectDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminListDeploymentsResponseObject); ok {
-		if err := validResponse.VisitAdminListDeploymentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminRejectDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminRejectDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5157,11 +8582,13 @@ func (sh *strictHandler) AdminListDeployments(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// AdminCreateDeployment operation middleware
-func (sh *strictHandler) AdminCreateDeployment(w http.ResponseWriter, r *http.Request) {
-	var request AdminCreateDeploymentRequestObject
+// AdminRestartDeployment operation middleware
+func (sh *strictHandler) AdminRestartDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminRestartDeploymentRequestObject
 
-	var body AdminCreateDeploymentJSONRequestBody
+	request.Id = id
+
+	var body AdminRestartDeploymentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5169,18 +8596,18 @@ func (sh *strictHandler) AdminCreateDeployment(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminCreateDeployment(ctx, request.(AdminCreateDeploymentRequestObject))
+		return sh.ssi.AdminRestartDeployment(ctx, request.(AdminRestartDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminCreateDeployment")
+		handler = middleware(handler, "AdminRestartDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminCreateDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminCreateDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminRestartDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminRestartDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5188,25 +8615,25 @@ func (sh *strictHandler) AdminCreateDeployment(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// AdminDeleteDeployment operation middleware
-func (sh *strictHandler) AdminDeleteDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminDeleteDeploymentRequestObject
+// AdminGetDeploymentResult operation middleware
+func (sh *strictHandler) AdminGetDeploymentResult(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminGetDeploymentResultRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminDeleteDeployment(ctx, request.(AdminDeleteDeploymentRequestObject))
+		return sh.ssi.AdminGetDeploymentResult(ctx, request.(AdminGetDeploymentResultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminDeleteDeployment")
+		handler = middleware(handler, "AdminGetDeploymentResult")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminDeleteDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminDeleteDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminGetDeploymentResultResponseObject); ok {
+		if err := validResponse.VisitAdminGetDeploymentResultResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5214,25 +8641,25 @@ func (sh *strictHandler) AdminDeleteDeployment(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// AdminGetDeployment operation middleware
-func (sh *strictHandler) AdminGetDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminGetDeploymentRequestObject
+// AdminSubmitDeployment operation middleware
+func (sh *strictHandler) AdminSubmitDeployment(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminSubmitDeploymentRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminGetDeployment(ctx, request.(AdminGetDeploymentRequestObject))
+		return sh.ssi.AdminSubmitDeployment(ctx, request.(AdminSubmitDeploymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminGetDeployment")
+		handler = middleware(handler, "AdminSubmitDeployment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminGetDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminGetDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminSubmitDeploymentResponseObject); ok {
+		if err := validResponse.VisitAdminSubmitDeploymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5240,32 +8667,25 @@ func (sh *strictHandler) AdminGetDeployment(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// AdminUpdateDeployment operation middleware
-func (sh *strictHandler) AdminUpdateDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminUpdateDeploymentRequestObject
-
-	request.Id = id
+// AdminListInvocations operation middleware
+func (sh *strictHandler) AdminListInvocations(w http.ResponseWriter, r *http.Request, params AdminListInvocationsParams) {
+	var request AdminListInvocationsRequestObject
 
-	var body AdminUpdateDeploymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminUpdateDeployment(ctx, request.(AdminUpdateDeploymentRequestObject))
+		return sh.ssi.AdminListInvocations(ctx, request.(AdminListInvocationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminUpdateDeployment")
+		handler = middleware(handler, "AdminListInvocations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminUpdateDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminUpdateDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListInvocationsResponseObject); ok {
+		if err := validResponse.VisitAdminListInvocationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5273,32 +8693,49 @@ func (sh *strictHandler) AdminUpdateDeployment(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// AdminPublishDeployment operation middleware
-func (sh *strictHandler) AdminPublishDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminPublishDeploymentRequestObject
+// AdminListPodMetrics operation middleware
+func (sh *strictHandler) AdminListPodMetrics(w http.ResponseWriter, r *http.Request) {
+	var request AdminListPodMetricsRequestObject
 
-	request.Id = id
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListPodMetrics(ctx, request.(AdminListPodMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListPodMetrics")
+	}
 
-	var body AdminPublishDeploymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListPodMetricsResponseObject); ok {
+		if err := validResponse.VisitAdminListPodMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// AdminListQueueStats operation middleware
+func (sh *strictHandler) AdminListQueueStats(w http.ResponseWriter, r *http.Request, params AdminListQueueStatsParams) {
+	var request AdminListQueueStatsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminPublishDeployment(ctx, request.(AdminPublishDeploymentRequestObject))
+		return sh.ssi.AdminListQueueStats(ctx, request.(AdminListQueueStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminPublishDeployment")
+		handler = middleware(handler, "AdminListQueueStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminPublishDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminPublishDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListQueueStatsResponseObject); ok {
+		if err := validResponse.VisitAdminListQueueStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5306,32 +8743,23 @@ func (sh *strictHandler) AdminPublishDeployment(w http.ResponseWriter, r *http.R
 	}
 }
 
-// AdminRejectDeployment operation middleware
-func (sh *strictHandler) AdminRejectDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminRejectDeploymentRequestObject
-
-	request.Id = id
-
-	var body AdminRejectDeploymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// AdminListReferenceConfigSuites operation middleware
+func (sh *strictHandler) AdminListReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
+	var request AdminListReferenceConfigSuitesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminRejectDeployment(ctx, request.(AdminRejectDeploymentRequestObject))
+		return sh.ssi.AdminListReferenceConfigSuites(ctx, request.(AdminListReferenceConfigSuitesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminRejectDeployment")
+		handler = middleware(handler, "AdminListReferenceConfigSuites")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminRejectDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminRejectDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListReferenceConfigSuitesResponseObject); ok {
+		if err := validResponse.VisitAdminListReferenceConfigSuitesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5339,32 +8767,23 @@ func (sh *strictHandler) AdminRejectDeployment(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// AdminRestartDeployment operation middleware
-func (sh *strictHandler) AdminRestartDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminRestartDeploymentRequestObject
-
-	request.Id = id
-
-	var body AdminRestartDeploymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// AdminSyncReferenceConfigSuites operation middleware
+func (sh *strictHandler) AdminSyncReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
+	var request AdminSyncReferenceConfigSuitesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminRestartDeployment(ctx, request.(AdminRestartDeploymentRequestObject))
+		return sh.ssi.AdminSyncReferenceConfigSuites(ctx, request.(AdminSyncReferenceConfigSuitesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminRestartDeployment")
+		handler = middleware(handler, "AdminSyncReferenceConfigSuites")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminRestartDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminRestartDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminSyncReferenceConfigSuitesResponseObject); ok {
+		if err := validResponse.VisitAdminSyncReferenceConfigSuitesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5372,25 +8791,25 @@ func (sh *strictHandler) AdminRestartDeployment(w http.ResponseWriter, r *http.R
 	}
 }
 
-// AdminGetDeploymentResult operation middleware
-func (sh *strictHandler) AdminGetDeploymentResult(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminGetDeploymentResultRequestObject
+// AdminListSchedules operation middleware
+func (sh *strictHandler) AdminListSchedules(w http.ResponseWriter, r *http.Request, params AdminListSchedulesParams) {
+	var request AdminListSchedulesRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminGetDeploymentResult(ctx, request.(AdminGetDeploymentResultRequestObject))
+		return sh.ssi.AdminListSchedules(ctx, request.(AdminListSchedulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminGetDeploymentResult")
+		handler = middleware(handler, "AdminListSchedules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminGetDeploymentResultResponseObject); ok {
-		if err := validResponse.VisitAdminGetDeploymentResultResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminListSchedulesResponseObject); ok {
+		if err := validResponse.VisitAdminListSchedulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5398,25 +8817,30 @@ func (sh *strictHandler) AdminGetDeploymentResult(w http.ResponseWriter, r *http
 	}
 }
 
-// AdminSubmitDeployment operation middleware
-func (sh *strictHandler) AdminSubmitDeployment(w http.ResponseWriter, r *http.Request, id int64) {
-	var request AdminSubmitDeploymentRequestObject
+// AdminCreateSchedule operation middleware
+func (sh *strictHandler) AdminCreateSchedule(w http.ResponseWriter, r *http.Request) {
+	var request AdminCreateScheduleRequestObject
 
-	request.Id = id
+	var body AdminCreateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminSubmitDeployment(ctx, request.(AdminSubmitDeploymentRequestObject))
+		return sh.ssi.AdminCreateSchedule(ctx, request.(AdminCreateScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminSubmitDeployment")
+		handler = middleware(handler, "AdminCreateSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminSubmitDeploymentResponseObject); ok {
-		if err := validResponse.VisitAdminSubmitDeploymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminCreateScheduleResponseObject); ok {
+		if err := validResponse.VisitAdminCreateScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5424,23 +8848,25 @@ func (sh *strictHandler) AdminSubmitDeployment(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// AdminListPodMetrics operation middleware
-func (sh *strictHandler) AdminListPodMetrics(w http.ResponseWriter, r *http.Request) {
-	var request AdminListPodMetricsRequestObject
+// AdminDeleteSchedule operation middleware
+func (sh *strictHandler) AdminDeleteSchedule(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminDeleteScheduleRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminListPodMetrics(ctx, request.(AdminListPodMetricsRequestObject))
+		return sh.ssi.AdminDeleteSchedule(ctx, request.(AdminDeleteScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminListPodMetrics")
+		handler = middleware(handler, "AdminDeleteSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminListPodMetricsResponseObject); ok {
-		if err := validResponse.VisitAdminListPodMetricsResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminDeleteScheduleResponseObject); ok {
+		if err := validResponse.VisitAdminDeleteScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5448,23 +8874,25 @@ func (sh *strictHandler) AdminListPodMetrics(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// AdminListReferenceConfigSuites operation middleware
-func (sh *strictHandler) AdminListReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
-	var request AdminListReferenceConfigSuitesRequestObject
+// AdminGetSchedule operation middleware
+func (sh *strictHandler) AdminGetSchedule(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminGetScheduleRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminListReferenceConfigSuites(ctx, request.(AdminListReferenceConfigSuitesRequestObject))
+		return sh.ssi.AdminGetSchedule(ctx, request.(AdminGetScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminListReferenceConfigSuites")
+		handler = middleware(handler, "AdminGetSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminListReferenceConfigSuitesResponseObject); ok {
-		if err := validResponse.VisitAdminListReferenceConfigSuitesResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminGetScheduleResponseObject); ok {
+		if err := validResponse.VisitAdminGetScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5472,23 +8900,32 @@ func (sh *strictHandler) AdminListReferenceConfigSuites(w http.ResponseWriter, r
 	}
 }
 
-// AdminSyncReferenceConfigSuites operation middleware
-func (sh *strictHandler) AdminSyncReferenceConfigSuites(w http.ResponseWriter, r *http.Request) {
-	var request AdminSyncReferenceConfigSuitesRequestObject
+// AdminUpdateSchedule operation middleware
+func (sh *strictHandler) AdminUpdateSchedule(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdminUpdateScheduleRequestObject
+
+	request.Id = id
+
+	var body AdminUpdateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminSyncReferenceConfigSuites(ctx, request.(AdminSyncReferenceConfigSuitesRequestObject))
+		return sh.ssi.AdminUpdateSchedule(ctx, request.(AdminUpdateScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminSyncReferenceConfigSuites")
+		handler = middleware(handler, "AdminUpdateSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AdminSyncReferenceConfigSuitesResponseObject); ok {
-		if err := validResponse.VisitAdminSyncReferenceConfigSuitesResponse(w); err != nil {
+	} else if validResponse, ok := response.(AdminUpdateScheduleResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5634,6 +9071,63 @@ func (sh *strictHandler) AdminUpdateSetting(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// AdminListTokenUsages operation middleware
+func (sh *strictHandler) AdminListTokenUsages(w http.ResponseWriter, r *http.Request, params AdminListTokenUsagesParams) {
+	var request AdminListTokenUsagesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminListTokenUsages(ctx, request.(AdminListTokenUsagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminListTokenUsages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminListTokenUsagesResponseObject); ok {
+		if err := validResponse.VisitAdminListTokenUsagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateChatCompletion operation middleware
+func (sh *strictHandler) CreateChatCompletion(w http.ResponseWriter, r *http.Request) {
+	var request CreateChatCompletionRequestObject
+
+	var body CreateChatCompletionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChatCompletion(ctx, request.(CreateChatCompletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChatCompletion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateChatCompletionResponseObject); ok {
+		if err := validResponse.VisitCreateChatCompletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // CreateCompletion operation middleware
 func (sh *strictHandler) CreateCompletion(w http.ResponseWriter, r *http.Request) {
 	var request CreateCompletionRequestObject
@@ -5773,9 +9267,11 @@ func (sh *strictHandler) ListEmbeddingModels(w http.ResponseWriter, r *http.Requ
 }
 
 // CreateInvocationAsync operation middleware
-func (sh *strictHandler) CreateInvocationAsync(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) CreateInvocationAsync(w http.ResponseWriter, r *http.Request, params CreateInvocationAsyncParams) {
 	var request CreateInvocationAsyncRequestObject
 
+	request.Params = params
+
 	var body CreateInvocationAsyncJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -5803,6 +9299,37 @@ func (sh *strictHandler) CreateInvocationAsync(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// CreateInvocationBatch operation middleware
+func (sh *strictHandler) CreateInvocationBatch(w http.ResponseWriter, r *http.Request) {
+	var request CreateInvocationBatchRequestObject
+
+	var body CreateInvocationBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateInvocationBatch(ctx, request.(CreateInvocationBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateInvocationBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateInvocationBatchResponseObject); ok {
+		if err := validResponse.VisitCreateInvocationBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetNextInvocation operation middleware
 func (sh *strictHandler) GetNextInvocation(w http.ResponseWriter, r *http.Request, params GetNextInvocationParams) {
 	var request GetNextInvocationRequestObject
@@ -5889,6 +9416,32 @@ func (sh *strictHandler) GetInvocationById(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// CancelInvocation operation middleware
+func (sh *strictHandler) CancelInvocation(w http.ResponseWriter, r *http.Request, id string) {
+	var request CancelInvocationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelInvocation(ctx, request.(CancelInvocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelInvocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelInvocationResponseObject); ok {
+		if err := validResponse.VisitCancelInvocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ReturnInvocationError operation middleware
 func (sh *strictHandler) ReturnInvocationError(w http.ResponseWriter, r *http.Request, invokeId string) {
 	var request ReturnInvocationErrorRequestObject
@@ -5922,6 +9475,39 @@ func (sh *strictHandler) ReturnInvocationError(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// HeartbeatInvocation operation middleware
+func (sh *strictHandler) HeartbeatInvocation(w http.ResponseWriter, r *http.Request, invokeId string) {
+	var request HeartbeatInvocationRequestObject
+
+	request.InvokeId = invokeId
+
+	var body HeartbeatInvocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HeartbeatInvocation(ctx, request.(HeartbeatInvocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeartbeatInvocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HeartbeatInvocationResponseObject); ok {
+		if err := validResponse.VisitHeartbeatInvocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ReturnInvocationResponse operation middleware
 func (sh *strictHandler) ReturnInvocationResponse(w http.ResponseWriter, r *http.Request, invokeId string) {
 	var request ReturnInvocationResponseRequestObject
@@ -6069,25 +9655,33 @@ func (sh *strictHandler) CreateCollection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// QueryCollection operation middleware
-func (sh *strictHandler) QueryCollection(w http.ResponseWriter, r *http.Request, name string) {
-	var request QueryCollectionRequestObject
+// UpsertCollection operation middleware
+func (sh *strictHandler) UpsertCollection(w http.ResponseWriter, r *http.Request, name string, params UpsertCollectionParams) {
+	var request UpsertCollectionRequestObject
 
 	request.Name = name
+	request.Params = params
+
+	var body UpsertCollectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.QueryCollection(ctx, request.(QueryCollectionRequestObject))
+		return sh.ssi.UpsertCollection(ctx, request.(UpsertCollectionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "QueryCollection")
+		handler = middleware(handler, "UpsertCollection")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(QueryCollectionResponseObject); ok {
-		if err := validResponse.VisitQueryCollectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertCollectionResponseObject); ok {
+		if err := validResponse.VisitUpsertCollectionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6095,13 +9689,14 @@ func (sh *strictHandler) QueryCollection(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpsertCollection operation middleware
-func (sh *strictHandler) UpsertCollection(w http.ResponseWriter, r *http.Request, name string) {
-	var request UpsertCollectionRequestObject
+// QueryCollection operation middleware
+func (sh *strictHandler) QueryCollection(w http.ResponseWriter, r *http.Request, name string, params QueryCollectionParams) {
+	var request QueryCollectionRequestObject
 
 	request.Name = name
+	request.Params = params
 
-	var body UpsertCollectionJSONRequestBody
+	var body QueryCollectionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6109,18 +9704,18 @@ func (sh *strictHandler) UpsertCollection(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertCollection(ctx, request.(UpsertCollectionRequestObject))
+		return sh.ssi.QueryCollection(ctx, request.(QueryCollectionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertCollection")
+		handler = middleware(handler, "QueryCollection")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertCollectionResponseObject); ok {
-		if err := validResponse.VisitUpsertCollectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryCollectionResponseObject); ok {
+		if err := validResponse.VisitQueryCollectionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
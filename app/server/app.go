@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,11 +19,17 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kelseyhightower/envconfig"
+	maoscore "gitlab.com/navyx/ai/maos/maos-core"
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/handler"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/suitestore"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/tracing"
 	"gitlab.com/navyx/ai/maos/maos-core/k8s"
 	"gitlab.com/navyx/ai/maos/maos-core/middleware"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const appName string = "maos-core-server"
@@ -32,6 +39,9 @@ type LoggingQueryTracer struct {
 	logger *slog.Logger
 }
 
+// the queries generated by sqlc start with their name
+var queryNamePattern = regexp.MustCompile(`^-- name: (\w+)`)
+
 func (t *LoggingQueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
 	traceId := ctx.Value("TRACEID")
 	if strings.Contains(data.SQL, "api_tokens") {
@@ -39,12 +49,31 @@ func (t *LoggingQueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn
 	} else {
 		t.logger.Debug("Query started", "sql", data.SQL, "args", data.Args, "TraceId", traceId)
 	}
+
+	spanName := "query"
+	if match := queryNamePattern.FindStringSubmatch(data.SQL); match != nil {
+		spanName = match[1]
+	}
+	ctx, _ = tracing.Tracer().Start(ctx, spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(data.SQL),
+		),
+	)
 	return ctx
 }
 
 func (t *LoggingQueryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
 	traceId := ctx.Value("TRACEID")
 	t.logger.Debug("Query ended", "CommandTag", data.CommandTag, "duration", data.CommandTag, "TraceId", traceId)
+
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil && data.Err != pgx.ErrNoRows {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.End()
 }
 
 type App struct {
@@ -56,6 +85,17 @@ func (a *App) Run() {
 
 	config := a.loadConfig()
 
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		Exporter:       config.TraceExporter,
+		ServiceName:    maoscore.ServiceName,
+		ServiceVersion: maoscore.GetVersion(),
+	})
+	if err != nil {
+		a.logger.Error("Failed to initialize tracing", "err", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing(ctx)
+
 	// Connect to the database and create a new accessor
 	dbConfig, err := pgxpool.ParseConfig(config.DatabaseUrl)
 	if err != nil {
@@ -85,6 +125,9 @@ func (a *App) Run() {
 		}
 	}
 
+	metricsMiddleware := middleware.NewMetricsMiddleware()
+	tracingMiddleware := middleware.NewTracingMiddleware()
+
 	// Init auth middleware and token cache
 	middleware, cacheCloser := middleware.NewBearerAuthMiddleware(
 		middleware.NewDatabaseApiTokenFetch(pool, bootstrapApiToken),
@@ -92,7 +135,8 @@ func (a *App) Run() {
 	)
 	defer cacheCloser()
 
-	middlewares := []api.StrictMiddlewareFunc{middleware}
+	// the metrics and tracing middlewares go last to wrap the others
+	middlewares := []api.StrictMiddlewareFunc{middleware, metricsMiddleware, tracingMiddleware}
 	options := api.StrictHTTPServerOptions{
 		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			message, _ := json.Marshal(err.Error())
@@ -134,6 +178,7 @@ func (a *App) Run() {
 		Logger:          a.logger.WithGroup("APIHandler"),
 		SourcePool:      pool,
 		SuiteStore:      suiteStore,
+		S3Client:        s3Client,
 		K8sController:   k8sController,
 		AOAIEndpoint:    config.AOAIEndpoint,
 		AOAIAPIKey:      config.AOAIAPIKey,
@@ -160,6 +205,20 @@ func (a *App) Run() {
 	)
 	api.HandlerFromMux(api.NewStrictHandlerWithOptions(apiHandler, middlewares, options), router)
 
+	// the metrics have their own listener, so that they aren't exposed with the API
+	if config.MetricsPort != 0 {
+		metricsRouter := mux.NewRouter()
+		metricsRouter.Handle("/metrics", metrics.Handler()).Methods(http.MethodGet)
+		go func() {
+			a.logger.Info("Starting metrics server", "port", config.MetricsPort)
+			err := http.ListenAndServe(fmt.Sprintf(":%d", config.MetricsPort), metricsRouter)
+			if err != nil {
+				a.logger.Error("Metrics server running error", "err", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	a.logger.Info("Starting server", "port", config.Port)
 	err = http.ListenAndServe(fmt.Sprintf(":%d", config.Port), router)
 	if err != nil {
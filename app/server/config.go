@@ -2,6 +2,7 @@ package main
 
 type Config struct {
 	Port             int    `envconfig:"PORT" validate:"required,numeric,min=1,max=65535"`
+	MetricsPort      int    `envconfig:"METRICS_PORT" validate:"omitempty,numeric,min=1,max=65535,nefield=Port"`
 	DatabaseUrl      string `envconfig:"DATABASE_URL" validate:"omitempty,url"`
 	DatabaseHost     string `envconfig:"DATABASE_HOST" validate:"omitempty"`
 	DatabasePort     string `envconfig:"DATABASE_PORT" validate:"omitempty"`
@@ -9,6 +10,7 @@ type Config struct {
 	DatabasePassword string `envconfig:"DATABASE_PASSWORD" validate:"omitempty"`
 	DatabaseName     string `envconfig:"DATABASE_NAME" validate:"omitempty"`
 	TokenCacheTTL    string `envconfig:"TOKEN_CACHE_TTL" validate:"omitempty"`
+	TraceExporter    string `envconfig:"TRACE_EXPORTER" validate:"omitempty,oneof=otlp stdout"`
 
 	// AWS credentials
 	AWSAccessKeyID         string `envconfig:"AWS_ACCESS_KEY_ID" validate:"required"`
@@ -13,11 +13,16 @@ SELECT
   actors.deployable,
   actors.configurable,
   actors.migratable,
+  actors.max_attempts,
+  actors.retry_backoff_seconds,
+  actors.max_priority,
+  queues.lease_seconds,
   actors.created_at,
   COUNT(*) OVER() AS total_count,
   COALESCE(atc.token_count, 0) AS token_count,
   CASE WHEN atc.token_count IS NULL OR atc.token_count = 0 THEN true ELSE false END AS renameable
 FROM actors
+JOIN queues ON actors.queue_id = queues.id
 LEFT JOIN actor_token_count atc ON actors.id = atc.actor_id
 ORDER BY actors.name
 LIMIT sqlc.arg(page_size)::bigint
@@ -39,10 +44,15 @@ SELECT
   actors.deployable,
   actors.configurable,
   actors.migratable,
+  actors.max_attempts,
+  actors.retry_backoff_seconds,
+  actors.max_priority,
+  queues.lease_seconds,
   actors.created_at,
   COALESCE(atc.token_count, 0) AS token_count,
   CASE WHEN atc.token_count IS NULL OR atc.token_count = 0 THEN true ELSE false END AS renameable
 FROM actors
+JOIN queues ON actors.queue_id = queues.id
 LEFT JOIN actor_token_count atc ON actors.id = atc.actor_id
 WHERE actors.id = @id;
 
@@ -55,6 +65,9 @@ INSERT INTO actors(
     deployable,
     configurable,
     migratable,
+    max_attempts,
+    retry_backoff_seconds,
+    max_priority,
     metadata
 ) VALUES (
     @name::text,
@@ -64,6 +77,9 @@ INSERT INTO actors(
     @deployable::boolean,
     @configurable::boolean,
     @migratable::boolean,
+    coalesce(sqlc.narg('max_attempts')::smallint, 1),
+    coalesce(sqlc.narg('retry_backoff_seconds')::integer, 10),
+    coalesce(sqlc.narg('max_priority')::smallint, 1),
     coalesce(@metadata::jsonb, '{}')
 ) RETURNING *;
 
@@ -75,6 +91,9 @@ UPDATE actors SET
     deployable = COALESCE(sqlc.narg('deployable')::boolean, deployable),
     configurable = COALESCE(sqlc.narg('configurable')::boolean, configurable),
     migratable = COALESCE(sqlc.narg('migratable')::boolean, migratable),
+    max_attempts = COALESCE(sqlc.narg('max_attempts')::smallint, max_attempts),
+    retry_backoff_seconds = COALESCE(sqlc.narg('retry_backoff_seconds')::integer, retry_backoff_seconds),
+    max_priority = COALESCE(sqlc.narg('max_priority')::smallint, max_priority),
     metadata = COALESCE(sqlc.narg('metadata')::jsonb, metadata)
 WHERE id = @id
 RETURNING *;
@@ -86,17 +105,27 @@ WITH check_actor AS (
 check_config AS (
     SELECT EXISTS (SELECT 1 FROM configs WHERE configs.actor_id = @id) AS config_exists
 ),
+check_schedule AS (
+    SELECT EXISTS (SELECT 1 FROM schedules WHERE schedules.actor_id = @id) AS schedule_exists
+),
 delete_actor AS (
     DELETE FROM actors
     WHERE actors.id = @id
     AND EXISTS (SELECT 1 FROM check_actor WHERE actor_exists = true)
     AND NOT EXISTS (SELECT 1 FROM check_config WHERE config_exists = true)
+    AND NOT EXISTS (SELECT 1 FROM check_schedule WHERE schedule_exists = true)
     RETURNING *
 )
 SELECT
     CASE
         WHEN NOT EXISTS (SELECT 1 FROM check_actor WHERE actor_exists = true) THEN 'NOTFOUND'
         WHEN EXISTS (SELECT 1 FROM check_config WHERE config_exists = true) THEN 'REFERENCED'
+        WHEN EXISTS (SELECT 1 FROM check_schedule WHERE schedule_exists = true) THEN 'REFERENCED'
         WHEN EXISTS (SELECT 1 FROM delete_actor) THEN 'DONE'
         ELSE 'ERROR'
     END AS result;
+
+-- name: ActorFindByName :one
+SELECT id, name, queue_id, enabled
+FROM actors
+WHERE name = @name::text;
@@ -16,17 +16,22 @@ WITH check_actor AS (
 check_config AS (
     SELECT EXISTS (SELECT 1 FROM configs WHERE configs.actor_id = $1) AS config_exists
 ),
+check_schedule AS (
+    SELECT EXISTS (SELECT 1 FROM schedules WHERE schedules.actor_id = $1) AS schedule_exists
+),
 delete_actor AS (
     DELETE FROM actors
     WHERE actors.id = $1
     AND EXISTS (SELECT 1 FROM check_actor WHERE actor_exists = true)
     AND NOT EXISTS (SELECT 1 FROM check_config WHERE config_exists = true)
-    RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable
+    AND NOT EXISTS (SELECT 1 FROM check_schedule WHERE schedule_exists = true)
+    RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable, max_attempts, retry_backoff_seconds, max_priority
 )
 SELECT
     CASE
         WHEN NOT EXISTS (SELECT 1 FROM check_actor WHERE actor_exists = true) THEN 'NOTFOUND'
         WHEN EXISTS (SELECT 1 FROM check_config WHERE config_exists = true) THEN 'REFERENCED'
+        WHEN EXISTS (SELECT 1 FROM check_schedule WHERE schedule_exists = true) THEN 'REFERENCED'
         WHEN EXISTS (SELECT 1 FROM delete_actor) THEN 'DONE'
         ELSE 'ERROR'
     END AS result
@@ -55,26 +60,35 @@ SELECT
   actors.deployable,
   actors.configurable,
   actors.migratable,
+  actors.max_attempts,
+  actors.retry_backoff_seconds,
+  actors.max_priority,
+  queues.lease_seconds,
   actors.created_at,
   COALESCE(atc.token_count, 0) AS token_count,
   CASE WHEN atc.token_count IS NULL OR atc.token_count = 0 THEN true ELSE false END AS renameable
 FROM actors
+JOIN queues ON actors.queue_id = queues.id
 LEFT JOIN actor_token_count atc ON actors.id = atc.actor_id
 WHERE actors.id = $1
 `
 
 type ActorFindByIdRow struct {
-	ID           int64
-	Name         string
-	QueueID      int64
-	Role         ActorRole
-	Enabled      bool
-	Deployable   bool
-	Configurable bool
-	Migratable   bool
-	CreatedAt    int64
-	TokenCount   int64
-	Renameable   bool
+	ID                  int64
+	Name                string
+	QueueID             int64
+	Role                ActorRole
+	Enabled             bool
+	Deployable          bool
+	Configurable        bool
+	Migratable          bool
+	MaxAttempts         int16
+	RetryBackoffSeconds int32
+	MaxPriority         int16
+	LeaseSeconds        *int32
+	CreatedAt           int64
+	TokenCount          int64
+	Renameable          bool
 }
 
 func (q *Queries) ActorFindById(ctx context.Context, db DBTX, id int64) (*ActorFindByIdRow, error) {
@@ -89,6 +103,10 @@ func (q *Queries) ActorFindById(ctx context.Context, db DBTX, id int64) (*ActorF
 		&i.Deployable,
 		&i.Configurable,
 		&i.Migratable,
+		&i.MaxAttempts,
+		&i.RetryBackoffSeconds,
+		&i.MaxPriority,
+		&i.LeaseSeconds,
 		&i.CreatedAt,
 		&i.TokenCount,
 		&i.Renameable,
@@ -96,6 +114,31 @@ func (q *Queries) ActorFindById(ctx context.Context, db DBTX, id int64) (*ActorF
 	return &i, err
 }
 
+const actorFindByName = `-- name: ActorFindByName :one
+SELECT id, name, queue_id, enabled
+FROM actors
+WHERE name = $1::text
+`
+
+type ActorFindByNameRow struct {
+	ID      int64
+	Name    string
+	QueueID int64
+	Enabled bool
+}
+
+func (q *Queries) ActorFindByName(ctx context.Context, db DBTX, name string) (*ActorFindByNameRow, error) {
+	row := db.QueryRow(ctx, actorFindByName, name)
+	var i ActorFindByNameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.QueueID,
+		&i.Enabled,
+	)
+	return &i, err
+}
+
 const actorInsert = `-- name: ActorInsert :one
 INSERT INTO actors(
     name,
@@ -105,6 +148,9 @@ INSERT INTO actors(
     deployable,
     configurable,
     migratable,
+    max_attempts,
+    retry_backoff_seconds,
+    max_priority,
     metadata
 ) VALUES (
     $1::text,
@@ -114,19 +160,25 @@ INSERT INTO actors(
     $5::boolean,
     $6::boolean,
     $7::boolean,
-    coalesce($8::jsonb, '{}')
-) RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable
+    coalesce($8::smallint, 1),
+    coalesce($9::integer, 10),
+    coalesce($10::smallint, 1),
+    coalesce($11::jsonb, '{}')
+) RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable, max_attempts, retry_backoff_seconds, max_priority
 `
 
 type ActorInsertParams struct {
-	Name         string
-	QueueID      int64
-	Role         ActorRole
-	Enabled      bool
-	Deployable   bool
-	Configurable bool
-	Migratable   bool
-	Metadata     []byte
+	Name                string
+	QueueID             int64
+	Role                ActorRole
+	Enabled             bool
+	Deployable          bool
+	Configurable        bool
+	Migratable          bool
+	MaxAttempts         *int16
+	RetryBackoffSeconds *int32
+	MaxPriority         *int16
+	Metadata            []byte
 }
 
 func (q *Queries) ActorInsert(ctx context.Context, db DBTX, arg *ActorInsertParams) (*Actor, error) {
@@ -138,6 +190,9 @@ func (q *Queries) ActorInsert(ctx context.Context, db DBTX, arg *ActorInsertPara
 		arg.Deployable,
 		arg.Configurable,
 		arg.Migratable,
+		arg.MaxAttempts,
+		arg.RetryBackoffSeconds,
+		arg.MaxPriority,
 		arg.Metadata,
 	)
 	var i Actor
@@ -153,6 +208,9 @@ func (q *Queries) ActorInsert(ctx context.Context, db DBTX, arg *ActorInsertPara
 		&i.Configurable,
 		&i.Role,
 		&i.Migratable,
+		&i.MaxAttempts,
+		&i.RetryBackoffSeconds,
+		&i.MaxPriority,
 	)
 	return &i, err
 }
@@ -172,11 +230,16 @@ SELECT
   actors.deployable,
   actors.configurable,
   actors.migratable,
+  actors.max_attempts,
+  actors.retry_backoff_seconds,
+  actors.max_priority,
+  queues.lease_seconds,
   actors.created_at,
   COUNT(*) OVER() AS total_count,
   COALESCE(atc.token_count, 0) AS token_count,
   CASE WHEN atc.token_count IS NULL OR atc.token_count = 0 THEN true ELSE false END AS renameable
 FROM actors
+JOIN queues ON actors.queue_id = queues.id
 LEFT JOIN actor_token_count atc ON actors.id = atc.actor_id
 ORDER BY actors.name
 LIMIT $1::bigint
@@ -189,18 +252,22 @@ type ActorListPagenatedParams struct {
 }
 
 type ActorListPagenatedRow struct {
-	ID           int64
-	Name         string
-	Role         ActorRole
-	QueueID      int64
-	Enabled      bool
-	Deployable   bool
-	Configurable bool
-	Migratable   bool
-	CreatedAt    int64
-	TotalCount   int64
-	TokenCount   int64
-	Renameable   bool
+	ID                  int64
+	Name                string
+	Role                ActorRole
+	QueueID             int64
+	Enabled             bool
+	Deployable          bool
+	Configurable        bool
+	Migratable          bool
+	MaxAttempts         int16
+	RetryBackoffSeconds int32
+	MaxPriority         int16
+	LeaseSeconds        *int32
+	CreatedAt           int64
+	TotalCount          int64
+	TokenCount          int64
+	Renameable          bool
 }
 
 func (q *Queries) ActorListPagenated(ctx context.Context, db DBTX, arg *ActorListPagenatedParams) ([]*ActorListPagenatedRow, error) {
@@ -221,6 +288,10 @@ func (q *Queries) ActorListPagenated(ctx context.Context, db DBTX, arg *ActorLis
 			&i.Deployable,
 			&i.Configurable,
 			&i.Migratable,
+			&i.MaxAttempts,
+			&i.RetryBackoffSeconds,
+			&i.MaxPriority,
+			&i.LeaseSeconds,
 			&i.CreatedAt,
 			&i.TotalCount,
 			&i.TokenCount,
@@ -244,20 +315,26 @@ UPDATE actors SET
     deployable = COALESCE($4::boolean, deployable),
     configurable = COALESCE($5::boolean, configurable),
     migratable = COALESCE($6::boolean, migratable),
-    metadata = COALESCE($7::jsonb, metadata)
-WHERE id = $8
-RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable
+    max_attempts = COALESCE($7::smallint, max_attempts),
+    retry_backoff_seconds = COALESCE($8::integer, retry_backoff_seconds),
+    max_priority = COALESCE($9::smallint, max_priority),
+    metadata = COALESCE($10::jsonb, metadata)
+WHERE id = $11
+RETURNING id, name, queue_id, created_at, metadata, updated_at, enabled, deployable, configurable, role, migratable, max_attempts, retry_backoff_seconds, max_priority
 `
 
 type ActorUpdateParams struct {
-	Name         *string
-	Role         NullActorRole
-	Enabled      *bool
-	Deployable   *bool
-	Configurable *bool
-	Migratable   *bool
-	Metadata     []byte
-	ID           int64
+	Name                *string
+	Role                NullActorRole
+	Enabled             *bool
+	Deployable          *bool
+	Configurable        *bool
+	Migratable          *bool
+	MaxAttempts         *int16
+	RetryBackoffSeconds *int32
+	MaxPriority         *int16
+	Metadata            []byte
+	ID                  int64
 }
 
 func (q *Queries) ActorUpdate(ctx context.Context, db DBTX, arg *ActorUpdateParams) (*Actor, error) {
@@ -268,6 +345,9 @@ func (q *Queries) ActorUpdate(ctx context.Context, db DBTX, arg *ActorUpdatePara
 		arg.Deployable,
 		arg.Configurable,
 		arg.Migratable,
+		arg.MaxAttempts,
+		arg.RetryBackoffSeconds,
+		arg.MaxPriority,
 		arg.Metadata,
 		arg.ID,
 	)
@@ -284,6 +364,9 @@ func (q *Queries) ActorUpdate(ctx context.Context, db DBTX, arg *ActorUpdatePara
 		&i.Configurable,
 		&i.Role,
 		&i.Migratable,
+		&i.MaxAttempts,
+		&i.RetryBackoffSeconds,
+		&i.MaxPriority,
 	)
 	return &i, err
 }
@@ -359,7 +359,7 @@ func (q *Queries) ConfigUpdateInactiveContentByCreator(ctx context.Context, db D
 }
 
 const getActorByConfigId = `-- name: GetActorByConfigId :one
-SELECT actors.id, actors.name, actors.queue_id, actors.created_at, actors.metadata, actors.updated_at, actors.enabled, actors.deployable, actors.configurable, actors.role, actors.migratable
+SELECT actors.id, actors.name, actors.queue_id, actors.created_at, actors.metadata, actors.updated_at, actors.enabled, actors.deployable, actors.configurable, actors.role, actors.migratable, actors.max_attempts, actors.retry_backoff_seconds, actors.max_priority
 FROM configs
 JOIN actors ON configs.actor_id = actors.id
 WHERE configs.id = $1::bigint
@@ -382,6 +382,9 @@ func (q *Queries) GetActorByConfigId(ctx context.Context, db DBTX, id int64) (*A
 		&i.Configurable,
 		&i.Role,
 		&i.Migratable,
+		&i.MaxAttempts,
+		&i.RetryBackoffSeconds,
+		&i.MaxPriority,
 	)
 	return &i, err
 }
@@ -4,10 +4,22 @@ FROM invocations
 WHERE id = @id::bigint;
 
 -- name: InvocationInsert :one
+-- The priority is capped by the max_priority of the caller, so that callers
+-- cannot push their invocations ahead of what they are allowed to.
+-- Nothing is inserted nor returned if the caller already created an
+-- invocation with the same idempotency key, or if the queue of the actor is
+-- paused and rejects new invocations.
 WITH actor_queue AS (
-	SELECT queue_id
+	SELECT actors.queue_id, actors.max_attempts, actors.retry_backoff_seconds
 	FROM actors
-	WHERE name = @actor_name::text
+	JOIN queues ON queues.id = actors.queue_id
+	WHERE actors.name = @actor_name::text
+		AND NOT (queues.paused_at IS NOT NULL AND queues.reject_invocations_when_paused)
+),
+caller_actor AS (
+	SELECT max_priority
+	FROM actors
+	WHERE id = sqlc.narg('created_by')::bigint
 )
 INSERT INTO invocations(
 	state,
@@ -17,21 +29,41 @@ INSERT INTO invocations(
 	priority,
 	payload,
 	metadata,
-	tags
+	tags,
+	max_attempts,
+	retry_backoff_seconds,
+	created_by,
+	scheduled_at,
+	idempotency_key
 )
 SELECT
 	@state::invocation_state,
 	actor_queue.queue_id,
 	coalesce(@created_at::bigint, EXTRACT(EPOCH FROM NOW())),
 	@finalized_at,
-	@priority::smallint,
+	GREATEST(coalesce(sqlc.narg('priority')::smallint, caller_actor.max_priority, 1), caller_actor.max_priority),
 	@payload::jsonb,
 	coalesce(@metadata::jsonb, '{}'),
-	coalesce(@tags::varchar(255)[], '{}')
+	coalesce(@tags::varchar(255)[], '{}'),
+	coalesce(sqlc.narg('max_attempts')::smallint, actor_queue.max_attempts),
+	coalesce(sqlc.narg('retry_backoff_seconds')::integer, actor_queue.retry_backoff_seconds),
+	sqlc.narg('created_by')::bigint,
+	coalesce(sqlc.narg('scheduled_at')::bigint, EXTRACT(EPOCH FROM NOW())),
+	sqlc.narg('idempotency_key')::varchar(255)
 FROM actor_queue
-RETURNING id, queue_id;
+LEFT JOIN caller_actor ON true
+ON CONFLICT (created_by, idempotency_key) WHERE idempotency_key IS NOT NULL DO NOTHING
+RETURNING id, queue_id, priority, scheduled_at;
+
+-- name: InvocationFindByIdempotencyKey :one
+SELECT id, state
+FROM invocations
+WHERE created_by = @created_by::bigint
+  AND idempotency_key = @idempotency_key::varchar(255);
 
 -- name: InvocationGetAvailable :many
+-- Nothing is returned while the queue is paused.
+-- Invocations of a queue without a lease get no lease_expires_at, so the lease reaper never takes them back.
 WITH locked_invocations AS (
 	SELECT
 			*
@@ -40,6 +72,8 @@ WITH locked_invocations AS (
 	WHERE
 			state = 'available'::invocation_state
 			AND queue_id = @queue_id::bigint
+			AND scheduled_at <= EXTRACT(EPOCH FROM NOW())
+			AND NOT EXISTS (SELECT 1 FROM queues WHERE queues.id = @queue_id::bigint AND queues.paused_at IS NOT NULL)
 	ORDER BY
 			priority ASC,
 			id ASC
@@ -52,7 +86,8 @@ UPDATE
 SET
 	state = 'running'::invocation_state,
 	attempted_at = EXTRACT(EPOCH FROM NOW()),
-	attempted_by = array_append(invocations.attempted_by, @attempted_by::bigint)
+	attempted_by = array_append(invocations.attempted_by, @attempted_by::bigint),
+	lease_expires_at = EXTRACT(EPOCH FROM NOW()) + (SELECT lease_seconds FROM queues WHERE queues.id = @queue_id::bigint)
 FROM
 	locked_invocations
 WHERE
@@ -78,17 +113,55 @@ updated_invocation AS (
 	SET
 		finalized_at = @finalized_at::bigint,
 		result = @result::jsonb,
-		state = 'completed'
+		state = CASE
+			WHEN invocations.cancel_requested_at IS NOT NULL THEN 'cancelled'::invocation_state
+			ELSE 'completed'::invocation_state
+		END
 	FROM invocation_to_update
 	WHERE invocations.id = invocation_to_update.id
 	RETURNING invocations.*
 )
-SELECT id, state, finalized_at
+SELECT id, state, queue_id, finalized_at
 FROM updated_invocation;
 
+-- name: InvocationHeartbeatIfRunning :one
+-- Extends the lease of a running invocation held by the heartbeat sender and
+-- records its progress, if any. An invocation whose cancellation has been
+-- requested is cancelled instead.
+UPDATE invocations
+SET
+	lease_expires_at = EXTRACT(EPOCH FROM NOW()) + (SELECT lease_seconds FROM queues WHERE queues.id = invocations.queue_id),
+	progress = COALESCE(sqlc.narg('progress')::jsonb, progress),
+	state = CASE
+		WHEN cancel_requested_at IS NOT NULL THEN 'cancelled'::invocation_state
+		ELSE state
+	END,
+	finalized_at = CASE
+		WHEN cancel_requested_at IS NOT NULL THEN EXTRACT(EPOCH FROM NOW())::bigint
+		ELSE finalized_at
+	END
+WHERE invocations.id = @id::bigint
+	AND invocations.state = 'running'::invocation_state
+	AND (
+		array_length(attempted_by, 1) > 0
+		AND attempted_by[array_length(attempted_by, 1)] = @attempted_by::bigint
+	)
+RETURNING id, state, lease_expires_at;
+
 -- name: InvocationSetFailureIfRunning :one
+-- Records the errors of a running invocation. The invocation goes back to
+-- 'available' with an exponential backoff on scheduled_at while it still has
+-- attempts left, otherwise it is discarded. An invocation whose cancellation has
+-- been requested is cancelled instead.
 WITH invocation_to_update AS (
-	SELECT invocations.id
+	SELECT
+		invocations.id,
+		invocations.cancel_requested_at IS NOT NULL AS cancel_requested,
+		array_length(attempted_by, 1) < invocations.max_attempts AND invocations.cancel_requested_at IS NULL AS retryable,
+		LEAST(
+			invocations.retry_backoff_seconds * power(2, array_length(attempted_by, 1) - 1),
+			86400
+		)::bigint AS backoff_seconds
 	FROM invocations
 	WHERE invocations.id = @id::bigint
 		AND invocations.state = 'running'::invocation_state
@@ -101,12 +174,209 @@ WITH invocation_to_update AS (
 updated_invocation AS (
 	UPDATE invocations
 	SET
-		finalized_at = @finalized_at::bigint,
+		finalized_at = CASE WHEN invocation_to_update.retryable THEN NULL ELSE @finalized_at::bigint END,
 		errors = @errors::jsonb,
-		state = 'discarded'
+		state = CASE
+			WHEN invocation_to_update.retryable THEN 'available'::invocation_state
+			WHEN invocation_to_update.cancel_requested THEN 'cancelled'::invocation_state
+			ELSE 'discarded'::invocation_state
+		END,
+		scheduled_at = CASE
+			WHEN invocation_to_update.retryable THEN @finalized_at::bigint + invocation_to_update.backoff_seconds
+			ELSE invocations.scheduled_at
+		END
 	FROM invocation_to_update
 	WHERE invocations.id = invocation_to_update.id
 	RETURNING invocations.*
 )
-SELECT id, state, finalized_at
+SELECT id, state, queue_id, finalized_at, scheduled_at
 FROM updated_invocation;
+
+-- name: InvocationReapExpiredLeases :many
+-- Takes back running invocations whose lease has expired, most likely because
+-- the actor working on them died. They are requeued while they still have
+-- attempts left, otherwise they are discarded, or cancelled if it was requested.
+WITH expired_invocations AS (
+	SELECT
+		invocations.id,
+		invocations.cancel_requested_at IS NOT NULL AS cancel_requested,
+		array_length(invocations.attempted_by, 1) < invocations.max_attempts AND invocations.cancel_requested_at IS NULL AS retryable
+	FROM invocations
+	WHERE invocations.state = 'running'::invocation_state
+		AND invocations.lease_expires_at < EXTRACT(EPOCH FROM NOW())
+	ORDER BY invocations.lease_expires_at ASC
+	LIMIT @max::integer
+	FOR UPDATE
+	SKIP LOCKED
+)
+UPDATE invocations
+SET
+	state = CASE
+		WHEN expired_invocations.retryable THEN 'available'::invocation_state
+		WHEN expired_invocations.cancel_requested THEN 'cancelled'::invocation_state
+		ELSE 'discarded'::invocation_state
+	END,
+	finalized_at = CASE WHEN expired_invocations.retryable THEN NULL ELSE EXTRACT(EPOCH FROM NOW())::bigint END,
+	scheduled_at = EXTRACT(EPOCH FROM NOW()),
+	errors = '{"error": "lease expired"}'::jsonb,
+	lease_expires_at = NULL
+FROM expired_invocations
+WHERE invocations.id = expired_invocations.id
+RETURNING invocations.id, invocations.state, invocations.queue_id;
+
+-- name: InvocationCancel :one
+-- Cancels an available invocation right away. A running invocation is only
+-- flagged, and it is cancelled when its actor sends a heartbeat or returns.
+UPDATE invocations
+SET
+	state = CASE
+		WHEN state = 'available'::invocation_state THEN 'cancelled'::invocation_state
+		ELSE state
+	END,
+	finalized_at = CASE
+		WHEN state = 'available'::invocation_state THEN EXTRACT(EPOCH FROM NOW())::bigint
+		ELSE finalized_at
+	END,
+	cancel_requested_at = EXTRACT(EPOCH FROM NOW())
+WHERE id = @id::bigint
+	AND state IN ('available'::invocation_state, 'running'::invocation_state)
+RETURNING id, state, queue_id, finalized_at, cancel_requested_at;
+
+-- name: InvocationListDueQueueIds :many
+-- Lists the queues with available invocations that became due in the given time range.
+SELECT DISTINCT queue_id
+FROM invocations
+WHERE state = 'available'::invocation_state
+	AND scheduled_at > @due_after::bigint
+	AND scheduled_at <= @due_before::bigint;
+
+-- name: InvocationListPaginated :many
+-- Lists the invocations matching the filters, newest first. The cursor is the
+-- id of the last invocation of the previous page.
+SELECT
+  sqlc.embed(invocations),
+  actors.name AS actor_name
+FROM invocations
+LEFT JOIN actors ON actors.queue_id = invocations.queue_id
+WHERE (sqlc.narg(cursor)::bigint IS NULL OR invocations.id < sqlc.narg(cursor)::bigint)
+  AND (sqlc.narg(actor)::text IS NULL OR actors.name = sqlc.narg(actor)::text)
+  AND (sqlc.narg(queue_id)::bigint IS NULL OR invocations.queue_id = sqlc.narg(queue_id)::bigint)
+  AND (sqlc.narg(state)::invocation_state IS NULL OR invocations.state = sqlc.narg(state)::invocation_state)
+  AND (sqlc.narg(tags)::varchar(255)[] IS NULL OR invocations.tags @> sqlc.narg(tags)::varchar(255)[])
+  AND (sqlc.narg(metadata)::jsonb IS NULL OR invocations.metadata @> sqlc.narg(metadata)::jsonb)
+  AND (sqlc.narg(created_after)::bigint IS NULL OR invocations.created_at >= sqlc.narg(created_after)::bigint)
+  AND (sqlc.narg(created_before)::bigint IS NULL OR invocations.created_at < sqlc.narg(created_before)::bigint)
+  AND (sqlc.narg(finalized_after)::bigint IS NULL OR invocations.finalized_at >= sqlc.narg(finalized_after)::bigint)
+  AND (sqlc.narg(finalized_before)::bigint IS NULL OR invocations.finalized_at < sqlc.narg(finalized_before)::bigint)
+ORDER BY invocations.id DESC
+LIMIT sqlc.arg(page_size)::bigint;
+
+-- name: InvocationReplayDiscarded :many
+-- Clones the given discarded invocations back to available, optionally with a
+-- new payload. Each clone records the invocation it was replayed from, and an
+-- invocation that was already replayed is not replayed again.
+INSERT INTO invocations(
+  state,
+  queue_id,
+  created_at,
+  scheduled_at,
+  priority,
+  payload,
+  metadata,
+  tags,
+  max_attempts,
+  retry_backoff_seconds,
+  created_by,
+  replayed_from
+)
+SELECT
+  'available'::invocation_state,
+  queue_id,
+  @now::bigint,
+  @now::bigint,
+  priority,
+  COALESCE(sqlc.narg(payload)::jsonb, payload),
+  metadata,
+  tags,
+  max_attempts,
+  retry_backoff_seconds,
+  created_by,
+  id
+FROM invocations
+WHERE id = ANY(@ids::bigint[])
+  AND state = 'discarded'::invocation_state
+  AND NOT EXISTS (SELECT 1 FROM invocations replays WHERE replays.replayed_from = invocations.id)
+ORDER BY id
+RETURNING id, queue_id, replayed_from;
+
+-- name: InvocationPurgeDiscarded :execrows
+-- Deletes the discarded invocations with the given ids, or all those of the
+-- given queue finalized before the given time.
+DELETE FROM invocations
+WHERE state = 'discarded'::invocation_state
+  AND (sqlc.narg(ids)::bigint[] IS NULL OR id = ANY(sqlc.narg(ids)::bigint[]))
+  AND (sqlc.narg(queue_id)::bigint IS NULL OR queue_id = sqlc.narg(queue_id)::bigint)
+  AND (sqlc.narg(finalized_before)::bigint IS NULL OR finalized_at < sqlc.narg(finalized_before)::bigint);
+
+-- name: InvocationListExpired :many
+-- Lists the oldest invocations in the given final state finalized before the
+-- given time.
+SELECT *
+FROM invocations
+WHERE state = @state::invocation_state
+  AND finalized_at < @finalized_before::bigint
+ORDER BY id
+LIMIT @max::integer;
+
+-- name: InvocationDeleteFinalized :execrows
+-- Deletes the given invocations, skipping any which are not finalized.
+DELETE FROM invocations
+WHERE id = ANY(@ids::bigint[])
+  AND finalized_at IS NOT NULL;
+
+-- name: InvocationCountByQueueAndState :many
+-- Counts the available and running invocations by queue, with the time the
+-- oldest available one became due.
+SELECT
+    queue_id,
+    state,
+    count(*) AS count,
+    min(scheduled_at) FILTER (WHERE scheduled_at <= @now::bigint) AS oldest_scheduled_at
+FROM invocations
+WHERE state IN ('available', 'running')
+GROUP BY queue_id, state;
+
+-- name: InvocationCountFinalizedByQueueAndState :many
+-- Counts the invocations finalized since the given time, by queue and final
+-- state.
+SELECT
+    queue_id,
+    state,
+    count(*) AS count
+FROM invocations
+WHERE state IN ('cancelled', 'completed', 'discarded')
+  AND finalized_at >= @since::bigint
+GROUP BY queue_id, state;
+
+-- name: InvocationWaitTimePercentiles :many
+-- Lists the p50 and p95 of the seconds waited between the creation and the
+-- latest attempt of the invocations attempted since the given time, by queue.
+SELECT
+    queue_id,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY attempted_at - created_at)::float8 AS p50,
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY attempted_at - created_at)::float8 AS p95
+FROM invocations
+WHERE attempted_at >= @since::bigint
+GROUP BY queue_id;
+
+-- name: InvocationRunTimePercentiles :many
+-- Lists the p50 and p95 of the seconds run by the invocations finalized since
+-- the given time, by queue.
+SELECT
+    queue_id,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY finalized_at - attempted_at)::float8 AS p50,
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY finalized_at - attempted_at)::float8 AS p95
+FROM invocations
+WHERE finalized_at >= @since::bigint
+  AND attempted_at IS NOT NULL
+GROUP BY queue_id;
@@ -9,8 +9,147 @@ import (
 	"context"
 )
 
+const invocationCancel = `-- name: InvocationCancel :one
+UPDATE invocations
+SET
+	state = CASE
+		WHEN state = 'available'::invocation_state THEN 'cancelled'::invocation_state
+		ELSE state
+	END,
+	finalized_at = CASE
+		WHEN state = 'available'::invocation_state THEN EXTRACT(EPOCH FROM NOW())::bigint
+		ELSE finalized_at
+	END,
+	cancel_requested_at = EXTRACT(EPOCH FROM NOW())
+WHERE id = $1::bigint
+	AND state IN ('available'::invocation_state, 'running'::invocation_state)
+RETURNING id, state, queue_id, finalized_at, cancel_requested_at
+`
+
+type InvocationCancelRow struct {
+	ID                int64
+	State             InvocationState
+	QueueID           int64
+	FinalizedAt       *int64
+	CancelRequestedAt *int64
+}
+
+// Cancels an available invocation right away. A running invocation is only
+// flagged, and it is cancelled when its actor sends a heartbeat or returns.
+func (q *Queries) InvocationCancel(ctx context.Context, db DBTX, id int64) (*InvocationCancelRow, error) {
+	row := db.QueryRow(ctx, invocationCancel, id)
+	var i InvocationCancelRow
+	err := row.Scan(
+		&i.ID,
+		&i.State,
+		&i.QueueID,
+		&i.FinalizedAt,
+		&i.CancelRequestedAt,
+	)
+	return &i, err
+}
+
+const invocationCountByQueueAndState = `-- name: InvocationCountByQueueAndState :many
+SELECT
+    queue_id,
+    state,
+    count(*) AS count,
+    min(scheduled_at) FILTER (WHERE scheduled_at <= $1::bigint) AS oldest_scheduled_at
+FROM invocations
+WHERE state IN ('available', 'running')
+GROUP BY queue_id, state
+`
+
+type InvocationCountByQueueAndStateRow struct {
+	QueueID           int64
+	State             InvocationState
+	Count             int64
+	OldestScheduledAt *int64
+}
+
+// Counts the available and running invocations by queue, with the time the
+// oldest available one became due.
+func (q *Queries) InvocationCountByQueueAndState(ctx context.Context, db DBTX, now int64) ([]*InvocationCountByQueueAndStateRow, error) {
+	rows, err := db.Query(ctx, invocationCountByQueueAndState, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationCountByQueueAndStateRow
+	for rows.Next() {
+		var i InvocationCountByQueueAndStateRow
+		if err := rows.Scan(
+			&i.QueueID,
+			&i.State,
+			&i.Count,
+			&i.OldestScheduledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationCountFinalizedByQueueAndState = `-- name: InvocationCountFinalizedByQueueAndState :many
+SELECT
+    queue_id,
+    state,
+    count(*) AS count
+FROM invocations
+WHERE state IN ('cancelled', 'completed', 'discarded')
+  AND finalized_at >= $1::bigint
+GROUP BY queue_id, state
+`
+
+type InvocationCountFinalizedByQueueAndStateRow struct {
+	QueueID int64
+	State   InvocationState
+	Count   int64
+}
+
+// Counts the invocations finalized since the given time, by queue and final
+// state.
+func (q *Queries) InvocationCountFinalizedByQueueAndState(ctx context.Context, db DBTX, since int64) ([]*InvocationCountFinalizedByQueueAndStateRow, error) {
+	rows, err := db.Query(ctx, invocationCountFinalizedByQueueAndState, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationCountFinalizedByQueueAndStateRow
+	for rows.Next() {
+		var i InvocationCountFinalizedByQueueAndStateRow
+		if err := rows.Scan(&i.QueueID, &i.State, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationDeleteFinalized = `-- name: InvocationDeleteFinalized :execrows
+DELETE FROM invocations
+WHERE id = ANY($1::bigint[])
+  AND finalized_at IS NOT NULL
+`
+
+// Deletes the given invocations, skipping any which are not finalized.
+func (q *Queries) InvocationDeleteFinalized(ctx context.Context, db DBTX, ids []int64) (int64, error) {
+	result, err := db.Exec(ctx, invocationDeleteFinalized, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const invocationFindById = `-- name: InvocationFindById :one
-SELECT id, state, queue_id, attempted_at, created_at, finalized_at, priority, payload, errors, result, metadata, tags, attempted_by
+SELECT id, state, queue_id, attempted_at, created_at, finalized_at, priority, payload, errors, result, metadata, tags, attempted_by, max_attempts, retry_backoff_seconds, scheduled_at, lease_expires_at, progress, created_by, cancel_requested_at, idempotency_key, replayed_from
 FROM invocations
 WHERE id = $1::bigint
 `
@@ -32,19 +171,54 @@ func (q *Queries) InvocationFindById(ctx context.Context, db DBTX, id int64) (*I
 		&i.Metadata,
 		&i.Tags,
 		&i.AttemptedBy,
+		&i.MaxAttempts,
+		&i.RetryBackoffSeconds,
+		&i.ScheduledAt,
+		&i.LeaseExpiresAt,
+		&i.Progress,
+		&i.CreatedBy,
+		&i.CancelRequestedAt,
+		&i.IdempotencyKey,
+		&i.ReplayedFrom,
 	)
 	return &i, err
 }
 
+const invocationFindByIdempotencyKey = `-- name: InvocationFindByIdempotencyKey :one
+SELECT id, state
+FROM invocations
+WHERE created_by = $1::bigint
+  AND idempotency_key = $2::varchar(255)
+`
+
+type InvocationFindByIdempotencyKeyParams struct {
+	CreatedBy      int64
+	IdempotencyKey string
+}
+
+type InvocationFindByIdempotencyKeyRow struct {
+	ID    int64
+	State InvocationState
+}
+
+func (q *Queries) InvocationFindByIdempotencyKey(ctx context.Context, db DBTX, arg *InvocationFindByIdempotencyKeyParams) (*InvocationFindByIdempotencyKeyRow, error) {
+	row := db.QueryRow(ctx, invocationFindByIdempotencyKey, arg.CreatedBy, arg.IdempotencyKey)
+	var i InvocationFindByIdempotencyKeyRow
+	err := row.Scan(&i.ID, &i.State)
+	return &i, err
+}
+
 const invocationGetAvailable = `-- name: InvocationGetAvailable :many
 WITH locked_invocations AS (
 	SELECT
-			id, state, queue_id, attempted_at, created_at, finalized_at, priority, payload, errors, result, metadata, tags, attempted_by
+			id, state, queue_id, attempted_at, created_at, finalized_at, priority, payload, errors, result, metadata, tags, attempted_by, max_attempts, retry_backoff_seconds, scheduled_at, lease_expires_at, progress, created_by, cancel_requested_at, idempotency_key, replayed_from
 	FROM
 			invocations
 	WHERE
 			state = 'available'::invocation_state
 			AND queue_id = $2::bigint
+			AND scheduled_at <= EXTRACT(EPOCH FROM NOW())
+			AND NOT EXISTS (SELECT 1 FROM queues WHERE queues.id = $2::bigint AND queues.paused_at IS NOT NULL)
 	ORDER BY
 			priority ASC,
 			id ASC
@@ -57,13 +231,14 @@ UPDATE
 SET
 	state = 'running'::invocation_state,
 	attempted_at = EXTRACT(EPOCH FROM NOW()),
-	attempted_by = array_append(invocations.attempted_by, $1::bigint)
+	attempted_by = array_append(invocations.attempted_by, $1::bigint),
+	lease_expires_at = EXTRACT(EPOCH FROM NOW()) + (SELECT lease_seconds FROM queues WHERE queues.id = $2::bigint)
 FROM
 	locked_invocations
 WHERE
 	invocations.id = locked_invocations.id
 RETURNING
-	invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by
+	invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by, invocations.max_attempts, invocations.retry_backoff_seconds, invocations.scheduled_at, invocations.lease_expires_at, invocations.progress, invocations.created_by, invocations.cancel_requested_at, invocations.idempotency_key, invocations.replayed_from
 `
 
 type InvocationGetAvailableParams struct {
@@ -72,6 +247,8 @@ type InvocationGetAvailableParams struct {
 	Max         int32
 }
 
+// Nothing is returned while the queue is paused.
+// Invocations of a queue without a lease get no lease_expires_at, so the lease reaper never takes them back.
 func (q *Queries) InvocationGetAvailable(ctx context.Context, db DBTX, arg *InvocationGetAvailableParams) ([]*Invocation, error) {
 	rows, err := db.Query(ctx, invocationGetAvailable, arg.AttemptedBy, arg.QueueID, arg.Max)
 	if err != nil {
@@ -95,6 +272,15 @@ func (q *Queries) InvocationGetAvailable(ctx context.Context, db DBTX, arg *Invo
 			&i.Metadata,
 			&i.Tags,
 			&i.AttemptedBy,
+			&i.MaxAttempts,
+			&i.RetryBackoffSeconds,
+			&i.ScheduledAt,
+			&i.LeaseExpiresAt,
+			&i.Progress,
+			&i.CreatedBy,
+			&i.CancelRequestedAt,
+			&i.IdempotencyKey,
+			&i.ReplayedFrom,
 		); err != nil {
 			return nil, err
 		}
@@ -106,11 +292,62 @@ func (q *Queries) InvocationGetAvailable(ctx context.Context, db DBTX, arg *Invo
 	return items, nil
 }
 
+const invocationHeartbeatIfRunning = `-- name: InvocationHeartbeatIfRunning :one
+UPDATE invocations
+SET
+	lease_expires_at = EXTRACT(EPOCH FROM NOW()) + (SELECT lease_seconds FROM queues WHERE queues.id = invocations.queue_id),
+	progress = COALESCE($1::jsonb, progress),
+	state = CASE
+		WHEN cancel_requested_at IS NOT NULL THEN 'cancelled'::invocation_state
+		ELSE state
+	END,
+	finalized_at = CASE
+		WHEN cancel_requested_at IS NOT NULL THEN EXTRACT(EPOCH FROM NOW())::bigint
+		ELSE finalized_at
+	END
+WHERE invocations.id = $2::bigint
+	AND invocations.state = 'running'::invocation_state
+	AND (
+		array_length(attempted_by, 1) > 0
+		AND attempted_by[array_length(attempted_by, 1)] = $3::bigint
+	)
+RETURNING id, state, lease_expires_at
+`
+
+type InvocationHeartbeatIfRunningParams struct {
+	Progress    []byte
+	ID          int64
+	AttemptedBy int64
+}
+
+type InvocationHeartbeatIfRunningRow struct {
+	ID             int64
+	State          InvocationState
+	LeaseExpiresAt *int64
+}
+
+// Extends the lease of a running invocation held by the heartbeat sender and
+// records its progress, if any. An invocation whose cancellation has been
+// requested is cancelled instead.
+func (q *Queries) InvocationHeartbeatIfRunning(ctx context.Context, db DBTX, arg *InvocationHeartbeatIfRunningParams) (*InvocationHeartbeatIfRunningRow, error) {
+	row := db.QueryRow(ctx, invocationHeartbeatIfRunning, arg.Progress, arg.ID, arg.AttemptedBy)
+	var i InvocationHeartbeatIfRunningRow
+	err := row.Scan(&i.ID, &i.State, &i.LeaseExpiresAt)
+	return &i, err
+}
+
 const invocationInsert = `-- name: InvocationInsert :one
 WITH actor_queue AS (
-	SELECT queue_id
+	SELECT actors.queue_id, actors.max_attempts, actors.retry_backoff_seconds
 	FROM actors
-	WHERE name = $8::text
+	JOIN queues ON queues.id = actors.queue_id
+	WHERE actors.name = $13::text
+		AND NOT (queues.paused_at IS NOT NULL AND queues.reject_invocations_when_paused)
+),
+caller_actor AS (
+	SELECT max_priority
+	FROM actors
+	WHERE id = $10::bigint
 )
 INSERT INTO invocations(
 	state,
@@ -120,37 +357,61 @@ INSERT INTO invocations(
 	priority,
 	payload,
 	metadata,
-	tags
+	tags,
+	max_attempts,
+	retry_backoff_seconds,
+	created_by,
+	scheduled_at,
+	idempotency_key
 )
 SELECT
 	$1::invocation_state,
 	actor_queue.queue_id,
 	coalesce($2::bigint, EXTRACT(EPOCH FROM NOW())),
 	$3,
-	$4::smallint,
+	GREATEST(coalesce($4::smallint, caller_actor.max_priority, 1), caller_actor.max_priority),
 	$5::jsonb,
 	coalesce($6::jsonb, '{}'),
-	coalesce($7::varchar(255)[], '{}')
+	coalesce($7::varchar(255)[], '{}'),
+	coalesce($8::smallint, actor_queue.max_attempts),
+	coalesce($9::integer, actor_queue.retry_backoff_seconds),
+	$10::bigint,
+	coalesce($11::bigint, EXTRACT(EPOCH FROM NOW())),
+	$12::varchar(255)
 FROM actor_queue
-RETURNING id, queue_id
+LEFT JOIN caller_actor ON true
+ON CONFLICT (created_by, idempotency_key) WHERE idempotency_key IS NOT NULL DO NOTHING
+RETURNING id, queue_id, priority, scheduled_at
 `
 
 type InvocationInsertParams struct {
-	State       InvocationState
-	CreatedAt   int64
-	FinalizedAt *int64
-	Priority    int16
-	Payload     []byte
-	Metadata    []byte
-	Tags        []string
-	ActorName   string
+	State               InvocationState
+	CreatedAt           int64
+	FinalizedAt         *int64
+	Priority            *int16
+	Payload             []byte
+	Metadata            []byte
+	Tags                []string
+	MaxAttempts         *int16
+	RetryBackoffSeconds *int32
+	CreatedBy           *int64
+	ScheduledAt         *int64
+	IdempotencyKey      *string
+	ActorName           string
 }
 
 type InvocationInsertRow struct {
-	ID      int64
-	QueueID int64
+	ID          int64
+	QueueID     int64
+	Priority    int16
+	ScheduledAt int64
 }
 
+// The priority is capped by the max_priority of the caller, so that callers
+// cannot push their invocations ahead of what they are allowed to.
+// Nothing is inserted nor returned if the caller already created an
+// invocation with the same idempotency key, or if the queue of the actor is
+// paused and rejects new invocations.
 func (q *Queries) InvocationInsert(ctx context.Context, db DBTX, arg *InvocationInsertParams) (*InvocationInsertRow, error) {
 	row := db.QueryRow(ctx, invocationInsert,
 		arg.State,
@@ -160,13 +421,407 @@ func (q *Queries) InvocationInsert(ctx context.Context, db DBTX, arg *Invocation
 		arg.Payload,
 		arg.Metadata,
 		arg.Tags,
+		arg.MaxAttempts,
+		arg.RetryBackoffSeconds,
+		arg.CreatedBy,
+		arg.ScheduledAt,
+		arg.IdempotencyKey,
 		arg.ActorName,
 	)
 	var i InvocationInsertRow
-	err := row.Scan(&i.ID, &i.QueueID)
+	err := row.Scan(
+		&i.ID,
+		&i.QueueID,
+		&i.Priority,
+		&i.ScheduledAt,
+	)
 	return &i, err
 }
 
+const invocationListDueQueueIds = `-- name: InvocationListDueQueueIds :many
+SELECT DISTINCT queue_id
+FROM invocations
+WHERE state = 'available'::invocation_state
+	AND scheduled_at > $1::bigint
+	AND scheduled_at <= $2::bigint
+`
+
+type InvocationListDueQueueIdsParams struct {
+	DueAfter  int64
+	DueBefore int64
+}
+
+// Lists the queues with available invocations that became due in the given time range.
+func (q *Queries) InvocationListDueQueueIds(ctx context.Context, db DBTX, arg *InvocationListDueQueueIdsParams) ([]int64, error) {
+	rows, err := db.Query(ctx, invocationListDueQueueIds, arg.DueAfter, arg.DueBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var queue_id int64
+		if err := rows.Scan(&queue_id); err != nil {
+			return nil, err
+		}
+		items = append(items, queue_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationListExpired = `-- name: InvocationListExpired :many
+SELECT id, state, queue_id, attempted_at, created_at, finalized_at, priority, payload, errors, result, metadata, tags, attempted_by, max_attempts, retry_backoff_seconds, scheduled_at, lease_expires_at, progress, created_by, cancel_requested_at, idempotency_key, replayed_from
+FROM invocations
+WHERE state = $1::invocation_state
+  AND finalized_at < $2::bigint
+ORDER BY id
+LIMIT $3::integer
+`
+
+type InvocationListExpiredParams struct {
+	State           InvocationState
+	FinalizedBefore int64
+	Max             int32
+}
+
+// Lists the oldest invocations in the given final state finalized before the
+// given time.
+func (q *Queries) InvocationListExpired(ctx context.Context, db DBTX, arg *InvocationListExpiredParams) ([]*Invocation, error) {
+	rows, err := db.Query(ctx, invocationListExpired, arg.State, arg.FinalizedBefore, arg.Max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Invocation
+	for rows.Next() {
+		var i Invocation
+		if err := rows.Scan(
+			&i.ID,
+			&i.State,
+			&i.QueueID,
+			&i.AttemptedAt,
+			&i.CreatedAt,
+			&i.FinalizedAt,
+			&i.Priority,
+			&i.Payload,
+			&i.Errors,
+			&i.Result,
+			&i.Metadata,
+			&i.Tags,
+			&i.AttemptedBy,
+			&i.MaxAttempts,
+			&i.RetryBackoffSeconds,
+			&i.ScheduledAt,
+			&i.LeaseExpiresAt,
+			&i.Progress,
+			&i.CreatedBy,
+			&i.CancelRequestedAt,
+			&i.IdempotencyKey,
+			&i.ReplayedFrom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationListPaginated = `-- name: InvocationListPaginated :many
+SELECT
+  invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by, invocations.max_attempts, invocations.retry_backoff_seconds, invocations.scheduled_at, invocations.lease_expires_at, invocations.progress, invocations.created_by, invocations.cancel_requested_at, invocations.idempotency_key, invocations.replayed_from,
+  actors.name AS actor_name
+FROM invocations
+LEFT JOIN actors ON actors.queue_id = invocations.queue_id
+WHERE ($1::bigint IS NULL OR invocations.id < $1::bigint)
+  AND ($2::text IS NULL OR actors.name = $2::text)
+  AND ($3::bigint IS NULL OR invocations.queue_id = $3::bigint)
+  AND ($4::invocation_state IS NULL OR invocations.state = $4::invocation_state)
+  AND ($5::varchar(255)[] IS NULL OR invocations.tags @> $5::varchar(255)[])
+  AND ($6::jsonb IS NULL OR invocations.metadata @> $6::jsonb)
+  AND ($7::bigint IS NULL OR invocations.created_at >= $7::bigint)
+  AND ($8::bigint IS NULL OR invocations.created_at < $8::bigint)
+  AND ($9::bigint IS NULL OR invocations.finalized_at >= $9::bigint)
+  AND ($10::bigint IS NULL OR invocations.finalized_at < $10::bigint)
+ORDER BY invocations.id DESC
+LIMIT $11::bigint
+`
+
+type InvocationListPaginatedParams struct {
+	Cursor          *int64
+	Actor           *string
+	QueueID         *int64
+	State           NullInvocationState
+	Tags            []string
+	Metadata        []byte
+	CreatedAfter    *int64
+	CreatedBefore   *int64
+	FinalizedAfter  *int64
+	FinalizedBefore *int64
+	PageSize        int64
+}
+
+type InvocationListPaginatedRow struct {
+	Invocation Invocation
+	ActorName  *string
+}
+
+// Lists the invocations matching the filters, newest first. The cursor is the
+// id of the last invocation of the previous page.
+func (q *Queries) InvocationListPaginated(ctx context.Context, db DBTX, arg *InvocationListPaginatedParams) ([]*InvocationListPaginatedRow, error) {
+	rows, err := db.Query(ctx, invocationListPaginated,
+		arg.Cursor,
+		arg.Actor,
+		arg.QueueID,
+		arg.State,
+		arg.Tags,
+		arg.Metadata,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.FinalizedAfter,
+		arg.FinalizedBefore,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationListPaginatedRow
+	for rows.Next() {
+		var i InvocationListPaginatedRow
+		if err := rows.Scan(
+			&i.Invocation.ID,
+			&i.Invocation.State,
+			&i.Invocation.QueueID,
+			&i.Invocation.AttemptedAt,
+			&i.Invocation.CreatedAt,
+			&i.Invocation.FinalizedAt,
+			&i.Invocation.Priority,
+			&i.Invocation.Payload,
+			&i.Invocation.Errors,
+			&i.Invocation.Result,
+			&i.Invocation.Metadata,
+			&i.Invocation.Tags,
+			&i.Invocation.AttemptedBy,
+			&i.Invocation.MaxAttempts,
+			&i.Invocation.RetryBackoffSeconds,
+			&i.Invocation.ScheduledAt,
+			&i.Invocation.LeaseExpiresAt,
+			&i.Invocation.Progress,
+			&i.Invocation.CreatedBy,
+			&i.Invocation.CancelRequestedAt,
+			&i.Invocation.IdempotencyKey,
+			&i.Invocation.ReplayedFrom,
+			&i.ActorName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationPurgeDiscarded = `-- name: InvocationPurgeDiscarded :execrows
+DELETE FROM invocations
+WHERE state = 'discarded'::invocation_state
+  AND ($1::bigint[] IS NULL OR id = ANY($1::bigint[]))
+  AND ($2::bigint IS NULL OR queue_id = $2::bigint)
+  AND ($3::bigint IS NULL OR finalized_at < $3::bigint)
+`
+
+type InvocationPurgeDiscardedParams struct {
+	Ids             []int64
+	QueueID         *int64
+	FinalizedBefore *int64
+}
+
+// Deletes the discarded invocations with the given ids, or all those of the
+// given queue finalized before the given time.
+func (q *Queries) InvocationPurgeDiscarded(ctx context.Context, db DBTX, arg *InvocationPurgeDiscardedParams) (int64, error) {
+	result, err := db.Exec(ctx, invocationPurgeDiscarded, arg.Ids, arg.QueueID, arg.FinalizedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const invocationReapExpiredLeases = `-- name: InvocationReapExpiredLeases :many
+WITH expired_invocations AS (
+	SELECT
+		invocations.id,
+		invocations.cancel_requested_at IS NOT NULL AS cancel_requested,
+		array_length(invocations.attempted_by, 1) < invocations.max_attempts AND invocations.cancel_requested_at IS NULL AS retryable
+	FROM invocations
+	WHERE invocations.state = 'running'::invocation_state
+		AND invocations.lease_expires_at < EXTRACT(EPOCH FROM NOW())
+	ORDER BY invocations.lease_expires_at ASC
+	LIMIT $1::integer
+	FOR UPDATE
+	SKIP LOCKED
+)
+UPDATE invocations
+SET
+	state = CASE
+		WHEN expired_invocations.retryable THEN 'available'::invocation_state
+		WHEN expired_invocations.cancel_requested THEN 'cancelled'::invocation_state
+		ELSE 'discarded'::invocation_state
+	END,
+	finalized_at = CASE WHEN expired_invocations.retryable THEN NULL ELSE EXTRACT(EPOCH FROM NOW())::bigint END,
+	scheduled_at = EXTRACT(EPOCH FROM NOW()),
+	errors = '{"error": "lease expired"}'::jsonb,
+	lease_expires_at = NULL
+FROM expired_invocations
+WHERE invocations.id = expired_invocations.id
+RETURNING invocations.id, invocations.state, invocations.queue_id
+`
+
+type InvocationReapExpiredLeasesRow struct {
+	ID      int64
+	State   InvocationState
+	QueueID int64
+}
+
+// Takes back running invocations whose lease has expired, most likely because
+// the actor working on them died. They are requeued while they still have
+// attempts left, otherwise they are discarded, or cancelled if it was requested.
+func (q *Queries) InvocationReapExpiredLeases(ctx context.Context, db DBTX, max int32) ([]*InvocationReapExpiredLeasesRow, error) {
+	rows, err := db.Query(ctx, invocationReapExpiredLeases, max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationReapExpiredLeasesRow
+	for rows.Next() {
+		var i InvocationReapExpiredLeasesRow
+		if err := rows.Scan(&i.ID, &i.State, &i.QueueID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationReplayDiscarded = `-- name: InvocationReplayDiscarded :many
+INSERT INTO invocations(
+  state,
+  queue_id,
+  created_at,
+  scheduled_at,
+  priority,
+  payload,
+  metadata,
+  tags,
+  max_attempts,
+  retry_backoff_seconds,
+  created_by,
+  replayed_from
+)
+SELECT
+  'available'::invocation_state,
+  queue_id,
+  $1::bigint,
+  $1::bigint,
+  priority,
+  COALESCE($2::jsonb, payload),
+  metadata,
+  tags,
+  max_attempts,
+  retry_backoff_seconds,
+  created_by,
+  id
+FROM invocations
+WHERE id = ANY($3::bigint[])
+  AND state = 'discarded'::invocation_state
+  AND NOT EXISTS (SELECT 1 FROM invocations replays WHERE replays.replayed_from = invocations.id)
+ORDER BY id
+RETURNING id, queue_id, replayed_from
+`
+
+type InvocationReplayDiscardedParams struct {
+	Now     int64
+	Payload []byte
+	Ids     []int64
+}
+
+type InvocationReplayDiscardedRow struct {
+	ID           int64
+	QueueID      int64
+	ReplayedFrom *int64
+}
+
+// Clones the given discarded invocations back to available, optionally with a
+// new payload. Each clone records the invocation it was replayed from, and an
+// invocation that was already replayed is not replayed again.
+func (q *Queries) InvocationReplayDiscarded(ctx context.Context, db DBTX, arg *InvocationReplayDiscardedParams) ([]*InvocationReplayDiscardedRow, error) {
+	rows, err := db.Query(ctx, invocationReplayDiscarded, arg.Now, arg.Payload, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationReplayDiscardedRow
+	for rows.Next() {
+		var i InvocationReplayDiscardedRow
+		if err := rows.Scan(&i.ID, &i.QueueID, &i.ReplayedFrom); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const invocationRunTimePercentiles = `-- name: InvocationRunTimePercentiles :many
+SELECT
+    queue_id,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY finalized_at - attempted_at)::float8 AS p50,
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY finalized_at - attempted_at)::float8 AS p95
+FROM invocations
+WHERE finalized_at >= $1::bigint
+  AND attempted_at IS NOT NULL
+GROUP BY queue_id
+`
+
+type InvocationRunTimePercentilesRow struct {
+	QueueID int64
+	P50     float64
+	P95     float64
+}
+
+// Lists the p50 and p95 of the seconds run by the invocations finalized since
+// the given time, by queue.
+func (q *Queries) InvocationRunTimePercentiles(ctx context.Context, db DBTX, since int64) ([]*InvocationRunTimePercentilesRow, error) {
+	rows, err := db.Query(ctx, invocationRunTimePercentiles, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationRunTimePercentilesRow
+	for rows.Next() {
+		var i InvocationRunTimePercentilesRow
+		if err := rows.Scan(&i.QueueID, &i.P50, &i.P95); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const invocationSetCompleteIfRunning = `-- name: InvocationSetCompleteIfRunning :one
 WITH invocation_to_update AS (
 	SELECT invocations.id
@@ -184,12 +839,15 @@ updated_invocation AS (
 	SET
 		finalized_at = $3::bigint,
 		result = $4::jsonb,
-		state = 'completed'
+		state = CASE
+			WHEN invocations.cancel_requested_at IS NOT NULL THEN 'cancelled'::invocation_state
+			ELSE 'completed'::invocation_state
+		END
 	FROM invocation_to_update
 	WHERE invocations.id = invocation_to_update.id
-	RETURNING invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by
+	RETURNING invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by, invocations.max_attempts, invocations.retry_backoff_seconds, invocations.scheduled_at, invocations.lease_expires_at, invocations.progress, invocations.created_by, invocations.cancel_requested_at, invocations.idempotency_key, invocations.replayed_from
 )
-SELECT id, state, finalized_at
+SELECT id, state, queue_id, finalized_at
 FROM updated_invocation
 `
 
@@ -203,6 +861,7 @@ type InvocationSetCompleteIfRunningParams struct {
 type InvocationSetCompleteIfRunningRow struct {
 	ID          int64
 	State       InvocationState
+	QueueID     int64
 	FinalizedAt *int64
 }
 
@@ -214,13 +873,25 @@ func (q *Queries) InvocationSetCompleteIfRunning(ctx context.Context, db DBTX, a
 		arg.Result,
 	)
 	var i InvocationSetCompleteIfRunningRow
-	err := row.Scan(&i.ID, &i.State, &i.FinalizedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.State,
+		&i.QueueID,
+		&i.FinalizedAt,
+	)
 	return &i, err
 }
 
 const invocationSetFailureIfRunning = `-- name: InvocationSetFailureIfRunning :one
 WITH invocation_to_update AS (
-	SELECT invocations.id
+	SELECT
+		invocations.id,
+		invocations.cancel_requested_at IS NOT NULL AS cancel_requested,
+		array_length(attempted_by, 1) < invocations.max_attempts AND invocations.cancel_requested_at IS NULL AS retryable,
+		LEAST(
+			invocations.retry_backoff_seconds * power(2, array_length(attempted_by, 1) - 1),
+			86400
+		)::bigint AS backoff_seconds
 	FROM invocations
 	WHERE invocations.id = $1::bigint
 		AND invocations.state = 'running'::invocation_state
@@ -233,38 +904,97 @@ WITH invocation_to_update AS (
 updated_invocation AS (
 	UPDATE invocations
 	SET
-		finalized_at = $3::bigint,
+		finalized_at = CASE WHEN invocation_to_update.retryable THEN NULL ELSE $3::bigint END,
 		errors = $4::jsonb,
-		state = 'discarded'
+		state = CASE
+			WHEN invocation_to_update.retryable THEN 'available'::invocation_state
+			WHEN invocation_to_update.cancel_requested THEN 'cancelled'::invocation_state
+			ELSE 'discarded'::invocation_state
+		END,
+		scheduled_at = CASE
+			WHEN invocation_to_update.retryable THEN $5	ELSE invocations.scheduled_at
+		END
 	FROM invocation_to_update
 	WHERE invocations.id = invocation_to_update.id
-	RETURNING invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by
+	RETURNING invocations.id, invocations.state, invocations.queue_id, invocations.attempted_at, invocations.created_at, invocations.finalized_at, invocations.priority, invocations.payload, invocations.errors, invocations.result, invocations.metadata, invocations.tags, invocations.attempted_by, invocations.max_attempts, invocations.retry_backoff_seconds, invocations.scheduled_at, invocations.lease_expires_at, invocations.progress, invocations.created_by, invocations.cancel_requested_at, invocations.idempotency_key, invocations.replayed_from
 )
-SELECT id, state, finalized_at
+SELECT id, state, queue_id, finalized_at, scheduled_at
 FROM updated_invocation
 `
 
 type InvocationSetFailureIfRunningParams struct {
-	ID          int64
-	FinalizerID int64
-	FinalizedAt int64
-	Errors      []byte
+	ID                                                       int64
+	FinalizerID                                              int64
+	FinalizedAt                                              int64
+	Errors                                                   []byte
+	FinalizedAtpgCatalogint8invocationToUpdatebackoffSeconds int64
 }
 
 type InvocationSetFailureIfRunningRow struct {
 	ID          int64
 	State       InvocationState
+	QueueID     int64
 	FinalizedAt *int64
+	ScheduledAt int64
 }
 
+// Records the errors of a running invocation. The invocation goes back to
+// 'available' with an exponential backoff on scheduled_at while it still has
+// attempts left, otherwise it is discarded. An invocation whose cancellation has
+// been requested is cancelled instead.
 func (q *Queries) InvocationSetFailureIfRunning(ctx context.Context, db DBTX, arg *InvocationSetFailureIfRunningParams) (*InvocationSetFailureIfRunningRow, error) {
 	row := db.QueryRow(ctx, invocationSetFailureIfRunning,
 		arg.ID,
 		arg.FinalizerID,
 		arg.FinalizedAt,
 		arg.Errors,
+		arg.FinalizedAtpgCatalogint8invocationToUpdatebackoffSeconds,
 	)
 	var i InvocationSetFailureIfRunningRow
-	err := row.Scan(&i.ID, &i.State, &i.FinalizedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.State,
+		&i.QueueID,
+		&i.FinalizedAt,
+		&i.ScheduledAt,
+	)
 	return &i, err
 }
+
+const invocationWaitTimePercentiles = `-- name: InvocationWaitTimePercentiles :many
+SELECT
+    queue_id,
+    percentile_cont(0.5) WITHIN GROUP (ORDER BY attempted_at - created_at)::float8 AS p50,
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY attempted_at - created_at)::float8 AS p95
+FROM invocations
+WHERE attempted_at >= $1::bigint
+GROUP BY queue_id
+`
+
+type InvocationWaitTimePercentilesRow struct {
+	QueueID int64
+	P50     float64
+	P95     float64
+}
+
+// Lists the p50 and p95 of the seconds waited between the creation and the
+// latest attempt of the invocations attempted since the given time, by queue.
+func (q *Queries) InvocationWaitTimePercentiles(ctx context.Context, db DBTX, since int64) ([]*InvocationWaitTimePercentilesRow, error) {
+	rows, err := db.Query(ctx, invocationWaitTimePercentiles, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InvocationWaitTimePercentilesRow
+	for rows.Next() {
+		var i InvocationWaitTimePercentilesRow
+		if err := rows.Scan(&i.QueueID, &i.P50, &i.P95); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
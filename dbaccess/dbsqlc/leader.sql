@@ -0,0 +1,23 @@
+-- name: LeaderAttemptElect :execrows
+INSERT INTO leaders(
+    name,
+    leader_id,
+    elected_at,
+    expires_at
+) VALUES (
+    @name::text,
+    @leader_id::text,
+    EXTRACT(EPOCH FROM NOW()),
+    EXTRACT(EPOCH FROM NOW()) + @ttl_seconds::bigint
+)
+ON CONFLICT (name) DO UPDATE SET
+    leader_id = EXCLUDED.leader_id,
+    elected_at = CASE WHEN leaders.leader_id = EXCLUDED.leader_id THEN leaders.elected_at ELSE EXCLUDED.elected_at END,
+    expires_at = EXCLUDED.expires_at
+WHERE leaders.leader_id = EXCLUDED.leader_id
+  OR leaders.expires_at < EXTRACT(EPOCH FROM NOW());
+
+-- name: LeaderResign :execrows
+DELETE FROM leaders
+WHERE name = @name::text
+  AND leader_id = @leader_id::text;
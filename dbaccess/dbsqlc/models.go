@@ -149,17 +149,28 @@ func (ns NullInvocationState) Value() (driver.Value, error) {
 }
 
 type Actor struct {
-	ID           int64
-	Name         string
-	QueueID      int64
-	CreatedAt    int64
-	Metadata     []byte
-	UpdatedAt    *int64
-	Enabled      bool
-	Deployable   bool
-	Configurable bool
-	Role         ActorRole
-	Migratable   bool
+	ID                  int64
+	Name                string
+	QueueID             int64
+	CreatedAt           int64
+	Metadata            []byte
+	UpdatedAt           *int64
+	Enabled             bool
+	Deployable          bool
+	Configurable        bool
+	Role                ActorRole
+	Migratable          bool
+	MaxAttempts         int16
+	RetryBackoffSeconds int32
+	MaxPriority         int16
+}
+
+type ActorQuota struct {
+	ActorId   int64
+	Period    string
+	MaxTokens *int64
+	MaxCost   *float64
+	CreatedAt int64
 }
 
 type ApiToken struct {
@@ -213,19 +224,35 @@ type Deployment struct {
 }
 
 type Invocation struct {
-	ID          int64
-	State       InvocationState
-	QueueID     int64
-	AttemptedAt *int64
-	CreatedAt   int64
-	FinalizedAt *int64
-	Priority    int16
-	Payload     []byte
-	Errors      []byte
-	Result      []byte
-	Metadata    []byte
-	Tags        []string
-	AttemptedBy []int64
+	ID                  int64
+	State               InvocationState
+	QueueID             int64
+	AttemptedAt         *int64
+	CreatedAt           int64
+	FinalizedAt         *int64
+	Priority            int16
+	Payload             []byte
+	Errors              []byte
+	Result              []byte
+	Metadata            []byte
+	Tags                []string
+	AttemptedBy         []int64
+	MaxAttempts         int16
+	RetryBackoffSeconds int32
+	ScheduledAt         int64
+	LeaseExpiresAt      *int64
+	Progress            []byte
+	CreatedBy           *int64
+	CancelRequestedAt   *int64
+	IdempotencyKey      *string
+	ReplayedFrom        *int64
+}
+
+type Leader struct {
+	Name      string
+	LeaderID  string
+	ElectedAt int64
+	ExpiresAt int64
 }
 
 type Migration struct {
@@ -235,12 +262,14 @@ type Migration struct {
 }
 
 type Queue struct {
-	ID        int64
-	Name      string
-	CreatedAt int64
-	Metadata  []byte
-	PausedAt  *int64
-	UpdatedAt *int64
+	ID                          int64
+	Name                        string
+	CreatedAt                   int64
+	Metadata                    []byte
+	PausedAt                    *int64
+	UpdatedAt                   *int64
+	LeaseSeconds                *int32
+	RejectInvocationsWhenPaused bool
 }
 
 type ReferenceConfigSuites struct {
@@ -251,7 +280,43 @@ type ReferenceConfigSuites struct {
 	UpdatedAt   *int64
 }
 
+type Schedule struct {
+	ID               int64
+	Name             string
+	ActorId          int64
+	CronExpression   string
+	Payload          []byte
+	Metadata         []byte
+	Enabled          bool
+	LastRunAt        *int64
+	LastInvocationID *int64
+	NextRunAt        *int64
+	CreatedAt        int64
+	UpdatedAt        *int64
+	LastError        *string
+}
+
 type Settings struct {
 	Key   string
 	Value []byte
 }
+
+type TokenUsage struct {
+	ApiTokenID   string
+	ActorId      int64
+	ModelID      string
+	Day          int64
+	Requests     int64
+	InputTokens  int64
+	OutputTokens int64
+	Cost         float64
+}
+
+type VectorCollection struct {
+	ID           int64
+	DatabaseName string
+	Name         string
+	Fields       []byte
+	Indexes      []byte
+	CreatedAt    int64
+}
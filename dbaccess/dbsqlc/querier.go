@@ -11,8 +11,12 @@ import (
 type Querier interface {
 	ActorDelete(ctx context.Context, db DBTX, id int64) (string, error)
 	ActorFindById(ctx context.Context, db DBTX, id int64) (*ActorFindByIdRow, error)
+	ActorFindByName(ctx context.Context, db DBTX, name string) (*ActorFindByNameRow, error)
 	ActorInsert(ctx context.Context, db DBTX, arg *ActorInsertParams) (*Actor, error)
 	ActorListPagenated(ctx context.Context, db DBTX, arg *ActorListPagenatedParams) ([]*ActorListPagenatedRow, error)
+	ActorQuotaDeleteByActorId(ctx context.Context, db DBTX, actorID int64) error
+	ActorQuotaInsert(ctx context.Context, db DBTX, arg *ActorQuotaInsertParams) (*ActorQuota, error)
+	ActorQuotaListByActorId(ctx context.Context, db DBTX, actorID int64) ([]*ActorQuota, error)
 	ActorUpdate(ctx context.Context, db DBTX, arg *ActorUpdateParams) (*Actor, error)
 	ApiTokenCount(ctx context.Context, db DBTX) (int64, error)
 	ApiTokenDelete(ctx context.Context, db DBTX, id string) error
@@ -54,20 +58,89 @@ type Querier interface {
 	DeploymentSubmitForReview(ctx context.Context, db DBTX, id int64) (*Deployment, error)
 	DeploymentUpdate(ctx context.Context, db DBTX, arg *DeploymentUpdateParams) (*Deployment, error)
 	GetActorByConfigId(ctx context.Context, db DBTX, id int64) (*Actor, error)
+	// Cancels an available invocation right away. A running invocation is only
+	// flagged, and it is cancelled when its actor sends a heartbeat or returns.
+	InvocationCancel(ctx context.Context, db DBTX, id int64) (*InvocationCancelRow, error)
+	// Counts the available and running invocations by queue, with the time the
+	// oldest available one became due.
+	InvocationCountByQueueAndState(ctx context.Context, db DBTX, now int64) ([]*InvocationCountByQueueAndStateRow, error)
+	// Counts the invocations finalized since the given time, by queue and final
+	// state.
+	InvocationCountFinalizedByQueueAndState(ctx context.Context, db DBTX, since int64) ([]*InvocationCountFinalizedByQueueAndStateRow, error)
+	// Deletes the given invocations, skipping any which are not finalized.
+	InvocationDeleteFinalized(ctx context.Context, db DBTX, ids []int64) (int64, error)
 	InvocationFindById(ctx context.Context, db DBTX, id int64) (*Invocation, error)
+	InvocationFindByIdempotencyKey(ctx context.Context, db DBTX, arg *InvocationFindByIdempotencyKeyParams) (*InvocationFindByIdempotencyKeyRow, error)
+	// Nothing is returned while the queue is paused.
+	// Invocations of a queue without a lease get no lease_expires_at, so the lease reaper never takes them back.
 	InvocationGetAvailable(ctx context.Context, db DBTX, arg *InvocationGetAvailableParams) ([]*Invocation, error)
+	// Extends the lease of a running invocation held by the heartbeat sender and
+	// records its progress, if any. An invocation whose cancellation has been
+	// requested is cancelled instead.
+	InvocationHeartbeatIfRunning(ctx context.Context, db DBTX, arg *InvocationHeartbeatIfRunningParams) (*InvocationHeartbeatIfRunningRow, error)
+	// The priority is capped by the max_priority of the caller, so that callers
+	// cannot push their invocations ahead of what they are allowed to.
+	// Nothing is inserted nor returned if the caller already created an
+	// invocation with the same idempotency key, or if the queue of the actor is
+	// paused and rejects new invocations.
 	InvocationInsert(ctx context.Context, db DBTX, arg *InvocationInsertParams) (*InvocationInsertRow, error)
+	// Lists the queues with available invocations that became due in the given time range.
+	InvocationListDueQueueIds(ctx context.Context, db DBTX, arg *InvocationListDueQueueIdsParams) ([]int64, error)
+	// Lists the oldest invocations in the given final state finalized before the
+	// given time.
+	InvocationListExpired(ctx context.Context, db DBTX, arg *InvocationListExpiredParams) ([]*Invocation, error)
+	// Lists the invocations matching the filters, newest first. The cursor is the
+	// id of the last invocation of the previous page.
+	InvocationListPaginated(ctx context.Context, db DBTX, arg *InvocationListPaginatedParams) ([]*InvocationListPaginatedRow, error)
+	// Deletes the discarded invocations with the given ids, or all those of the
+	// given queue finalized before the given time.
+	InvocationPurgeDiscarded(ctx context.Context, db DBTX, arg *InvocationPurgeDiscardedParams) (int64, error)
+	// Takes back running invocations whose lease has expired, most likely because
+	// the actor working on them died. They are requeued while they still have
+	// attempts left, otherwise they are discarded, or cancelled if it was requested.
+	InvocationReapExpiredLeases(ctx context.Context, db DBTX, max int32) ([]*InvocationReapExpiredLeasesRow, error)
+	// Clones the given discarded invocations back to available, optionally with a
+	// new payload. Each clone records the invocation it was replayed from, and an
+	// invocation that was already replayed is not replayed again.
+	InvocationReplayDiscarded(ctx context.Context, db DBTX, arg *InvocationReplayDiscardedParams) ([]*InvocationReplayDiscardedRow, error)
+	// Lists the p50 and p95 of the seconds run by the invocations finalized since
+	// the given time, by queue.
+	InvocationRunTimePercentiles(ctx context.Context, db DBTX, since int64) ([]*InvocationRunTimePercentilesRow, error)
 	InvocationSetCompleteIfRunning(ctx context.Context, db DBTX, arg *InvocationSetCompleteIfRunningParams) (*InvocationSetCompleteIfRunningRow, error)
+	// Records the errors of a running invocation. The invocation goes back to
+	// 'available' with an exponential backoff on scheduled_at while it still has
+	// attempts left, otherwise it is discarded. An invocation whose cancellation has
+	// been requested is cancelled instead.
 	InvocationSetFailureIfRunning(ctx context.Context, db DBTX, arg *InvocationSetFailureIfRunningParams) (*InvocationSetFailureIfRunningRow, error)
+	// Lists the p50 and p95 of the seconds waited between the creation and the
+	// latest attempt of the invocations attempted since the given time, by queue.
+	InvocationWaitTimePercentiles(ctx context.Context, db DBTX, since int64) ([]*InvocationWaitTimePercentilesRow, error)
+	LeaderAttemptElect(ctx context.Context, db DBTX, arg *LeaderAttemptElectParams) (int64, error)
+	LeaderResign(ctx context.Context, db DBTX, arg *LeaderResignParams) (int64, error)
 	MigrationDeleteByVersionMany(ctx context.Context, db DBTX, version []int64) ([]*Migration, error)
 	MigrationGetAll(ctx context.Context, db DBTX) ([]*Migration, error)
 	MigrationInsert(ctx context.Context, db DBTX, version int64) (*Migration, error)
 	MigrationInsertMany(ctx context.Context, db DBTX, version []int64) ([]*Migration, error)
 	PgNotifyOne(ctx context.Context, db DBTX, arg *PgNotifyOneParams) error
+	QueueFindByActorName(ctx context.Context, db DBTX, actorName string) (*Queue, error)
 	QueueFindById(ctx context.Context, db DBTX, id int64) (*Queue, error)
 	QueueInsert(ctx context.Context, db DBTX, arg *QueueInsertParams) (*Queue, error)
+	QueueListWithActorName(ctx context.Context, db DBTX) ([]*QueueListWithActorNameRow, error)
+	// Pausing a paused queue keeps its paused_at and only updates whether new
+	// invocations are rejected.
+	QueuePauseByActorId(ctx context.Context, db DBTX, arg *QueuePauseByActorIdParams) (*Queue, error)
+	QueueResumeByActorId(ctx context.Context, db DBTX, actorID int64) (*Queue, error)
+	QueueUpdate(ctx context.Context, db DBTX, arg *QueueUpdateParams) (*Queue, error)
 	ReferenceConfigSuiteList(ctx context.Context, db DBTX) ([]*ReferenceConfigSuites, error)
 	ReferenceConfigSuiteUpsert(ctx context.Context, db DBTX, arg *ReferenceConfigSuiteUpsertParams) (int64, error)
+	ScheduleDelete(ctx context.Context, db DBTX, id int64) (int64, error)
+	ScheduleFindById(ctx context.Context, db DBTX, id int64) (*ScheduleFindByIdRow, error)
+	ScheduleInsert(ctx context.Context, db DBTX, arg *ScheduleInsertParams) (*Schedule, error)
+	ScheduleListDue(ctx context.Context, db DBTX, arg *ScheduleListDueParams) ([]*ScheduleListDueRow, error)
+	ScheduleListPaginated(ctx context.Context, db DBTX, arg *ScheduleListPaginatedParams) ([]*ScheduleListPaginatedRow, error)
+	ScheduleMarkFailed(ctx context.Context, db DBTX, arg *ScheduleMarkFailedParams) (int64, error)
+	ScheduleMarkRun(ctx context.Context, db DBTX, arg *ScheduleMarkRunParams) (int64, error)
+	ScheduleUpdate(ctx context.Context, db DBTX, arg *ScheduleUpdateParams) (*Schedule, error)
 	// it sets the specific deployment status to deploying.
 	// it checks if the deployment status is in draft or reviewing before setting it to deploying
 	// it also checks if there are no other deploying deployments
@@ -75,6 +148,14 @@ type Querier interface {
 	SettingGetSystem(ctx context.Context, db DBTX) (*Settings, error)
 	SettingUpdateSystem(ctx context.Context, db DBTX, value []byte) (*Settings, error)
 	TableExists(ctx context.Context, db DBTX, tableName string) (bool, error)
+	TokenUsageAdd(ctx context.Context, db DBTX, arg *TokenUsageAddParams) error
+	TokenUsageListPaginated(ctx context.Context, db DBTX, arg *TokenUsageListPaginatedParams) ([]*TokenUsageListPaginatedRow, error)
+	TokenUsageSumByActor(ctx context.Context, db DBTX, arg *TokenUsageSumByActorParams) (*TokenUsageSumByActorRow, error)
+	VectorCollectionFindByName(ctx context.Context, db DBTX, arg *VectorCollectionFindByNameParams) (*VectorCollection, error)
+	VectorCollectionInsert(ctx context.Context, db DBTX, arg *VectorCollectionInsertParams) (*VectorCollection, error)
+	VectorCollectionListByDatabase(ctx context.Context, db DBTX, databaseName string) ([]string, error)
+	VectorCollectionListDatabases(ctx context.Context, db DBTX) ([]string, error)
+	VectorExtensionInstalled(ctx context.Context, db DBTX) (bool, error)
 	UpdateDeploymentLastError(ctx context.Context, db DBTX, arg *UpdateDeploymentLastErrorParams) error
 	UpdateDeploymentMigrationLogs(ctx context.Context, db DBTX, arg *UpdateDeploymentMigrationLogsParams) error
 }
@@ -1,13 +1,63 @@
 -- name: QueueInsert :one
 INSERT INTO queues(
     name,
-    metadata
+    metadata,
+    lease_seconds
 ) VALUES (
     @name::text,
-    coalesce(@metadata::jsonb, '{}')
+    coalesce(@metadata::jsonb, '{}'),
+    NULLIF(sqlc.narg('lease_seconds')::integer, 0)
 ) RETURNING *;
 
 -- name: QueueFindById :one
 SELECT *
 FROM queues
 WHERE id = @id;
+
+-- name: QueueUpdate :one
+UPDATE queues SET
+    lease_seconds = CASE
+        WHEN sqlc.narg('lease_seconds')::integer IS NULL THEN lease_seconds
+        ELSE NULLIF(sqlc.narg('lease_seconds')::integer, 0)
+    END,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+WHERE id = @id
+RETURNING *;
+
+-- name: QueueFindByActorName :one
+SELECT queues.*
+FROM queues
+JOIN actors ON actors.queue_id = queues.id
+WHERE actors.name = @actor_name::text;
+
+-- name: QueuePauseByActorId :one
+-- Pausing a paused queue keeps its paused_at and only updates whether new
+-- invocations are rejected.
+UPDATE queues SET
+    paused_at = COALESCE(queues.paused_at, EXTRACT(EPOCH FROM NOW())),
+    reject_invocations_when_paused = @reject_invocations::boolean,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+FROM actors
+WHERE actors.queue_id = queues.id
+  AND actors.id = @actor_id::bigint
+RETURNING queues.*;
+
+-- name: QueueResumeByActorId :one
+UPDATE queues SET
+    paused_at = NULL,
+    reject_invocations_when_paused = false,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+FROM actors
+WHERE actors.queue_id = queues.id
+  AND actors.id = @actor_id::bigint
+RETURNING queues.*;
+
+-- name: QueueListWithActorName :many
+SELECT
+    queues.id,
+    queues.name,
+    queues.paused_at,
+    actors.name AS actor_name
+FROM queues
+LEFT JOIN actors ON actors.queue_id = queues.id
+ORDER BY queues.id;
@@ -9,8 +9,31 @@ import (
 	"context"
 )
 
+const queueFindByActorName = `-- name: QueueFindByActorName :one
+SELECT queues.id, queues.name, queues.created_at, queues.metadata, queues.paused_at, queues.updated_at, queues.lease_seconds, queues.reject_invocations_when_paused
+FROM queues
+JOIN actors ON actors.queue_id = queues.id
+WHERE actors.name = $1::text
+`
+
+func (q *Queries) QueueFindByActorName(ctx context.Context, db DBTX, actorName string) (*Queue, error) {
+	row := db.QueryRow(ctx, queueFindByActorName, actorName)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Metadata,
+		&i.PausedAt,
+		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
+	)
+	return &i, err
+}
+
 const queueFindById = `-- name: QueueFindById :one
-SELECT id, name, created_at, metadata, paused_at, updated_at
+SELECT id, name, created_at, metadata, paused_at, updated_at, lease_seconds, reject_invocations_when_paused
 FROM queues
 WHERE id = $1
 `
@@ -25,6 +48,8 @@ func (q *Queries) QueueFindById(ctx context.Context, db DBTX, id int64) (*Queue,
 		&i.Metadata,
 		&i.PausedAt,
 		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
 	)
 	return &i, err
 }
@@ -32,20 +57,159 @@ func (q *Queries) QueueFindById(ctx context.Context, db DBTX, id int64) (*Queue,
 const queueInsert = `-- name: QueueInsert :one
 INSERT INTO queues(
     name,
-    metadata
+    metadata,
+    lease_seconds
 ) VALUES (
     $1::text,
-    coalesce($2::jsonb, '{}')
-) RETURNING id, name, created_at, metadata, paused_at, updated_at
+    coalesce($2::jsonb, '{}'),
+    NULLIF($3::integer, 0)
+) RETURNING id, name, created_at, metadata, paused_at, updated_at, lease_seconds, reject_invocations_when_paused
 `
 
 type QueueInsertParams struct {
-	Name     string
-	Metadata []byte
+	Name         string
+	Metadata     []byte
+	LeaseSeconds *int32
 }
 
 func (q *Queries) QueueInsert(ctx context.Context, db DBTX, arg *QueueInsertParams) (*Queue, error) {
-	row := db.QueryRow(ctx, queueInsert, arg.Name, arg.Metadata)
+	row := db.QueryRow(ctx, queueInsert, arg.Name, arg.Metadata, arg.LeaseSeconds)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Metadata,
+		&i.PausedAt,
+		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
+	)
+	return &i, err
+}
+
+const queueListWithActorName = `-- name: QueueListWithActorName :many
+SELECT
+    queues.id,
+    queues.name,
+    queues.paused_at,
+    actors.name AS actor_name
+FROM queues
+LEFT JOIN actors ON actors.queue_id = queues.id
+ORDER BY queues.id
+`
+
+type QueueListWithActorNameRow struct {
+	ID        int64
+	Name      string
+	PausedAt  *int64
+	ActorName *string
+}
+
+func (q *Queries) QueueListWithActorName(ctx context.Context, db DBTX) ([]*QueueListWithActorNameRow, error) {
+	rows, err := db.Query(ctx, queueListWithActorName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*QueueListWithActorNameRow
+	for rows.Next() {
+		var i QueueListWithActorNameRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.PausedAt,
+			&i.ActorName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queuePauseByActorId = `-- name: QueuePauseByActorId :one
+UPDATE queues SET
+    paused_at = COALESCE(queues.paused_at, EXTRACT(EPOCH FROM NOW())),
+    reject_invocations_when_paused = $1::boolean,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+FROM actors
+WHERE actors.queue_id = queues.id
+  AND actors.id = $2::bigint
+RETURNING queues.id, queues.name, queues.created_at, queues.metadata, queues.paused_at, queues.updated_at, queues.lease_seconds, queues.reject_invocations_when_paused
+`
+
+type QueuePauseByActorIdParams struct {
+	RejectInvocations bool
+	ActorId           int64
+}
+
+// Pausing a paused queue keeps its paused_at and only updates whether new
+// invocations are rejected.
+func (q *Queries) QueuePauseByActorId(ctx context.Context, db DBTX, arg *QueuePauseByActorIdParams) (*Queue, error) {
+	row := db.QueryRow(ctx, queuePauseByActorId, arg.RejectInvocations, arg.ActorId)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Metadata,
+		&i.PausedAt,
+		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
+	)
+	return &i, err
+}
+
+const queueResumeByActorId = `-- name: QueueResumeByActorId :one
+UPDATE queues SET
+    paused_at = NULL,
+    reject_invocations_when_paused = false,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+FROM actors
+WHERE actors.queue_id = queues.id
+  AND actors.id = $1::bigint
+RETURNING queues.id, queues.name, queues.created_at, queues.metadata, queues.paused_at, queues.updated_at, queues.lease_seconds, queues.reject_invocations_when_paused
+`
+
+func (q *Queries) QueueResumeByActorId(ctx context.Context, db DBTX, actorID int64) (*Queue, error) {
+	row := db.QueryRow(ctx, queueResumeByActorId, actorID)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Metadata,
+		&i.PausedAt,
+		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
+	)
+	return &i, err
+}
+
+const queueUpdate = `-- name: QueueUpdate :one
+UPDATE queues SET
+    lease_seconds = CASE
+        WHEN $1::integer IS NULL THEN lease_seconds
+        ELSE NULLIF($1::integer, 0)
+    END,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+WHERE id = $2
+RETURNING id, name, created_at, metadata, paused_at, updated_at, lease_seconds, reject_invocations_when_paused
+`
+
+type QueueUpdateParams struct {
+	LeaseSeconds *int32
+	ID           int64
+}
+
+func (q *Queries) QueueUpdate(ctx context.Context, db DBTX, arg *QueueUpdateParams) (*Queue, error) {
+	row := db.QueryRow(ctx, queueUpdate, arg.LeaseSeconds, arg.ID)
 	var i Queue
 	err := row.Scan(
 		&i.ID,
@@ -54,6 +218,8 @@ func (q *Queries) QueueInsert(ctx context.Context, db DBTX, arg *QueueInsertPara
 		&i.Metadata,
 		&i.PausedAt,
 		&i.UpdatedAt,
+		&i.LeaseSeconds,
+		&i.RejectInvocationsWhenPaused,
 	)
 	return &i, err
 }
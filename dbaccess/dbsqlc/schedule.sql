@@ -0,0 +1,90 @@
+-- name: ScheduleListPaginated :many
+SELECT
+  sqlc.embed(schedules),
+  actors.name AS actor_name,
+  COUNT(*) OVER() AS total_count
+FROM schedules
+JOIN actors ON schedules.actor_id = actors.id
+WHERE (sqlc.narg(name)::text IS NULL OR schedules.name ILIKE '%' || sqlc.narg(name)::text || '%')
+ORDER BY schedules.name
+LIMIT sqlc.arg(page_size)::bigint
+OFFSET sqlc.arg(page_size)::bigint * (sqlc.arg(page)::bigint - 1);
+
+-- name: ScheduleFindById :one
+SELECT
+  sqlc.embed(schedules),
+  actors.name AS actor_name
+FROM schedules
+JOIN actors ON schedules.actor_id = actors.id
+WHERE schedules.id = @id;
+
+-- name: ScheduleInsert :one
+INSERT INTO schedules(
+    name,
+    actor_id,
+    cron_expression,
+    payload,
+    metadata,
+    enabled,
+    next_run_at
+) VALUES (
+    @name::text,
+    @actor_id::bigint,
+    @cron_expression::text,
+    @payload::jsonb,
+    @metadata::jsonb,
+    @enabled::boolean,
+    sqlc.narg('next_run_at')::bigint
+) RETURNING *;
+
+-- name: ScheduleUpdate :one
+UPDATE schedules SET
+    name = COALESCE(sqlc.narg('name')::text, name),
+    actor_id = COALESCE(sqlc.narg('actor_id')::bigint, actor_id),
+    cron_expression = COALESCE(sqlc.narg('cron_expression')::text, cron_expression),
+    payload = COALESCE(sqlc.narg('payload')::jsonb, payload),
+    metadata = COALESCE(sqlc.narg('metadata')::jsonb, metadata),
+    enabled = COALESCE(sqlc.narg('enabled')::boolean, enabled),
+    next_run_at = sqlc.narg('next_run_at')::bigint,
+    updated_at = EXTRACT(EPOCH FROM NOW())
+WHERE id = @id
+RETURNING *;
+
+-- name: ScheduleDelete :execrows
+DELETE FROM schedules
+WHERE id = @id;
+
+-- name: ScheduleListDue :many
+SELECT
+  schedules.id,
+  schedules.name,
+  actors.name AS actor_name,
+  schedules.cron_expression,
+  schedules.payload,
+  schedules.metadata,
+  schedules.next_run_at
+FROM schedules
+JOIN actors ON schedules.actor_id = actors.id
+WHERE schedules.enabled
+  AND schedules.next_run_at <= @now::bigint
+ORDER BY schedules.next_run_at, schedules.id
+LIMIT @max::integer;
+
+-- name: ScheduleMarkRun :execrows
+UPDATE schedules SET
+    last_run_at = @last_run_at::bigint,
+    last_invocation_id = @last_invocation_id::bigint,
+    last_error = NULL,
+    next_run_at = sqlc.narg('next_run_at')::bigint
+WHERE id = @id
+  AND enabled
+  AND next_run_at = @last_run_at::bigint;
+
+-- name: ScheduleMarkFailed :execrows
+UPDATE schedules SET
+    last_run_at = @last_run_at::bigint,
+    last_error = @last_error::text,
+    next_run_at = sqlc.narg('next_run_at')::bigint
+WHERE id = @id
+  AND enabled
+  AND next_run_at = @last_run_at::bigint;
@@ -0,0 +1,42 @@
+-- name: TokenUsageAdd :exec
+INSERT INTO token_usages (api_token_id, actor_id, model_id, day, requests, input_tokens, output_tokens, cost)
+VALUES (@api_token_id, @actor_id, @model_id, @day, 1, @input_tokens, @output_tokens, @cost)
+ON CONFLICT (api_token_id, actor_id, model_id, day) DO UPDATE
+SET
+  requests = token_usages.requests + 1,
+  input_tokens = token_usages.input_tokens + EXCLUDED.input_tokens,
+  output_tokens = token_usages.output_tokens + EXCLUDED.output_tokens,
+  cost = token_usages.cost + EXCLUDED.cost;
+
+-- name: TokenUsageSumByActor :one
+SELECT
+  COALESCE(SUM(input_tokens + output_tokens), 0)::bigint AS tokens,
+  COALESCE(SUM(cost), 0)::float8 AS cost
+FROM token_usages
+WHERE actor_id = @actor_id AND day >= @since_day::bigint;
+
+-- name: TokenUsageListPaginated :many
+SELECT
+  sqlc.embed(token_usages),
+  COUNT(*) OVER() AS total_count
+FROM token_usages
+WHERE (sqlc.narg(actor_id)::bigint IS NULL OR token_usages.actor_id = sqlc.narg(actor_id)::bigint)
+  AND (sqlc.narg(from_day)::bigint IS NULL OR token_usages.day >= sqlc.narg(from_day)::bigint)
+  AND (sqlc.narg(to_day)::bigint IS NULL OR token_usages.day <= sqlc.narg(to_day)::bigint)
+ORDER BY token_usages.day DESC, token_usages.actor_id, token_usages.api_token_id, token_usages.model_id
+LIMIT sqlc.arg(page_size)::bigint
+OFFSET sqlc.arg(page_size)::bigint * (sqlc.arg(page)::bigint - 1);
+
+-- name: ActorQuotaListByActorId :many
+SELECT * FROM actor_quotas
+WHERE actor_id = @actor_id
+ORDER BY period;
+
+-- name: ActorQuotaDeleteByActorId :exec
+DELETE FROM actor_quotas
+WHERE actor_id = @actor_id;
+
+-- name: ActorQuotaInsert :one
+INSERT INTO actor_quotas (actor_id, period, max_tokens, max_cost)
+VALUES (@actor_id, @period, sqlc.narg(max_tokens), sqlc.narg(max_cost))
+RETURNING *;
@@ -0,0 +1,21 @@
+-- name: VectorCollectionInsert :one
+INSERT INTO vector_collections (database_name, name, fields, indexes)
+VALUES (@database_name, @name, @fields, @indexes)
+ON CONFLICT (database_name, name) DO NOTHING
+RETURNING *;
+
+-- name: VectorCollectionFindByName :one
+SELECT * FROM vector_collections
+WHERE database_name = @database_name AND name = @name;
+
+-- name: VectorCollectionListByDatabase :many
+SELECT name FROM vector_collections
+WHERE database_name = @database_name
+ORDER BY name;
+
+-- name: VectorCollectionListDatabases :many
+SELECT DISTINCT database_name FROM vector_collections
+ORDER BY database_name;
+
+-- name: VectorExtensionInstalled :one
+SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'vector')::boolean AS installed;
@@ -1,7 +1,26 @@
 package dbaccess
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
 
 // ErrNotFound is returned when a query by ID finds no matching rows.
 // For example, canceling a non-existent job returns this error.
 var ErrNotFound = errors.New("not found")
+
+// IsForeignKeyViolation returns true if the error is raised by a row that
+// references a missing row, or by deleting a row that is still referenced.
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation
+}
+
+// IsUniqueViolation returns true if the error is raised by a row that
+// duplicates the unique key of another row.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
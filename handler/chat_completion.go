@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+// chatContentPart is a part of the content of an OpenAI chat message
+type chatContentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	ImageUrl struct {
+		Url string `json:"url"`
+	} `json:"image_url"`
+}
+
+// fromChatCompletionRequest translates an OpenAI chat completion request into a completion request
+func fromChatCompletionRequest(body api.CreateChatCompletionJSONRequestBody) (llm.CompletionRequest, error) {
+	messages, err := fromChatCompletionMessages(body.Messages)
+	if err != nil {
+		return llm.CompletionRequest{}, err
+	}
+
+	tools := make([]llm.Tool, 0, len(lo.FromPtr(body.Tools)))
+	for _, t := range lo.FromPtr(body.Tools) {
+		parameters, err := json.Marshal(lo.FromPtrOr(t.Function.Parameters, map[string]interface{}{"type": "object"}))
+		if err != nil {
+			return llm.CompletionRequest{}, fmt.Errorf("Invalid parameters of tool %s", t.Function.Name)
+		}
+		tools = append(tools, llm.Tool{
+			Name:        t.Function.Name,
+			Description: lo.FromPtr(t.Function.Description),
+			Parameters:  parameters,
+		})
+	}
+
+	toolChoice, err := fromChatToolChoice(body.ToolChoice)
+	if err != nil {
+		return llm.CompletionRequest{}, err
+	}
+	stopSequences, err := fromChatStop(body.Stop)
+	if err != nil {
+		return llm.CompletionRequest{}, err
+	}
+
+	maxTokens := lo.FromPtrOr(body.MaxCompletionTokens, lo.FromPtrOr(body.MaxTokens, 8000))
+	return llm.CompletionRequest{
+		ModelID:       body.Model,
+		Messages:      messages,
+		Tools:         tools,
+		ToolChoice:    toolChoice,
+		StopSequences: stopSequences,
+		Temperature:   body.Temperature,
+		MaxTokens:     lo.ToPtr(int32(maxTokens)),
+	}, nil
+}
+
+// fromChatCompletionMessages translates OpenAI chat messages into completion messages. Developer messages are
+// system messages, and consecutive tool messages are merged into a single message of their results.
+func fromChatCompletionMessages(chatMessages []api.ChatCompletionMessage) ([]llm.Message, error) {
+	messages := make([]llm.Message, 0, len(chatMessages))
+	for _, m := range chatMessages {
+		contents, err := fromChatContent(m.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		switch m.Role {
+		case api.ChatCompletionMessageRoleSystem, api.ChatCompletionMessageRoleDeveloper:
+			messages = append(messages, llm.Message{Role: "system", Content: contents})
+		case api.ChatCompletionMessageRoleUser:
+			messages = append(messages, llm.Message{Role: "user", Content: contents})
+		case api.ChatCompletionMessageRoleAssistant:
+			for _, call := range lo.FromPtr(m.ToolCalls) {
+				contents = append(contents, llm.Content{ToolCall: &llm.ToolCall{
+					ID:           call.Id,
+					FunctionName: lo.FromPtr(call.Function.Name),
+					Arguments:    call.Function.Arguments,
+				}})
+			}
+			messages = append(messages, llm.Message{Role: "assistant", Content: contents})
+		case api.ChatCompletionMessageRoleTool:
+			if m.ToolCallId == nil {
+				return nil, fmt.Errorf("Tool message must have tool_call_id")
+			}
+			result := llm.Content{ToolResult: &llm.ToolResult{
+				ID:     *m.ToolCallId,
+				Result: strings.Join(lo.Map(contents, func(c llm.Content, _ int) string { return c.Text }), ""),
+			}}
+			if len(messages) > 0 && messages[len(messages)-1].Role == "tool" {
+				messages[len(messages)-1].Content = append(messages[len(messages)-1].Content, result)
+			} else {
+				messages = append(messages, llm.Message{Role: "tool", Content: []llm.Content{result}})
+			}
+		default:
+			return nil, fmt.Errorf("Invalid message role %s", m.Role)
+		}
+	}
+	return messages, nil
+}
+
+// fromChatContent translates the content of an OpenAI chat message, which is either a string or an array of parts
+func fromChatContent(content *interface{}) ([]llm.Content, error) {
+	if content == nil || *content == nil {
+		return []llm.Content{}, nil
+	}
+	if text, ok := (*content).(string); ok {
+		if text == "" {
+			return []llm.Content{}, nil
+		}
+		return []llm.Content{{Text: text}}, nil
+	}
+
+	raw, err := json.Marshal(*content)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid message content")
+	}
+	var parts []chatContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return nil, fmt.Errorf("Invalid message content")
+	}
+
+	contents := make([]llm.Content, 0, len(parts))
+	for _, part := range parts {
+		switch part.Type {
+		case "text":
+			contents = append(contents, llm.Content{Text: part.Text})
+		case "image_url":
+			url := part.ImageUrl.Url
+			if !strings.HasPrefix(url, "data:") {
+				contents = append(contents, llm.Content{ImageURL: url})
+				continue
+			}
+			// a data URL is data:<media type>;base64,<data>
+			_, data, ok := strings.Cut(url, ";base64,")
+			if !ok {
+				return nil, fmt.Errorf("Invalid image data URL")
+			}
+			image, err := base64.StdEncoding.DecodeString(data)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid base64 image encoding")
+			}
+			contents = append(contents, llm.Content{Image: image})
+		default:
+			return nil, fmt.Errorf("Unsupported message content type %s", part.Type)
+		}
+	}
+	return contents, nil
+}
+
+// fromChatToolChoice translates an OpenAI tool choice, which is either a string or a function object
+func fromChatToolChoice(toolChoice *interface{}) (*llm.ToolChoice, error) {
+	if toolChoice == nil || *toolChoice == nil {
+		return nil, nil
+	}
+
+	switch choice := (*toolChoice).(type) {
+	case string:
+		switch choice {
+		case llm.ToolChoiceAuto, llm.ToolChoiceNone, llm.ToolChoiceRequired:
+			return &llm.ToolChoice{Type: choice}, nil
+		}
+	case map[string]interface{}:
+		function, _ := choice["function"].(map[string]interface{})
+		name, _ := function["name"].(string)
+		if choice["type"] == llm.ToolChoiceFunction && name != "" {
+			return &llm.ToolChoice{Type: llm.ToolChoiceFunction, FunctionName: name}, nil
+		}
+	}
+	return nil, fmt.Errorf("Invalid tool_choice")
+}
+
+// fromChatStop translates OpenAI stop sequences, which are either a string or an array of strings
+func fromChatStop(stop *interface{}) ([]string, error) {
+	if stop == nil || *stop == nil {
+		return nil, nil
+	}
+
+	switch s := (*stop).(type) {
+	case string:
+		return []string{s}, nil
+	case []interface{}:
+		sequences := make([]string, 0, len(s))
+		for _, sequence := range s {
+			text, ok := sequence.(string)
+			if !ok {
+				return nil, fmt.Errorf("Invalid stop")
+			}
+			sequences = append(sequences, text)
+		}
+		return sequences, nil
+	}
+	return nil, fmt.Errorf("Invalid stop")
+}
+
+// toChatCompletion translates a completion result into an OpenAI chat completion with a single choice
+func toChatCompletion(id string, model string, created time.Time, result llm.CompletionResult) api.ChatCompletion {
+	var text strings.Builder
+	var toolCalls []api.ChatCompletionToolCall
+	for _, m := range result.Messages {
+		for _, c := range m.Content {
+			text.WriteString(c.Text)
+			if c.ToolCall != nil {
+				toolCalls = append(toolCalls, api.ChatCompletionToolCall{
+					Id:   c.ToolCall.ID,
+					Type: api.ChatCompletionToolCallTypeFunction,
+					Function: api.ChatCompletionFunctionCall{
+						Name:      lo.ToPtr(c.ToolCall.FunctionName),
+						Arguments: c.ToolCall.Arguments,
+					},
+				})
+			}
+		}
+	}
+
+	message := api.ChatCompletionMessage{Role: api.ChatCompletionMessageRoleAssistant}
+	if text.Len() > 0 {
+		message.Content = lo.ToPtr[interface{}](text.String())
+	}
+	if len(toolCalls) > 0 {
+		message.ToolCalls = &toolCalls
+	}
+	return api.ChatCompletion{
+		Id:      id,
+		Object:  "chat.completion",
+		Created: created.Unix(),
+		Model:   model,
+		Choices: []api.ChatCompletionChoice{{
+			Index:        0,
+			Message:      message,
+			FinishReason: chatFinishReason(len(toolCalls) > 0),
+		}},
+		Usage: toChatCompletionUsage(result.Usage),
+	}
+}
+
+func toChatCompletionUsage(usage llm.CompletionUsage) api.ChatCompletionUsage {
+	return api.ChatCompletionUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.InputTokens + usage.OutputTokens,
+	}
+}
+
+func chatFinishReason(toolCalled bool) string {
+	return lo.Ternary(toolCalled, "tool_calls", "stop")
+}
+
+// newChatCompletionId returns a random ID in the format of the OpenAI chat completions
+func newChatCompletionId() string {
+	b := make([]byte, 18)
+	_, _ = rand.Read(b)
+	return "chatcmpl-" + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// chatCompletionStreamResponse is the response of CreateChatCompletion when streaming is requested. Every delta is
+// sent as a chunk in the OpenAI format as soon as it arrives, and the stream ends with a [DONE] event.
+type chatCompletionStreamResponse struct {
+	ctx     context.Context
+	logger  *slog.Logger
+	adapter *adapter.RoutedAdapter
+	request llm.CompletionRequest
+	id      string
+	created time.Time
+	// includeUsage sends the usage in a last chunk without choices
+	includeUsage bool
+	// recordUsage adds the usage of the completion to the ledger once it's finished or has failed
+	recordUsage func(llm.CompletionUsage)
+}
+
+func (response chatCompletionStreamResponse) VisitCreateChatCompletionResponse(w http.ResponseWriter) error {
+	flusher, _ := w.(http.Flusher)
+	writeData := func(payload []byte) error {
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", payload); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+	writeJSON := func(data interface{}) error {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return writeData(payload)
+	}
+	// the model of a chunk is the one generating the completion, which is only known once it has started
+	chunk := func(choices []api.ChatCompletionChunkChoice) api.ChatCompletionChunk {
+		return api.ChatCompletionChunk{
+			Id:      response.id,
+			Object:  "chat.completion.chunk",
+			Created: response.created.Unix(),
+			Model:   response.adapter.Model().ID,
+			Choices: choices,
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	started := false
+	toolCalled := false
+	start := time.Now()
+	usage, err := response.adapter.StreamCompletion(response.ctx, response.request, func(delta llm.CompletionDelta) error {
+		chatDelta := api.ChatCompletionDelta{}
+		if !started {
+			started = true
+			chatDelta.Role = lo.ToPtr("assistant")
+		}
+		if delta.Text != "" {
+			chatDelta.Content = &delta.Text
+		}
+		if delta.ToolCall != nil {
+			toolCalled = true
+			chatDelta.ToolCalls = &[]api.ChatCompletionToolCallDelta{toChatToolCallDelta(*delta.ToolCall)}
+		}
+		return writeJSON(chunk([]api.ChatCompletionChunkChoice{{Index: 0, Delta: chatDelta}}))
+	})
+	metrics.ObserveLLMCompletion(response.adapter.Model().Provider, start, err)
+	// the tokens consumed until a failure are accounted too, including when the client disconnects
+	response.recordUsage(usage)
+	if err != nil {
+		// the status is already sent, so the error can only be reported as an event
+		response.logger.Error("Error streaming chat completion", "id", response.id, "error", err)
+		return writeJSON(api.Error{Error: err.Error()})
+	}
+
+	if err := writeJSON(chunk([]api.ChatCompletionChunkChoice{{
+		Index:        0,
+		Delta:        api.ChatCompletionDelta{},
+		FinishReason: lo.ToPtr(chatFinishReason(toolCalled)),
+	}})); err != nil {
+		return err
+	}
+	if response.includeUsage {
+		usageChunk := chunk([]api.ChatCompletionChunkChoice{})
+		usageChunk.Usage = lo.ToPtr(toChatCompletionUsage(usage))
+		if err := writeJSON(usageChunk); err != nil {
+			return err
+		}
+	}
+	return writeData([]byte("[DONE]"))
+}
+
+func toChatToolCallDelta(delta llm.ToolCallDelta) api.ChatCompletionToolCallDelta {
+	result := api.ChatCompletionToolCallDelta{
+		Index: delta.Index,
+		Function: &api.ChatCompletionFunctionCall{
+			Name:      lo.EmptyableToPtr(delta.FunctionName),
+			Arguments: delta.Arguments,
+		},
+	}
+	if delta.ID != "" {
+		result.Id = &delta.ID
+		result.Type = lo.ToPtr("function")
+	}
+	return result
+}
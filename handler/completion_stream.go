@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+// completionStreamResponse is the response of CreateCompletion when streaming is requested. The completion is
+// generated while the response is written, so that every delta is sent as a Server-Sent Event as soon as it arrives.
+type completionStreamResponse struct {
+	ctx     context.Context
+	logger  *slog.Logger
+	adapter *adapter.RoutedAdapter
+	request llm.CompletionRequest
+	traceId string
+	// recordUsage adds the usage of the completion to the ledger once it's finished or has failed
+	recordUsage func(llm.CompletionUsage)
+}
+
+func (response completionStreamResponse) VisitCreateCompletionResponse(w http.ResponseWriter) error {
+	flusher, _ := w.(http.Flusher)
+	writeEvent := func(event string, data interface{}) error {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	start := time.Now()
+	usage, err := response.adapter.StreamCompletion(response.ctx, response.request, func(delta llm.CompletionDelta) error {
+		return writeEvent("delta", toAPICompletionDelta(delta))
+	})
+	metrics.ObserveLLMCompletion(response.adapter.Model().Provider, start, err)
+	// the tokens consumed until a failure are accounted too, including when the client disconnects
+	response.recordUsage(usage)
+	if err != nil {
+		// the status is already sent, so the error can only be reported as an event
+		response.logger.Error("Error streaming completion", "trace_id", response.traceId, "error", err)
+		return writeEvent("error", api.Error{Error: err.Error()})
+	}
+	return writeEvent("done", api.CompletionUsage{
+		InputTokens:  usage.InputTokens,
+		OutputTokens: usage.OutputTokens,
+	})
+}
+
+func toAPICompletionDelta(delta llm.CompletionDelta) api.CompletionDelta {
+	result := api.CompletionDelta{}
+	if delta.Text != "" {
+		result.Text = &delta.Text
+	}
+	if delta.ToolCall != nil {
+		result.ToolCall = &api.ToolCallDelta{
+			Index:     delta.ToolCall.Index,
+			Id:        lo.EmptyableToPtr(delta.ToolCall.ID),
+			Name:      lo.EmptyableToPtr(delta.ToolCall.FunctionName),
+			Arguments: lo.EmptyableToPtr(delta.ToolCall.Arguments),
+		}
+	}
+	return result
+}
@@ -4,25 +4,33 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/samber/lo"
 	"gitlab.com/navyx/ai/maos/maos-core/admin"
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/retention"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/suitestore"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/usage"
 	"gitlab.com/navyx/ai/maos/maos-core/invocation"
 	"gitlab.com/navyx/ai/maos/maos-core/k8s"
 	"gitlab.com/navyx/ai/maos/maos-core/llm"
 	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+	"gitlab.com/navyx/ai/maos/maos-core/middleware"
 	"gitlab.com/navyx/ai/maos/maos-core/util"
+	"gitlab.com/navyx/ai/maos/maos-core/vectorstore"
 )
 
 type NewAPIHandlerParams struct {
 	Logger          *slog.Logger
 	SourcePool      dbaccess.SourcePool
 	SuiteStore      suitestore.SuiteStore
+	S3Client        suitestore.S3ClientInterface
 	K8sController   k8s.Controller
 	AOAIEndpoint    string
 	AOAIAPIKey      string
@@ -34,6 +42,7 @@ func NewAPIHandler(params NewAPIHandlerParams) *APIHandler {
 		logger:            params.Logger,
 		dataSource:        params.SourcePool,
 		invocationManager: invocation.NewManager(params.Logger, params.SourcePool),
+		retentionWorker:   retention.NewWorker(params.Logger, params.SourcePool, admin.LoadRetentionPolicy, params.S3Client, retention.WorkerConfig{}),
 		suiteStore:        params.SuiteStore,
 		k8sController:     params.K8sController,
 		AdapterCredentials: adapter.AdapterCredentials{
@@ -48,16 +57,30 @@ type APIHandler struct {
 	logger             *slog.Logger
 	dataSource         dbaccess.DataSource
 	invocationManager  *invocation.Manager
+	retentionWorker    *retention.Worker
 	suiteStore         suitestore.SuiteStore
 	k8sController      k8s.Controller
 	AdapterCredentials adapter.AdapterCredentials
 }
 
 func (s *APIHandler) Start(ctx context.Context) error {
-	return s.invocationManager.Start(ctx)
+	// vector collections are optional, so a missing pgvector only disables them
+	if err := vectorstore.CheckPgvector(ctx, s.dataSource); err != nil {
+		s.logger.Warn("Vector collections are unavailable", "error", err)
+	}
+
+	if err := s.invocationManager.Start(ctx); err != nil {
+		return err
+	}
+	if err := s.retentionWorker.Start(ctx); err != nil {
+		s.invocationManager.Close(ctx)
+		return err
+	}
+	return nil
 }
 
 func (s *APIHandler) Close(ctx context.Context) error {
+	s.retentionWorker.Stop()
 	return s.invocationManager.Close(ctx)
 }
 
@@ -83,6 +106,14 @@ func (s *APIHandler) CreateInvocationSync(ctx context.Context, request api.Creat
 	return s.invocationManager.ExecuteInvocationSync(ctx, token.ActorId, request)
 }
 
+func (s *APIHandler) CreateInvocationBatch(ctx context.Context, request api.CreateInvocationBatchRequestObject) (api.CreateInvocationBatchResponseObject, error) {
+	token := ValidatePermissions(ctx, "CreateInvocationBatch")
+	if token == nil {
+		return api.CreateInvocationBatch401Response{}, nil
+	}
+	return s.invocationManager.InsertInvocationBatch(ctx, token.ActorId, request)
+}
+
 func (s *APIHandler) GetInvocationById(ctx context.Context, request api.GetInvocationByIdRequestObject) (api.GetInvocationByIdResponseObject, error) {
 	token := ValidatePermissions(ctx, "CreateInvocationSync")
 	if token == nil {
@@ -120,12 +151,149 @@ func (s *APIHandler) ReturnInvocationError(ctx context.Context, request api.Retu
 	return s.invocationManager.ReturnInvocationError(ctx, token.ActorId, request)
 }
 
+// CancelInvocation implements the POST /v1/invocation/{id}/cancel endpoint
+func (s *APIHandler) CancelInvocation(ctx context.Context, request api.CancelInvocationRequestObject) (api.CancelInvocationResponseObject, error) {
+	token := GetContextToken(ctx)
+	if token == nil {
+		return api.CancelInvocation401Response{}, nil
+	}
+
+	// admins can cancel any invocation, others only the ones they created
+	isAdmin := lo.Contains(token.Permissions, "admin")
+	if !isAdmin && ValidatePermissions(ctx, "CancelInvocation") == nil {
+		return api.CancelInvocation401Response{}, nil
+	}
+
+	return s.invocationManager.CancelInvocation(ctx, token.ActorId, isAdmin, request)
+}
+
+// HeartbeatInvocation implements the POST /v1/invocation/{invoke_id}/heartbeat endpoint
+func (s *APIHandler) HeartbeatInvocation(ctx context.Context, request api.HeartbeatInvocationRequestObject) (api.HeartbeatInvocationResponseObject, error) {
+	token := ValidatePermissions(ctx, "HeartbeatInvocation")
+	if token == nil {
+		return api.HeartbeatInvocation401Response{}, nil
+	}
+
+	return s.invocationManager.HeartbeatInvocation(ctx, token.ActorId, request)
+}
+
 func (s *APIHandler) ListEmbeddingModels(ctx context.Context, request api.ListEmbeddingModelsRequestObject) (api.ListEmbeddingModelsResponseObject, error) {
-	panic("not implemented")
+	s.logger.Info("ListEmbeddingModels")
+
+	token := ValidatePermissions(ctx, "ListEmbeddingModels")
+	if token == nil {
+		return api.ListEmbeddingModels401Response{}, nil
+	}
+	models := lo.Map(adapter.GetEmbeddingModelList(), func(model llm.EmbeddingModel, _ int) struct {
+		Dimension int    `json:"dimension"`
+		Id        string `json:"id"`
+		Name      string `json:"name"`
+		Provider  string `json:"provider"`
+	} {
+		return struct {
+			Dimension int    `json:"dimension"`
+			Id        string `json:"id"`
+			Name      string `json:"name"`
+			Provider  string `json:"provider"`
+		}{
+			Dimension: model.Dimension,
+			Id:        model.ID,
+			Name:      model.Name,
+			Provider:  model.Provider,
+		}
+	})
+	return api.ListEmbeddingModels200JSONResponse{Data: models}, nil
 }
 
 func (s *APIHandler) CreateEmbedding(ctx context.Context, request api.CreateEmbeddingRequestObject) (api.CreateEmbeddingResponseObject, error) {
-	panic("not implemented")
+	s.logger.Info(
+		"CreateEmbedding",
+		"ModelId", request.Body.ModelId,
+		"InputCount", len(request.Body.Input),
+		"InputType", request.Body.InputType,
+	)
+
+	token := ValidatePermissions(ctx, "CreateEmbedding")
+	if token == nil {
+		return api.CreateEmbedding401Response{}, nil
+	}
+
+	embeddingAdapter, err := adapter.CreateEmbeddingAdapter(request.Body.ModelId, s.AdapterCredentials)
+	if err != nil {
+		return api.CreateEmbedding400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Model %s not found", request.Body.ModelId)},
+		}, nil
+	}
+	if len(request.Body.Input) == 0 {
+		return api.CreateEmbedding400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: "Input is empty"}}, nil
+	}
+
+	result, err := embeddingAdapter.GetEmbedding(ctx, llm.EmbeddingRequest{
+		ModelID:   request.Body.ModelId,
+		Input:     request.Body.Input,
+		InputType: (*string)(request.Body.InputType),
+	})
+	if err != nil {
+		s.logger.Error("Error creating embedding", "error", err)
+		return api.CreateEmbedding500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Error creating embedding: %v", err)},
+		}, nil
+	}
+
+	return api.CreateEmbedding200JSONResponse{
+		Data: lo.ToPtr(lo.Map(result.Data, func(embedding llm.Embedding, _ int) api.Embedding {
+			return api.Embedding{
+				Embedding: lo.ToPtr(lo.Map(embedding.Embedding, func(value float64, _ int) float32 {
+					return float32(value)
+				})),
+				Index: lo.ToPtr(embedding.Index),
+			}
+		})),
+	}, nil
+}
+
+// errCompletionModelNotFound is returned by newCompletionAdapter when the model is neither a completion model nor a
+// virtual model of the setting
+var errCompletionModelNotFound = errors.New("completion model not found")
+
+// newCompletionAdapter creates the adapter of a completion. A virtual model of the setting is routed by its policy,
+// and any other model is completed by itself.
+func (s *APIHandler) newCompletionAdapter(ctx context.Context, modelId string) (*adapter.RoutedAdapter, error) {
+	setting, err := admin.LoadSetting(ctx, s.dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot load setting: %w", err)
+	}
+	policy, ok := setting.RoutingPolicy(modelId)
+	if !ok {
+		policy = adapter.SingleModelPolicy(modelId)
+	}
+	routedAdapter, err := adapter.NewRoutedAdapter(policy, s.AdapterCredentials)
+	if err != nil {
+		if ok {
+			return nil, fmt.Errorf("Cannot create adapters of virtual model: %w", err)
+		}
+		return nil, errCompletionModelNotFound
+	}
+	return routedAdapter, nil
+}
+
+// completionUsageRecorder returns a function which adds the usage of a finished completion to the ledger. The usage
+// is accounted to the model which generated the completion, even if a virtual model was requested.
+func (s *APIHandler) completionUsageRecorder(ctx context.Context, token *middleware.Token, routedAdapter *adapter.RoutedAdapter, traceId string) func(llm.CompletionUsage) {
+	return func(u llm.CompletionUsage) {
+		model := routedAdapter.Model()
+		entry := usage.Entry{
+			ApiTokenId: token.Id,
+			ActorId:    token.ActorId,
+			ModelId:    model.ID,
+			Usage:      u,
+			Cost:       model.Cost(u),
+		}
+		// the completion is already done, so a failure to account it is only logged
+		if err := usage.Record(context.WithoutCancel(ctx), s.dataSource, entry, time.Now()); err != nil {
+			s.logger.Error("Cannot record token usage", "trace_id", traceId, "error", err)
+		}
+	}
 }
 
 func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCompletionRequestObject) (api.CreateCompletionResponseObject, error) {
@@ -138,6 +306,7 @@ func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCom
 		"StopSequences", request.Body.StopSequences,
 		"Messages", request.Body.Messages,
 		"Tools", request.Body.Tools,
+		"Stream", request.Body.Stream,
 	)
 
 	return400Error := func(message string) (api.CreateCompletionResponseObject, error) {
@@ -149,9 +318,12 @@ func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCom
 		return api.CreateCompletion401Response{}, nil
 	}
 
-	adapter, err := adapter.CreateAdapter(request.Body.ModelId, s.AdapterCredentials)
-	if err != nil {
+	routedAdapter, err := s.newCompletionAdapter(ctx, request.Body.ModelId)
+	if errors.Is(err, errCompletionModelNotFound) {
 		return return400Error(fmt.Sprintf("Model %s not found", request.Body.ModelId))
+	} else if err != nil {
+		s.logger.Error("Cannot create completion adapter", "trace_id", request.Body.TraceId, "error", err)
+		return api.CreateCompletion500JSONResponse{N500JSONResponse: api.N500JSONResponse{Error: err.Error()}}, nil
 	}
 
 	messages := make([]llm.Message, 0, len(request.Body.Messages))
@@ -224,7 +396,32 @@ func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCom
 		MaxTokens:   lo.ToPtr(int32(lo.FromPtrOr(request.Body.MaxTokens, 8000))),
 	}
 
-	result, err := adapter.GetCompletion(ctx, completionRequest)
+	if err := usage.CheckQuotas(ctx, s.dataSource, token.ActorId, time.Now()); err != nil {
+		var quotaErr *usage.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			return api.CreateCompletion429JSONResponse{N429JSONResponse: api.N429JSONResponse{Error: err.Error()}}, nil
+		}
+		s.logger.Error("Cannot check quotas", "trace_id", request.Body.TraceId, "error", err)
+		return api.CreateCompletion500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot check quotas: %v", err)},
+		}, nil
+	}
+	recordUsage := s.completionUsageRecorder(ctx, token, routedAdapter, request.Body.TraceId)
+
+	if lo.FromPtr(request.Body.Stream) {
+		return completionStreamResponse{
+			ctx:         ctx,
+			logger:      s.logger,
+			adapter:     routedAdapter,
+			request:     completionRequest,
+			traceId:     request.Body.TraceId,
+			recordUsage: recordUsage,
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := routedAdapter.GetCompletion(ctx, completionRequest)
+	metrics.ObserveLLMCompletion(routedAdapter.Model().Provider, start, err)
 	if err != nil {
 		s.logger.Error("Error creating completion", "error", err)
 		return api.CreateCompletion500JSONResponse{
@@ -233,8 +430,13 @@ func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCom
 			},
 		}, nil
 	}
+	recordUsage(result.Usage)
 
 	return api.CreateCompletion200JSONResponse{
+		Usage: api.CompletionUsage{
+			InputTokens:  result.Usage.InputTokens,
+			OutputTokens: result.Usage.OutputTokens,
+		},
 		Messages: lo.Map(result.Messages, func(m llm.Message, _ int) api.Message {
 			return api.Message{
 				Role: api.MessageRole(m.Role),
@@ -267,6 +469,78 @@ func (s *APIHandler) CreateCompletion(ctx context.Context, request api.CreateCom
 	}, nil
 }
 
+// CreateChatCompletion is a facade of CreateCompletion in the format of the OpenAI Chat Completions API
+func (s *APIHandler) CreateChatCompletion(ctx context.Context, request api.CreateChatCompletionRequestObject) (api.CreateChatCompletionResponseObject, error) {
+	id := newChatCompletionId()
+	s.logger.Info(
+		"CreateChatCompletion",
+		"id", id,
+		"Model", request.Body.Model,
+		"Messages", request.Body.Messages,
+		"Tools", request.Body.Tools,
+		"ToolChoice", request.Body.ToolChoice,
+		"Stream", request.Body.Stream,
+	)
+
+	return400Error := func(message string) (api.CreateChatCompletionResponseObject, error) {
+		return api.CreateChatCompletion400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: message}}, nil
+	}
+
+	token := ValidatePermissions(ctx, "CreateChatCompletion")
+	if token == nil {
+		return api.CreateChatCompletion401Response{}, nil
+	}
+
+	completionRequest, err := fromChatCompletionRequest(*request.Body)
+	if err != nil {
+		return return400Error(err.Error())
+	}
+
+	routedAdapter, err := s.newCompletionAdapter(ctx, request.Body.Model)
+	if errors.Is(err, errCompletionModelNotFound) {
+		return return400Error(fmt.Sprintf("Model %s not found", request.Body.Model))
+	} else if err != nil {
+		s.logger.Error("Cannot create completion adapter", "id", id, "error", err)
+		return api.CreateChatCompletion500JSONResponse{N500JSONResponse: api.N500JSONResponse{Error: err.Error()}}, nil
+	}
+
+	if err := usage.CheckQuotas(ctx, s.dataSource, token.ActorId, time.Now()); err != nil {
+		var quotaErr *usage.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			return api.CreateChatCompletion429JSONResponse{N429JSONResponse: api.N429JSONResponse{Error: err.Error()}}, nil
+		}
+		s.logger.Error("Cannot check quotas", "id", id, "error", err)
+		return api.CreateChatCompletion500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot check quotas: %v", err)},
+		}, nil
+	}
+	recordUsage := s.completionUsageRecorder(ctx, token, routedAdapter, id)
+
+	if lo.FromPtr(request.Body.Stream) {
+		return chatCompletionStreamResponse{
+			ctx:          ctx,
+			logger:       s.logger,
+			adapter:      routedAdapter,
+			request:      completionRequest,
+			id:           id,
+			created:      time.Now(),
+			includeUsage: request.Body.StreamOptions != nil && lo.FromPtr(request.Body.StreamOptions.IncludeUsage),
+			recordUsage:  recordUsage,
+		}, nil
+	}
+
+	start := time.Now()
+	result, err := routedAdapter.GetCompletion(ctx, completionRequest)
+	metrics.ObserveLLMCompletion(routedAdapter.Model().Provider, start, err)
+	if err != nil {
+		s.logger.Error("Error creating chat completion", "id", id, "error", err)
+		return api.CreateChatCompletion500JSONResponse{N500JSONResponse: api.N500JSONResponse{Error: err.Error()}}, nil
+	}
+	recordUsage(result.Usage)
+
+	return api.CreateChatCompletion200JSONResponse(toChatCompletion(id, routedAdapter.Model().ID, start, result)), nil
+}
+
 func (s *APIHandler) ListCompletionModels(ctx context.Context, request api.ListCompletionModelsRequestObject) (api.ListCompletionModelsResponseObject, error) {
 	s.logger.Info("ListCompletionModels", "trace_id", request.Params.TraceId)
 
@@ -293,31 +567,120 @@ func (s *APIHandler) ListCompletionModels(ctx context.Context, request api.ListC
 }
 
 func (s *APIHandler) CreateRerank(ctx context.Context, request api.CreateRerankRequestObject) (api.CreateRerankResponseObject, error) {
-	panic("not implemented")
+	s.logger.Info(
+		"CreateRerank",
+		"ModelId", request.Body.ModelId,
+		"DocumentCount", len(request.Body.Documents),
+		"TopK", request.Body.TopK,
+		"ReturnDocuments", request.Body.ReturnDocuments,
+	)
+
+	token := ValidatePermissions(ctx, "CreateRerank")
+	if token == nil {
+		return api.CreateRerank401Response{}, nil
+	}
+
+	rerankAdapter, err := adapter.CreateRerankAdapter(request.Body.ModelId, s.AdapterCredentials)
+	if err != nil {
+		return api.CreateRerank400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("Model %s not found", request.Body.ModelId)},
+		}, nil
+	}
+	if len(request.Body.Documents) == 0 {
+		return api.CreateRerank400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: "Documents are empty"}}, nil
+	}
+	if request.Body.TopK != nil && *request.Body.TopK < 1 {
+		return api.CreateRerank400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: "top_k must be positive"}}, nil
+	}
+
+	result, err := rerankAdapter.Rerank(ctx, llm.RerankRequest{
+		ModelID:         request.Body.ModelId,
+		Query:           request.Body.Query,
+		Documents:       request.Body.Documents,
+		TopK:            request.Body.TopK,
+		ReturnDocuments: lo.FromPtr(request.Body.ReturnDocuments),
+	})
+	if err != nil {
+		s.logger.Error("Error creating rerank", "error", err)
+		return api.CreateRerank500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Error creating rerank: %v", err)},
+		}, nil
+	}
+
+	return api.CreateRerank201JSONResponse{
+		Data: lo.Map(result.Data, func(document llm.RerankedDocument, _ int) api.RerankResult {
+			return api.RerankResult{
+				Index: document.Index,
+				Score: float32(document.Score),
+				Text:  lo.EmptyableToPtr(document.Text),
+			}
+		}),
+	}, nil
 }
 
 func (s *APIHandler) ListRerankModels(ctx context.Context, request api.ListRerankModelsRequestObject) (api.ListRerankModelsResponseObject, error) {
-	panic("not implemented")
+	s.logger.Info("ListRerankModels")
+
+	token := ValidatePermissions(ctx, "ListRerankModels")
+	if token == nil {
+		return api.ListRerankModels401Response{}, nil
+	}
+	models := lo.Map(adapter.GetRerankModelList(), func(model llm.RerankModel, _ int) struct {
+		Id       string `json:"id"`
+		Name     string `json:"name"`
+		Provider string `json:"provider"`
+	} {
+		return struct {
+			Id       string `json:"id"`
+			Name     string `json:"name"`
+			Provider string `json:"provider"`
+		}{
+			Id:       model.ID,
+			Name:     model.Name,
+			Provider: model.Provider,
+		}
+	})
+	return api.ListRerankModels200JSONResponse{Data: models}, nil
 }
 
 func (s *APIHandler) ListCollection(ctx context.Context, request api.ListCollectionRequestObject) (api.ListCollectionResponseObject, error) {
-	panic("not implemented")
+	token := ValidatePermissions(ctx, "ListCollection")
+	if token == nil {
+		return api.ListCollection401Response{}, nil
+	}
+	return vectorstore.ListCollections(ctx, s.logger, s.dataSource, request)
 }
 
 func (s *APIHandler) CreateCollection(ctx context.Context, request api.CreateCollectionRequestObject) (api.CreateCollectionResponseObject, error) {
-	panic("not implemented")
+	token := ValidatePermissions(ctx, "CreateCollection")
+	if token == nil {
+		return api.CreateCollection401Response{}, nil
+	}
+	return vectorstore.CreateCollection(ctx, s.logger, s.dataSource, request)
 }
 
 func (s *APIHandler) QueryCollection(ctx context.Context, request api.QueryCollectionRequestObject) (api.QueryCollectionResponseObject, error) {
-	panic("not implemented")
+	token := ValidatePermissions(ctx, "QueryCollection")
+	if token == nil {
+		return api.QueryCollection401Response{}, nil
+	}
+	return vectorstore.QueryCollection(ctx, s.logger, s.dataSource, s.AdapterCredentials, request)
 }
 
 func (s *APIHandler) UpsertCollection(ctx context.Context, request api.UpsertCollectionRequestObject) (api.UpsertCollectionResponseObject, error) {
-	panic("not implemented")
+	token := ValidatePermissions(ctx, "UpsertCollection")
+	if token == nil {
+		return api.UpsertCollection401Response{}, nil
+	}
+	return vectorstore.UpsertCollection(ctx, s.logger, s.dataSource, s.AdapterCredentials, request)
 }
 
 func (s *APIHandler) ListVectoreStores(ctx context.Context, request api.ListVectoreStoresRequestObject) (api.ListVectoreStoresResponseObject, error) {
-	panic("not implemented")
+	token := ValidatePermissions(ctx, "ListVectoreStores")
+	if token == nil {
+		return api.ListVectoreStores401Response{}, nil
+	}
+	return vectorstore.ListVectorStores(ctx, s.logger, s.dataSource, request)
 }
 
 func (s *APIHandler) AdminListActors(ctx context.Context, request api.AdminListActorsRequestObject) (api.AdminListActorsResponseObject, error) {
@@ -360,6 +723,126 @@ func (s *APIHandler) AdminDeleteActor(ctx context.Context, request api.AdminDele
 	return admin.DeleteActor(ctx, s.logger, s.dataSource, request)
 }
 
+func (s *APIHandler) AdminPauseActorQueue(ctx context.Context, request api.AdminPauseActorQueueRequestObject) (api.AdminPauseActorQueueResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminPauseActorQueue")
+	if token == nil {
+		return api.AdminPauseActorQueue401Response{}, nil
+	}
+	return admin.PauseActorQueue(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminResumeActorQueue(ctx context.Context, request api.AdminResumeActorQueueRequestObject) (api.AdminResumeActorQueueResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminResumeActorQueue")
+	if token == nil {
+		return api.AdminResumeActorQueue401Response{}, nil
+	}
+	return admin.ResumeActorQueue(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListActorQuotas(ctx context.Context, request api.AdminListActorQuotasRequestObject) (api.AdminListActorQuotasResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListActorQuotas")
+	if token == nil {
+		return api.AdminListActorQuotas401Response{}, nil
+	}
+	return admin.ListActorQuotas(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminSetActorQuotas(ctx context.Context, request api.AdminSetActorQuotasRequestObject) (api.AdminSetActorQuotasResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminSetActorQuotas")
+	if token == nil {
+		return api.AdminSetActorQuotas401Response{}, nil
+	}
+	return admin.SetActorQuotas(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListTokenUsages(ctx context.Context, request api.AdminListTokenUsagesRequestObject) (api.AdminListTokenUsagesResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListTokenUsages")
+	if token == nil {
+		return api.AdminListTokenUsages401Response{}, nil
+	}
+	return admin.ListTokenUsages(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListQueueStats(ctx context.Context, request api.AdminListQueueStatsRequestObject) (api.AdminListQueueStatsResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListQueueStats")
+	if token == nil {
+		return api.AdminListQueueStats401Response{}, nil
+	}
+	return admin.ListQueueStats(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListInvocations(ctx context.Context, request api.AdminListInvocationsRequestObject) (api.AdminListInvocationsResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListInvocations")
+	if token == nil {
+		return api.AdminListInvocations401Response{}, nil
+	}
+	return admin.ListInvocations(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListDeadLetters(ctx context.Context, request api.AdminListDeadLettersRequestObject) (api.AdminListDeadLettersResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListDeadLetters")
+	if token == nil {
+		return api.AdminListDeadLetters401Response{}, nil
+	}
+	return admin.ListDeadLetters(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminReplayDeadLetters(ctx context.Context, request api.AdminReplayDeadLettersRequestObject) (api.AdminReplayDeadLettersResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminReplayDeadLetters")
+	if token == nil {
+		return api.AdminReplayDeadLetters401Response{}, nil
+	}
+	return admin.ReplayDeadLetters(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminPurgeDeadLetters(ctx context.Context, request api.AdminPurgeDeadLettersRequestObject) (api.AdminPurgeDeadLettersResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminPurgeDeadLetters")
+	if token == nil {
+		return api.AdminPurgeDeadLetters401Response{}, nil
+	}
+	return admin.PurgeDeadLetters(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminListSchedules(ctx context.Context, request api.AdminListSchedulesRequestObject) (api.AdminListSchedulesResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminListSchedules")
+	if token == nil {
+		return api.AdminListSchedules401Response{}, nil
+	}
+	return admin.ListSchedules(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminCreateSchedule(ctx context.Context, request api.AdminCreateScheduleRequestObject) (api.AdminCreateScheduleResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminCreateSchedule")
+	if token == nil {
+		return api.AdminCreateSchedule401Response{}, nil
+	}
+	return admin.CreateSchedule(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminGetSchedule(ctx context.Context, request api.AdminGetScheduleRequestObject) (api.AdminGetScheduleResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminGetSchedule")
+	if token == nil {
+		return api.AdminGetSchedule401Response{}, nil
+	}
+	return admin.GetSchedule(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminUpdateSchedule(ctx context.Context, request api.AdminUpdateScheduleRequestObject) (api.AdminUpdateScheduleResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminUpdateSchedule")
+	if token == nil {
+		return api.AdminUpdateSchedule401Response{}, nil
+	}
+	return admin.UpdateSchedule(ctx, s.logger, s.dataSource, request)
+}
+
+func (s *APIHandler) AdminDeleteSchedule(ctx context.Context, request api.AdminDeleteScheduleRequestObject) (api.AdminDeleteScheduleResponseObject, error) {
+	token := ValidatePermissions(ctx, "AdminDeleteSchedule")
+	if token == nil {
+		return api.AdminDeleteSchedule401Response{}, nil
+	}
+	return admin.DeleteSchedule(ctx, s.logger, s.dataSource, request)
+}
+
 func (s *APIHandler) AdminListApiTokens(ctx context.Context, request api.AdminListApiTokensRequestObject) (api.AdminListApiTokensResponseObject, error) {
 	token := ValidatePermissions(ctx, "AdminListApiTokens")
 	if token == nil {
@@ -12,15 +12,42 @@ var (
 	Permissions = map[string][]string{
 		"CreateInvocationAsync":          {"create:invocation"},
 		"CreateInvocationSync":           {"create:invocation"},
+		"CreateInvocationBatch":          {"create:invocation"},
 		"GetNextInvocation":              {"read:invocation"},
 		"ReturnInvocationResponse":       {"read:invocation"},
+		"HeartbeatInvocation":            {"read:invocation"},
+		"CancelInvocation":               {"create:invocation"},
 		"ListEmbeddingModels":            {"read:completion"},
+		"CreateEmbedding":                {"create:completion"},
+		"ListRerankModels":               {"read:completion"},
+		"CreateRerank":                   {"create:completion"},
 		"CreateCompletion":               {"create:completion"},
+		"CreateChatCompletion":           {"create:completion"},
+		"ListVectoreStores":              {"read:vector"},
+		"ListCollection":                 {"read:vector"},
+		"QueryCollection":                {"read:vector"},
+		"CreateCollection":               {"create:vector"},
+		"UpsertCollection":               {"create:vector"},
 		"AdminListActors":                {"admin"},
 		"AdminGetActors":                 {"admin"},
 		"AdminCreateActor":               {"admin"},
 		"AdminUpdateActor":               {"admin"},
 		"AdminDeleteActor":               {"admin"},
+		"AdminPauseActorQueue":           {"admin"},
+		"AdminResumeActorQueue":          {"admin"},
+		"AdminListActorQuotas":           {"admin"},
+		"AdminSetActorQuotas":            {"admin"},
+		"AdminListTokenUsages":           {"admin"},
+		"AdminListQueueStats":            {"admin"},
+		"AdminListInvocations":           {"admin"},
+		"AdminListDeadLetters":           {"admin"},
+		"AdminReplayDeadLetters":         {"admin"},
+		"AdminPurgeDeadLetters":          {"admin"},
+		"AdminListSchedules":             {"admin"},
+		"AdminCreateSchedule":            {"admin"},
+		"AdminGetSchedule":               {"admin"},
+		"AdminUpdateSchedule":            {"admin"},
+		"AdminDeleteSchedule":            {"admin"},
 		"AdminGetActorConfig":            {"admin"},
 		"AdminListApiTokens":             {"admin"},
 		"AdminCreateApiToken":            {"admin"},
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
 )
 
@@ -13,7 +14,7 @@ func InsertInvocation(t *testing.T, ctx context.Context, ds DataSource, state st
 	invocation, err := query.InvocationInsert(ctx, ds, &dbsqlc.InvocationInsertParams{
 		State:     dbsqlc.InvocationState(state),
 		CreatedAt: time.Now().Unix(),
-		Priority:  1,
+		Priority:  lo.ToPtr(int16(1)),
 		Payload:   []byte(payload),
 		Metadata:  []byte(`{"kind": "test", "trace_id": "123"}`),
 		ActorName: actor,
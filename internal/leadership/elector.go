@@ -0,0 +1,161 @@
+// Package leadership elects a single leader among the server instances sharing
+// a database, so that work which must not run concurrently (e.g. firing cron
+// schedules) is only done by one of them at a time.
+package leadership
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+)
+
+const (
+	ElectorIntervalDefault = 5 * time.Second
+	ElectorTTLDefault      = 15 * time.Second
+)
+
+var querier = dbsqlc.New()
+
+type ElectorConfig struct {
+	// Name identifies the leadership being elected. Electors with different
+	// names don't compete with each other.
+	Name string
+	// LeaderID identifies this elector. A random ID is generated if empty.
+	LeaderID string
+	// Interval is the time between two election attempts.
+	Interval time.Duration
+	// TTL is how long a leadership lasts without being renewed. It should be
+	// a few times larger than Interval.
+	TTL time.Duration
+}
+
+// Elector periodically tries to become, or stay, the leader recorded in the
+// leaders table. A leader renews its leadership on every attempt, and anyone
+// else may take over once it has expired. The leadership is given up when the
+// elector stops.
+type Elector struct {
+	baseservice.BaseService
+	startstop.BaseStartStop
+
+	config     ElectorConfig
+	dataSource dbaccess.DataSource
+	isLeader   atomic.Bool
+}
+
+func NewElector(logger *slog.Logger, dataSource dbaccess.DataSource, config ElectorConfig) *Elector {
+	if config.Interval <= 0 {
+		config.Interval = ElectorIntervalDefault
+	}
+	if config.TTL <= 0 {
+		config.TTL = ElectorTTLDefault
+	}
+
+	elector := baseservice.Init(logger, &Elector{
+		config:     config,
+		dataSource: dataSource,
+	})
+	if elector.config.LeaderID == "" {
+		elector.config.LeaderID = elector.generateLeaderID()
+	}
+	return elector
+}
+
+// IsLeader reports whether this elector held the leadership at its last
+// attempt.
+func (e *Elector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+func (e *Elector) Start(ctx context.Context) error {
+	ctx, shouldStart, started, stopped := e.StartInit(ctx)
+	if !shouldStart {
+		return nil
+	}
+
+	go func() {
+		started()
+		defer stopped()
+
+		e.Logger.DebugContext(ctx, e.Name+": Run loop started", "name", e.config.Name, "leaderId", e.config.LeaderID)
+		defer e.Logger.DebugContext(ctx, e.Name+": Run loop stopped", "name", e.config.Name, "leaderId", e.config.LeaderID)
+		defer e.resign(ctx)
+
+		ticker := time.NewTicker(e.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := e.AttemptElect(ctx); err != nil && ctx.Err() == nil {
+				e.Logger.ErrorContext(ctx, e.Name+": Error attempting election", "name", e.config.Name, "err", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// AttemptElect tries to take or renew the leadership and returns whether this
+// elector is the leader. The leadership is considered lost if the attempt
+// fails, since it can't be renewed in time.
+func (e *Elector) AttemptElect(ctx context.Context) (bool, error) {
+	elected, err := querier.LeaderAttemptElect(ctx, e.dataSource, &dbsqlc.LeaderAttemptElectParams{
+		Name:       e.config.Name,
+		LeaderID:   e.config.LeaderID,
+		TtlSeconds: int64(e.config.TTL / time.Second),
+	})
+	if err != nil {
+		e.setLeader(ctx, false)
+		return false, err
+	}
+
+	e.setLeader(ctx, elected > 0)
+	return elected > 0, nil
+}
+
+func (e *Elector) setLeader(ctx context.Context, isLeader bool) {
+	if e.isLeader.Swap(isLeader) != isLeader {
+		e.Logger.InfoContext(ctx, e.Name+": Leadership changed", "name", e.config.Name, "leaderId", e.config.LeaderID, "isLeader", isLeader)
+	}
+}
+
+func (e *Elector) resign(ctx context.Context) {
+	if !e.isLeader.Load() {
+		return
+	}
+
+	// the service context is already cancelled at this point
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	_, err := querier.LeaderResign(ctx, e.dataSource, &dbsqlc.LeaderResignParams{
+		Name:     e.config.Name,
+		LeaderID: e.config.LeaderID,
+	})
+	if err != nil {
+		e.Logger.ErrorContext(ctx, e.Name+": Error resigning leadership", "name", e.config.Name, "err", err)
+	}
+	e.setLeader(ctx, false)
+}
+
+func (e *Elector) generateLeaderID() string {
+	host, _ := os.Hostname()
+	suffix := make([]byte, 4)
+	e.Rand.Read(suffix)
+	if host == "" {
+		return hex.EncodeToString(suffix)
+	}
+	return host + "-" + hex.EncodeToString(suffix)
+}
@@ -0,0 +1,86 @@
+package leadership_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/leadership"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestElector(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("Only one elector leads", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+
+		elector1 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test"})
+		elector2 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test"})
+		other := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "other"})
+
+		elected, err := elector1.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+		require.True(t, elector1.IsLeader())
+
+		elected, err = elector2.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.False(t, elected)
+		require.False(t, elector2.IsLeader())
+
+		// a different leadership is not affected
+		elected, err = other.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+
+		// the leader renews its leadership
+		elected, err = elector1.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+	})
+
+	t.Run("Leadership is given up on stop", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+
+		elector1 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test", Interval: time.Hour})
+		elector2 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test"})
+
+		require.NoError(t, elector1.Start(ctx))
+		require.Eventually(t, elector1.IsLeader, testhelper.WaitTimeout(), 10*time.Millisecond)
+		elector1.Stop()
+		require.False(t, elector1.IsLeader())
+
+		elected, err := elector2.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+	})
+
+	t.Run("Expired leadership is taken over", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+
+		elector1 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test"})
+		elector2 := leadership.NewElector(testhelper.Logger(t), dbPool, leadership.ElectorConfig{Name: "test"})
+
+		elected, err := elector1.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+
+		_, err = dbPool.Exec(ctx, "UPDATE leaders SET expires_at = EXTRACT(EPOCH FROM NOW()) - 1 WHERE name = 'test'")
+		require.NoError(t, err)
+
+		elected, err = elector2.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.True(t, elected)
+
+		elected, err = elector1.AttemptElect(ctx)
+		require.NoError(t, err)
+		require.False(t, elected)
+	})
+}
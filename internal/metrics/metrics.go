@@ -0,0 +1,129 @@
+// Package metrics holds the Prometheus collectors of the server, which are
+// exposed by Handler on the /metrics endpoint of the metrics port.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "maos"
+
+// Registry is the registry of all the collectors of the server, including the
+// Go runtime and process collectors.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of API requests by operation and status code.",
+	}, []string{"operation", "code"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	InvocationsEnqueued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "invocation",
+		Name:      "enqueued_total",
+		Help:      "Number of invocations enqueued by queue.",
+	}, []string{"queue_id"})
+
+	InvocationsDequeued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "invocation",
+		Name:      "dequeued_total",
+		Help:      "Number of invocations handed out to actors by queue.",
+	}, []string{"queue_id"})
+
+	InvocationsFinalized = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "invocation",
+		Name:      "finalized_total",
+		Help:      "Number of invocations finalized by queue and final state.",
+	}, []string{"queue_id", "state"})
+
+	DispatcherListeners = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "dispatcher",
+		Name:      "listeners",
+		Help:      "Number of requests waiting on the dispatcher for an invocation.",
+	})
+
+	NotifierReconnects = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "notifier",
+		Name:      "reconnects_total",
+		Help:      "Number of times the notifier reconnected its listener after an error.",
+	})
+
+	TokenCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "token_cache",
+		Name:      "requests_total",
+		Help:      "Number of API token lookups by result, either hit or miss.",
+	}, []string{"result"})
+
+	LLMCompletionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "completion_duration_seconds",
+		Help:      "Latency of LLM completions by provider.",
+		Buckets:   []float64{0.25, 0.5, 1, 2.5, 5, 10, 20, 40, 80, 160},
+	}, []string{"provider"})
+
+	LLMCompletionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "completion_errors_total",
+		Help:      "Number of failed LLM completions by provider.",
+	}, []string{"provider"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPRequestDuration,
+		InvocationsEnqueued,
+		InvocationsDequeued,
+		InvocationsFinalized,
+		DispatcherListeners,
+		NotifierReconnects,
+		TokenCacheRequests,
+		LLMCompletionDuration,
+		LLMCompletionErrors,
+	)
+}
+
+// Handler serves the metrics of Registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// QueueLabel returns the queue_id label value of the given queue.
+func QueueLabel(queueId int64) string {
+	return strconv.FormatInt(queueId, 10)
+}
+
+// ObserveLLMCompletion records the latency of an LLM completion which started at
+// the given time, and counts it as an error if err is not nil.
+func ObserveLLMCompletion(provider string, start time.Time, err error) {
+	LLMCompletionDuration.WithLabelValues(provider).Observe(time.Since(start).Seconds())
+	if err != nil {
+		LLMCompletionErrors.WithLabelValues(provider).Inc()
+	}
+}
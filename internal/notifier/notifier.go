@@ -12,6 +12,7 @@ import (
 	"github.com/samber/lo"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/listener"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
 )
@@ -132,6 +133,7 @@ func (n *Notifier) Start(ctx context.Context) error {
 				n.Logger.ErrorContext(ctx, n.Name+": Error running listener (will attempt reconnect after backoff)",
 					"attempt", attempt, "err", err, "sleep_duration", sleepDuration)
 				n.testSignals.BackoffError.Signal(err)
+				metrics.NotifierReconnects.Inc()
 				if !n.disableSleep {
 					n.CancellableSleep(ctx, sleepDuration)
 				}
@@ -0,0 +1,58 @@
+package retention
+
+import (
+	"encoding/json"
+
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+)
+
+// ArchiveRecord is one line of an invocation archive.
+type ArchiveRecord struct {
+	ID                  int64           `json:"id"`
+	State               string          `json:"state"`
+	QueueID             int64           `json:"queue_id"`
+	Priority            int16           `json:"priority"`
+	Tags                []string        `json:"tags"`
+	Metadata            json.RawMessage `json:"meta"`
+	Payload             json.RawMessage `json:"payload"`
+	Result              json.RawMessage `json:"result,omitempty"`
+	Errors              json.RawMessage `json:"errors,omitempty"`
+	Progress            json.RawMessage `json:"progress,omitempty"`
+	AttemptedBy         []int64         `json:"attempted_by,omitempty"`
+	MaxAttempts         int16           `json:"max_attempts"`
+	RetryBackoffSeconds int32           `json:"retry_backoff_seconds"`
+	CreatedBy           *int64          `json:"created_by,omitempty"`
+	CreatedAt           int64           `json:"created_at"`
+	ScheduledAt         int64           `json:"scheduled_at"`
+	AttemptedAt         *int64          `json:"attempted_at,omitempty"`
+	FinalizedAt         *int64          `json:"finalized_at,omitempty"`
+	CancelRequestedAt   *int64          `json:"cancel_requested_at,omitempty"`
+	IdempotencyKey      *string         `json:"idempotency_key,omitempty"`
+	ReplayedFrom        *int64          `json:"replayed_from,omitempty"`
+}
+
+func newArchiveRecord(invocation *dbsqlc.Invocation) ArchiveRecord {
+	return ArchiveRecord{
+		ID:                  invocation.ID,
+		State:               string(invocation.State),
+		QueueID:             invocation.QueueID,
+		Priority:            invocation.Priority,
+		Tags:                invocation.Tags,
+		Metadata:            invocation.Metadata,
+		Payload:             invocation.Payload,
+		Result:              invocation.Result,
+		Errors:              invocation.Errors,
+		Progress:            invocation.Progress,
+		AttemptedBy:         invocation.AttemptedBy,
+		MaxAttempts:         invocation.MaxAttempts,
+		RetryBackoffSeconds: invocation.RetryBackoffSeconds,
+		CreatedBy:           invocation.CreatedBy,
+		CreatedAt:           invocation.CreatedAt,
+		ScheduledAt:         invocation.ScheduledAt,
+		AttemptedAt:         invocation.AttemptedAt,
+		FinalizedAt:         invocation.FinalizedAt,
+		CancelRequestedAt:   invocation.CancelRequestedAt,
+		IdempotencyKey:      invocation.IdempotencyKey,
+		ReplayedFrom:        invocation.ReplayedFrom,
+	}
+}
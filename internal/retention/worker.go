@@ -0,0 +1,248 @@
+// Package retention deletes the finalized invocations which are older than the
+// retention policy of the system setting, optionally archiving them to S3 as
+// gzipped JSONL first.
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/leadership"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/suitestore"
+)
+
+const (
+	WorkerIntervalDefault  = 10 * time.Minute
+	WorkerBatchSizeDefault = 1000
+
+	workerLeadership = "invocation_retention"
+)
+
+var querier = dbsqlc.New()
+
+// Policy is the part of the system setting which drives the worker. A state
+// with zero days is kept forever.
+type Policy struct {
+	CompletedDays int
+	CancelledDays int
+	DiscardedDays int
+
+	EnableArchive bool
+	ArchiveBucket string
+	ArchivePrefix string
+}
+
+// PolicyLoader loads the current retention policy on every run, so changes to
+// the system setting apply without a restart.
+type PolicyLoader func(ctx context.Context, ds dbaccess.DataSource) (Policy, error)
+
+type WorkerConfig struct {
+	// Interval is the time between two runs of the retention policy.
+	Interval time.Duration
+	// BatchSize is the maximum number of invocations archived and deleted at
+	// once.
+	BatchSize int
+}
+
+// Worker periodically deletes the completed, cancelled and discarded
+// invocations finalized longer ago than the days configured for their state in
+// the system setting. When the invocation archive is enabled, every batch is
+// uploaded to S3 before it's deleted, and nothing is deleted if the upload
+// fails.
+//
+// Only the elected leader among the server instances runs the worker.
+type Worker struct {
+	baseservice.BaseService
+	startstop.BaseStartStop
+
+	config     WorkerConfig
+	dataSource dbaccess.DataSource
+	loadPolicy PolicyLoader
+	s3Client   suitestore.S3ClientInterface
+	elector    *leadership.Elector
+}
+
+func NewWorker(logger *slog.Logger, dataSource dbaccess.DataSource, loadPolicy PolicyLoader, s3Client suitestore.S3ClientInterface, config WorkerConfig) *Worker {
+	if config.Interval <= 0 {
+		config.Interval = WorkerIntervalDefault
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = WorkerBatchSizeDefault
+	}
+
+	return baseservice.Init(logger, &Worker{
+		config:     config,
+		dataSource: dataSource,
+		loadPolicy: loadPolicy,
+		s3Client:   s3Client,
+		elector:    leadership.NewElector(logger, dataSource, leadership.ElectorConfig{Name: workerLeadership}),
+	})
+}
+
+func (w *Worker) Start(ctx context.Context) error {
+	ctx, shouldStart, started, stopped := w.StartInit(ctx)
+	if !shouldStart {
+		return nil
+	}
+
+	if err := w.elector.Start(ctx); err != nil {
+		stopped()
+		return err
+	}
+
+	go func() {
+		started()
+		defer stopped()
+		defer w.elector.Stop()
+
+		w.Logger.DebugContext(ctx, w.Name+": Run loop started")
+		defer w.Logger.DebugContext(ctx, w.Name+": Run loop stopped")
+
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if !w.elector.IsLeader() {
+				continue
+			}
+
+			if _, err := w.RunOnce(ctx, time.Now()); err != nil && ctx.Err() == nil {
+				w.Logger.ErrorContext(ctx, w.Name+": Error applying retention policy", "err", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RunOnce applies the retention policy of the system setting at the given time
+// and returns the number of invocations deleted.
+func (w *Worker) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	policy, err := w.loadPolicy(ctx, w.dataSource)
+	if err != nil {
+		return 0, err
+	}
+
+	states := []struct {
+		state dbsqlc.InvocationState
+		days  int
+	}{
+		{dbsqlc.InvocationStateCompleted, policy.CompletedDays},
+		{dbsqlc.InvocationStateCancelled, policy.CancelledDays},
+		{dbsqlc.InvocationStateDiscarded, policy.DiscardedDays},
+	}
+
+	total := 0
+	for _, expiry := range states {
+		days := expiry.days
+		if days <= 0 {
+			continue
+		}
+
+		deleted, err := w.expire(ctx, policy, expiry.state, now, now.AddDate(0, 0, -days))
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted > 0 {
+			w.Logger.InfoContext(ctx, w.Name+": Expired invocations deleted", "state", expiry.state, "days", days, "count", deleted)
+		}
+	}
+	return total, nil
+}
+
+// expire deletes the invocations in the given state finalized before the given
+// time, one batch at a time.
+func (w *Worker) expire(ctx context.Context, policy Policy, state dbsqlc.InvocationState, now, finalizedBefore time.Time) (int, error) {
+	total := 0
+	for {
+		invocations, err := querier.InvocationListExpired(ctx, w.dataSource, &dbsqlc.InvocationListExpiredParams{
+			State:           state,
+			FinalizedBefore: finalizedBefore.Unix(),
+			Max:             int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return total, err
+		}
+		if len(invocations) == 0 {
+			return total, nil
+		}
+
+		if policy.EnableArchive {
+			err := w.archive(ctx, policy.ArchiveBucket, policy.ArchivePrefix, state, now, invocations)
+			if err != nil {
+				return total, fmt.Errorf("failed to archive invocations: %w", err)
+			}
+		}
+
+		deleted, err := querier.InvocationDeleteFinalized(ctx, w.dataSource, lo.Map(invocations, func(invocation *dbsqlc.Invocation, _ int) int64 {
+			return invocation.ID
+		}))
+		if err != nil {
+			return total, err
+		}
+		total += int(deleted)
+
+		if len(invocations) < w.config.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+// archive uploads the invocations as one gzipped JSONL object, keyed by their
+// state, the day of the run and their id range.
+func (w *Worker) archive(ctx context.Context, bucket, prefix string, state dbsqlc.InvocationState, now time.Time, invocations []*dbsqlc.Invocation) error {
+	if w.s3Client == nil {
+		return errors.New("no S3 client")
+	}
+	if bucket == "" {
+		return errors.New("invocation_archive_bucket is required")
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gz)
+	for _, invocation := range invocations {
+		if err := encoder.Encode(newArchiveRecord(invocation)); err != nil {
+			return err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	key := path.Join(
+		prefix,
+		"invocations",
+		string(state),
+		now.UTC().Format(time.DateOnly),
+		fmt.Sprintf("%d-%d.jsonl.gz", invocations[0].ID, invocations[len(invocations)-1].ID),
+	)
+	_, err := w.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String("application/gzip"),
+	})
+	return err
+}
@@ -0,0 +1,151 @@
+package retention_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/admin"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/retention"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+type fakeS3Client struct {
+	puts []*s3.PutObjectInput
+	err  error
+}
+
+func (c *fakeS3Client) ListObjectsV2(ctx context.Context, params *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	return &s3.ListObjectsV2Output{}, nil
+}
+
+func (c *fakeS3Client) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	return nil, c.err
+}
+
+func (c *fakeS3Client) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.puts = append(c.puts, params)
+	return &s3.PutObjectOutput{}, nil
+}
+
+func TestWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	day := int64(24 * 60 * 60)
+
+	setup := func(t *testing.T, setting string) (*pgxpool.Pool, map[string]int64) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+
+		_, err := dbPool.Exec(ctx, "INSERT INTO settings (key, value) VALUES ('system', $1)", []byte(setting))
+		require.NoError(t, err)
+
+		fixture.InsertActor(t, ctx, dbPool, "actor1")
+		finalize := func(state string, age int64) int64 {
+			id := fixture.InsertInvocation(t, ctx, dbPool, "available", `{"n":1}`, "actor1")
+			_, err := dbPool.Exec(ctx, "UPDATE invocations SET state = $2, finalized_at = $3 WHERE id = $1", id, state, now.Unix()-age)
+			require.NoError(t, err)
+			return id
+		}
+		return dbPool, map[string]int64{
+			"old completed":  finalize("completed", 10*day),
+			"new completed":  finalize("completed", 1*day),
+			"old cancelled":  finalize("cancelled", 10*day),
+			"old discarded":  finalize("discarded", 40*day),
+			"new discarded":  finalize("discarded", 10*day),
+			"available":      fixture.InsertInvocation(t, ctx, dbPool, "available", `{}`, "actor1"),
+			"old completed2": finalize("completed", 20*day),
+		}
+	}
+
+	exists := func(t *testing.T, dbPool *pgxpool.Pool, id int64) bool {
+		_, err := dbsqlc.New().InvocationFindById(ctx, dbPool, id)
+		return err == nil
+	}
+
+	t.Run("Deletes expired invocations in batches", func(t *testing.T) {
+		t.Parallel()
+		dbPool, ids := setup(t, `{"invocation_retention_completed_days": 7, "invocation_retention_discarded_days": 30}`)
+
+		worker := retention.NewWorker(testhelper.Logger(t), dbPool, admin.LoadRetentionPolicy, nil, retention.WorkerConfig{BatchSize: 1})
+		deleted, err := worker.RunOnce(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 3, deleted)
+
+		assert.False(t, exists(t, dbPool, ids["old completed"]))
+		assert.False(t, exists(t, dbPool, ids["old completed2"]))
+		assert.False(t, exists(t, dbPool, ids["old discarded"]))
+		assert.True(t, exists(t, dbPool, ids["new completed"]))
+		assert.True(t, exists(t, dbPool, ids["new discarded"]))
+		assert.True(t, exists(t, dbPool, ids["old cancelled"]))
+		assert.True(t, exists(t, dbPool, ids["available"]))
+	})
+
+	t.Run("Archives before deleting", func(t *testing.T) {
+		t.Parallel()
+		dbPool, ids := setup(t, `{"invocation_retention_completed_days": 7, "enable_invocation_archive": true, "invocation_archive_bucket": "bucket", "invocation_archive_prefix": "maos"}`)
+
+		s3Client := &fakeS3Client{}
+		worker := retention.NewWorker(testhelper.Logger(t), dbPool, admin.LoadRetentionPolicy, s3Client, retention.WorkerConfig{})
+		deleted, err := worker.RunOnce(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		require.Len(t, s3Client.puts, 1)
+		put := s3Client.puts[0]
+		assert.Equal(t, "bucket", *put.Bucket)
+		assert.Regexp(t, `^maos/invocations/completed/\d{4}-\d{2}-\d{2}/\d+-\d+\.jsonl\.gz$`, *put.Key)
+
+		gz, err := gzip.NewReader(put.Body)
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(gz)
+		var records []retention.ArchiveRecord
+		for scanner.Scan() {
+			var record retention.ArchiveRecord
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			records = append(records, record)
+		}
+		require.NoError(t, scanner.Err())
+		require.Len(t, records, 2)
+		assert.Equal(t, ids["old completed"], records[0].ID)
+		assert.Equal(t, ids["old completed2"], records[1].ID)
+		assert.Equal(t, "completed", records[0].State)
+		assert.JSONEq(t, `{"n":1}`, string(records[0].Payload))
+	})
+
+	t.Run("Keeps invocations if the archive fails", func(t *testing.T) {
+		t.Parallel()
+		dbPool, ids := setup(t, `{"invocation_retention_completed_days": 7, "enable_invocation_archive": true, "invocation_archive_bucket": "bucket"}`)
+
+		worker := retention.NewWorker(testhelper.Logger(t), dbPool, admin.LoadRetentionPolicy, &fakeS3Client{err: io.ErrUnexpectedEOF}, retention.WorkerConfig{})
+		deleted, err := worker.RunOnce(ctx, now)
+		require.Error(t, err)
+		assert.Zero(t, deleted)
+		assert.True(t, exists(t, dbPool, ids["old completed"]))
+	})
+
+	t.Run("Keeps everything without retention policy", func(t *testing.T) {
+		t.Parallel()
+		dbPool, _ := setup(t, `{}`)
+
+		worker := retention.NewWorker(testhelper.Logger(t), dbPool, admin.LoadRetentionPolicy, nil, retention.WorkerConfig{})
+		deleted, err := worker.RunOnce(ctx, now)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+	})
+}
@@ -71,7 +71,8 @@ func (m *Manager) Acquire(ctx context.Context) (*pgxpool.Pool, error) {
 		return nil, err
 	}
 
-	config.ConnConfig.RuntimeParams["search_path"] = schemaName
+	// public stays on the path for types of extensions shared by all test schemas, e.g. pgvector.
+	config.ConnConfig.RuntimeParams["search_path"] = fmt.Sprintf(`"%s", public`, schemaName)
 	config.MaxConns = 2
 
 	pool, err := pgxpool.NewWithConfig(ctx, config)
@@ -4,6 +4,7 @@ package testhelper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -13,8 +14,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/testdb"
 	"go.uber.org/goleak"
 	//nolint:depguard
@@ -64,6 +68,28 @@ func TestDB(ctx context.Context, tb testing.TB) *pgxpool.Pool {
 	return testPool
 }
 
+// RequirePgvector installs the pgvector extension into the public schema shared by all test databases.
+// The test is skipped if the extension is not available in the database server.
+func RequirePgvector(ctx context.Context, tb testing.TB, ds dbaccess.DataSource) {
+	tb.Helper()
+
+	var available bool
+	err := ds.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector')").Scan(&available)
+	if err != nil {
+		tb.Fatalf("Failed to check pgvector extension: %v", err)
+	}
+	if !available {
+		tb.Skip("pgvector extension is not available")
+	}
+
+	// Concurrent tests may race to create the extension, in which case the loser sees a unique violation.
+	_, err = ds.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS vector SCHEMA public")
+	var pgErr *pgconn.PgError
+	if err != nil && !(errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation) {
+		tb.Fatalf("Failed to create pgvector extension: %v", err)
+	}
+}
+
 var ignoredKnownGoroutineLeaks = []goleak.Option{ //nolint:gochecknoglobals
 	// This goroutine has a 500 ms uninterruptable sleep that may still be running
 	// when the test suite finishes, causing a failure. This might be a pgx issue:
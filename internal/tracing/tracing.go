@@ -0,0 +1,93 @@
+// Package tracing sets up the OpenTelemetry tracing of the server, with the W3C
+// trace context propagation used for the incoming requests and the
+// invocations.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	tracerName = "gitlab.com/navyx/ai/maos/maos-core"
+)
+
+// Propagator reads and writes the W3C traceparent and tracestate. It's used
+// directly rather than through the global propagator so that the trace context
+// is propagated even when no exporter is configured.
+var Propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+type Config struct {
+	// Exporter is where the spans are exported: "otlp", "stdout", or nowhere
+	// when empty. The OTLP exporter is configured by the standard
+	// OTEL_EXPORTER_OTLP_* environment variables.
+	Exporter string
+	// ServiceName is the service.name of the exported spans.
+	ServiceName string
+	// ServiceVersion is the service.version of the exported spans.
+	ServiceVersion string
+}
+
+// Init installs the global tracer provider exporting to the configured
+// exporter. The returned function flushes and stops the provider.
+func Init(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(Propagator)
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %s", config.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", config.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(config.ServiceName),
+		semconv.ServiceVersion(config.ServiceVersion),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the server from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Inject returns the W3C trace context of ctx as a map of traceparent and
+// tracestate, which is empty if ctx has no span.
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	Propagator.Inject(ctx, carrier)
+	return carrier
+}
@@ -0,0 +1,11 @@
+package usage_test
+
+import (
+	"testing"
+
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+)
+
+func TestMain(m *testing.M) {
+	testhelper.WrapTestMain(m)
+}
@@ -0,0 +1,108 @@
+// Package usage keeps the ledger of the tokens consumed by the LLM completions,
+// and enforces the token and cost quotas of the actors against it.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+const (
+	PeriodDaily   = "daily"
+	PeriodMonthly = "monthly"
+)
+
+var querier = dbsqlc.New()
+
+// Entry is the usage of one completion.
+type Entry struct {
+	ApiTokenId string
+	ActorId    int64
+	ModelId    string
+	Usage      llm.CompletionUsage
+	// Cost is the price of the usage in USD.
+	Cost float64
+}
+
+// QuotaExceededError is returned by CheckQuotas when a quota of the actor is
+// used up.
+type QuotaExceededError struct {
+	Period string
+	// Kind is either "token" or "cost".
+	Kind string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s %s quota exceeded", e.Period, e.Kind)
+}
+
+// Day returns the start of the UTC day of t in epoch seconds, which is how
+// the ledger entries are keyed.
+func Day(t time.Time) int64 {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// PeriodStart returns the first day of the quota period containing t, in the
+// same form as Day.
+func PeriodStart(period string, t time.Time) int64 {
+	year, month, day := t.UTC().Date()
+	if period == PeriodMonthly {
+		day = 1
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// Record adds the entry to the ledger of the day of now.
+func Record(ctx context.Context, ds dbaccess.DataSource, entry Entry, now time.Time) error {
+	return querier.TokenUsageAdd(ctx, ds, &dbsqlc.TokenUsageAddParams{
+		ApiTokenID:   entry.ApiTokenId,
+		ActorId:      entry.ActorId,
+		ModelID:      entry.ModelId,
+		Day:          Day(now),
+		InputTokens:  int64(entry.Usage.InputTokens),
+		OutputTokens: int64(entry.Usage.OutputTokens),
+		Cost:         entry.Cost,
+	})
+}
+
+// Used returns the tokens and the cost consumed by the actor in the quota
+// period containing now.
+func Used(ctx context.Context, ds dbaccess.DataSource, actorId int64, period string, now time.Time) (int64, float64, error) {
+	used, err := querier.TokenUsageSumByActor(ctx, ds, &dbsqlc.TokenUsageSumByActorParams{
+		ActorId:  actorId,
+		SinceDay: PeriodStart(period, now),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return used.Tokens, used.Cost, nil
+}
+
+// CheckQuotas returns a QuotaExceededError if any quota of the actor is used
+// up in its period containing now.
+func CheckQuotas(ctx context.Context, ds dbaccess.DataSource, actorId int64, now time.Time) error {
+	quotas, err := querier.ActorQuotaListByActorId(ctx, ds, actorId)
+	if err != nil {
+		return err
+	}
+
+	for _, quota := range quotas {
+		tokens, cost, err := Used(ctx, ds, actorId, quota.Period, now)
+		if err != nil {
+			return err
+		}
+		if quota.MaxTokens != nil && tokens >= *quota.MaxTokens {
+			return &QuotaExceededError{Period: quota.Period, Kind: "token"}
+		}
+		if quota.MaxCost != nil && cost >= *quota.MaxCost {
+			return &QuotaExceededError{Period: quota.Period, Kind: "cost"}
+		}
+	}
+	return nil
+}
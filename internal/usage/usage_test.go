@@ -0,0 +1,98 @@
+package usage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/usage"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+func TestPeriodStart(t *testing.T) {
+	now := time.Date(2024, 7, 15, 18, 30, 0, 0, time.FixedZone("UTC+8", 8*60*60))
+
+	assert.Equal(t, time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC).Unix(), usage.Day(now))
+	assert.Equal(t, time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC).Unix(), usage.PeriodStart(usage.PeriodDaily, now))
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC).Unix(), usage.PeriodStart(usage.PeriodMonthly, now))
+}
+
+func TestRecordAndCheckQuotas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dbPool := testhelper.TestDB(ctx, t)
+	t.Cleanup(dbPool.Close)
+
+	actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+	fixture.InsertToken(t, ctx, dbPool, "token1", actor.ID, []string{"create:completion"})
+	now := time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	record := func(at time.Time, input, output int32, cost float64) {
+		err := usage.Record(ctx, dbPool, usage.Entry{
+			ApiTokenId: "token1",
+			ActorId:    actor.ID,
+			ModelId:    "model1",
+			Usage:      llm.CompletionUsage{InputTokens: input, OutputTokens: output},
+			Cost:       cost,
+		}, at)
+		require.NoError(t, err)
+	}
+	record(now.AddDate(0, 0, -3), 500, 500, 0.5)
+	record(now, 100, 50, 0.1)
+	record(now, 200, 150, 0.2)
+
+	var requests, inputTokens int64
+	err := dbPool.QueryRow(ctx, "SELECT requests, input_tokens FROM token_usages WHERE day = $1", usage.Day(now)).Scan(&requests, &inputTokens)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests)
+	assert.Equal(t, int64(300), inputTokens)
+
+	tokens, cost, err := usage.Used(ctx, dbPool, actor.ID, usage.PeriodDaily, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), tokens)
+	assert.InDelta(t, 0.3, cost, 1e-9)
+
+	tokens, cost, err = usage.Used(ctx, dbPool, actor.ID, usage.PeriodMonthly, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), tokens)
+	assert.InDelta(t, 0.8, cost, 1e-9)
+
+	setQuota := func(period string, maxTokens *int64, maxCost *float64) {
+		_, err := dbPool.Exec(ctx, "DELETE FROM actor_quotas WHERE actor_id = $1", actor.ID)
+		require.NoError(t, err)
+		_, err = dbPool.Exec(ctx, "INSERT INTO actor_quotas (actor_id, period, max_tokens, max_cost) VALUES ($1, $2, $3, $4)",
+			actor.ID, period, maxTokens, maxCost)
+		require.NoError(t, err)
+	}
+
+	t.Run("No quota", func(t *testing.T) {
+		require.NoError(t, usage.CheckQuotas(ctx, dbPool, actor.ID, now))
+	})
+
+	t.Run("Daily token quota", func(t *testing.T) {
+		setQuota(usage.PeriodDaily, lo.ToPtr(int64(1000)), nil)
+		require.NoError(t, usage.CheckQuotas(ctx, dbPool, actor.ID, now))
+
+		setQuota(usage.PeriodDaily, lo.ToPtr(int64(500)), nil)
+		err := usage.CheckQuotas(ctx, dbPool, actor.ID, now)
+		require.Equal(t, &usage.QuotaExceededError{Period: usage.PeriodDaily, Kind: "token"}, err)
+
+		// the next day starts afresh
+		require.NoError(t, usage.CheckQuotas(ctx, dbPool, actor.ID, now.AddDate(0, 0, 1)))
+	})
+
+	t.Run("Monthly cost quota", func(t *testing.T) {
+		setQuota(usage.PeriodMonthly, nil, lo.ToPtr(1.0))
+		require.NoError(t, usage.CheckQuotas(ctx, dbPool, actor.ID, now))
+
+		setQuota(usage.PeriodMonthly, nil, lo.ToPtr(0.75))
+		err := usage.CheckQuotas(ctx, dbPool, actor.ID, now)
+		require.Equal(t, &usage.QuotaExceededError{Period: usage.PeriodMonthly, Kind: "cost"}, err)
+	})
+}
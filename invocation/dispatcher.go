@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/puzpuzpuz/xsync/v3"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
 )
 
 /*
@@ -80,6 +81,9 @@ func (d *Dispatcher[T]) WaitFor(id string, timeout time.Duration) (*T, error) {
 		return nil, err
 	}
 
+	metrics.DispatcherListeners.Inc()
+	defer metrics.DispatcherListeners.Dec()
+
 	select {
 	case payload, ok := <-ch:
 		if !ok {
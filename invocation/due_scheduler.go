@@ -0,0 +1,113 @@
+package invocation
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+)
+
+const DueSchedulerIntervalDefault = time.Second
+
+type DueSchedulerConfig struct {
+	// Interval is the time between two checks for due invocations.
+	Interval time.Duration
+}
+
+// DueScheduler wakes up the actors waiting on a queue when its delayed
+// invocations become due. This covers the invocations created with a run_at or
+// delay_seconds, as well as the failed ones waiting for their retry backoff.
+//
+// Every server instance runs its own scheduler. The duplicated notifications
+// are harmless since the waiting actors lock the invocations they get.
+type DueScheduler struct {
+	baseservice.BaseService
+	startstop.BaseStartStop
+
+	config     DueSchedulerConfig
+	dataSource dbaccess.DataSource
+}
+
+func NewDueScheduler(logger *slog.Logger, dataSource dbaccess.DataSource, config DueSchedulerConfig) *DueScheduler {
+	if config.Interval <= 0 {
+		config.Interval = DueSchedulerIntervalDefault
+	}
+
+	return baseservice.Init(logger, &DueScheduler{
+		config:     config,
+		dataSource: dataSource,
+	})
+}
+
+func (s *DueScheduler) Start(ctx context.Context) error {
+	ctx, shouldStart, started, stopped := s.StartInit(ctx)
+	if !shouldStart {
+		return nil
+	}
+
+	go func() {
+		started()
+		defer stopped()
+
+		s.Logger.DebugContext(ctx, s.Name+": Run loop started")
+		defer s.Logger.DebugContext(ctx, s.Name+": Run loop stopped")
+
+		ticker := time.NewTicker(s.config.Interval)
+		defer ticker.Stop()
+
+		dueAfter := time.Now().Unix()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			dueBefore := time.Now().Unix()
+			if dueBefore <= dueAfter {
+				continue
+			}
+
+			if _, err := s.NotifyDue(ctx, dueAfter, dueBefore); err != nil {
+				if ctx.Err() == nil {
+					s.Logger.ErrorContext(ctx, s.Name+": Error notifying due invocations", "err", err)
+				}
+				continue
+			}
+			dueAfter = dueBefore
+		}
+	}()
+
+	return nil
+}
+
+// NotifyDue sends an invoke notification to every queue with available
+// invocations that became due in the (dueAfter, dueBefore] time range, and
+// returns the notified queue IDs.
+func (s *DueScheduler) NotifyDue(ctx context.Context, dueAfter, dueBefore int64) ([]int64, error) {
+	queueIDs, err := querier.InvocationListDueQueueIds(ctx, s.dataSource, &dbsqlc.InvocationListDueQueueIdsParams{
+		DueAfter:  dueAfter,
+		DueBefore: dueBefore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queueID := range queueIDs {
+		s.Logger.DebugContext(ctx, s.Name+": Invocations became due", "queueId", queueID)
+		err := querier.PgNotifyOne(ctx, s.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   invokeTopic,
+			Payload: strconv.FormatInt(queueID, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return queueIDs, nil
+}
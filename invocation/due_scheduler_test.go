@@ -0,0 +1,95 @@
+package invocation_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func TestDueScheduler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("Delayed invocation is notified once due", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		manager := invocation.NewManager(testhelper.Logger(t), dbPool)
+		scheduler := invocation.NewDueScheduler(testhelper.Logger(t), dbPool, invocation.DueSchedulerConfig{})
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+
+		now := time.Now().Unix()
+		response, err := manager.InsertInvocation(ctx, actor.ID, api.CreateInvocationAsyncRequestObject{
+			Body: &api.CreateInvocationAsyncJSONRequestBody{
+				Actor:        actor.Name,
+				Meta:         map[string]interface{}{"kind": "test"},
+				Payload:      map[string]interface{}{},
+				DelaySeconds: lo.ToPtr(60),
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.CreateInvocationAsync201JSONResponse{}, response)
+		id, err := strconv.ParseInt(response.(api.CreateInvocationAsync201JSONResponse).Id, 10, 64)
+		require.NoError(t, err)
+
+		row, err := querier.InvocationFindById(ctx, dbPool, id)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, row.ScheduledAt, now+60)
+
+		// not due yet, so actors cannot get it
+		available, err := querier.InvocationGetAvailable(ctx, dbPool, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: actor.ID,
+			QueueID:     actor.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, available)
+
+		queueIDs, err := scheduler.NotifyDue(ctx, now-1, now+30)
+		require.NoError(t, err)
+		require.Empty(t, queueIDs)
+
+		queueIDs, err = scheduler.NotifyDue(ctx, now+30, row.ScheduledAt)
+		require.NoError(t, err)
+		require.Equal(t, []int64{actor.QueueID}, queueIDs)
+	})
+
+	t.Run("Invalid delay", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		manager := invocation.NewManager(testhelper.Logger(t), dbPool)
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+
+		response, err := manager.InsertInvocation(ctx, actor.ID, api.CreateInvocationAsyncRequestObject{
+			Body: &api.CreateInvocationAsyncJSONRequestBody{
+				Actor:        actor.Name,
+				Meta:         map[string]interface{}{"kind": "test"},
+				Payload:      map[string]interface{}{},
+				RunAt:        lo.ToPtr(time.Now().Unix() + 60),
+				DelaySeconds: lo.ToPtr(60),
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.CreateInvocationAsync400JSONResponse{}, response)
+
+		response, err = manager.InsertInvocation(ctx, actor.ID, api.CreateInvocationAsyncRequestObject{
+			Body: &api.CreateInvocationAsyncJSONRequestBody{
+				Actor:   actor.Name,
+				Meta:    map[string]interface{}{"kind": "test"},
+				Payload: map[string]interface{}{},
+				RunAt:   lo.ToPtr(time.Now().Add(31 * 24 * time.Hour).Unix()),
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.CreateInvocationAsync400JSONResponse{}, response)
+	})
+}
@@ -0,0 +1,139 @@
+package invocation
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+)
+
+const (
+	LeaseReaperIntervalDefault  = 10 * time.Second
+	LeaseReaperBatchSizeDefault = 100
+)
+
+type LeaseReaperConfig struct {
+	// Interval is the time between two runs of the reaper.
+	Interval time.Duration
+	// BatchSize is the maximum number of invocations taken back in one query.
+	BatchSize int
+}
+
+// LeaseReaper periodically takes back the running invocations whose lease has
+// expired. An expired invocation goes back to its queue if it has attempts
+// left, otherwise it is finalized and the waiters of its response are woken up.
+// Invocations of a queue without lease_seconds have no lease and are left alone.
+//
+// Invocations are locked with SKIP LOCKED, so it's safe to run a reaper on
+// every server instance.
+type LeaseReaper struct {
+	baseservice.BaseService
+	startstop.BaseStartStop
+
+	config     LeaseReaperConfig
+	dataSource dbaccess.DataSource
+}
+
+func NewLeaseReaper(logger *slog.Logger, dataSource dbaccess.DataSource, config LeaseReaperConfig) *LeaseReaper {
+	if config.Interval <= 0 {
+		config.Interval = LeaseReaperIntervalDefault
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = LeaseReaperBatchSizeDefault
+	}
+
+	return baseservice.Init(logger, &LeaseReaper{
+		config:     config,
+		dataSource: dataSource,
+	})
+}
+
+func (r *LeaseReaper) Start(ctx context.Context) error {
+	ctx, shouldStart, started, stopped := r.StartInit(ctx)
+	if !shouldStart {
+		return nil
+	}
+
+	go func() {
+		started()
+		defer stopped()
+
+		r.Logger.DebugContext(ctx, r.Name+": Run loop started")
+		defer r.Logger.DebugContext(ctx, r.Name+": Run loop stopped")
+
+		ticker := time.NewTicker(r.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if _, err := r.ReapOnce(ctx); err != nil && ctx.Err() == nil {
+				r.Logger.ErrorContext(ctx, r.Name+": Error reaping expired leases", "err", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ReapOnce takes back all the invocations whose lease has expired, one batch at
+// a time, and returns the number of invocations taken back.
+func (r *LeaseReaper) ReapOnce(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		rows, err := querier.InvocationReapExpiredLeases(ctx, r.dataSource, int32(r.config.BatchSize))
+		if err != nil {
+			return total, err
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		total += len(rows)
+		r.notify(ctx, rows)
+
+		if len(rows) < r.config.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (r *LeaseReaper) notify(ctx context.Context, rows []*dbsqlc.InvocationReapExpiredLeasesRow) {
+	requeued := make(map[int64]struct{})
+	for _, row := range rows {
+		if row.State == dbsqlc.InvocationStateAvailable {
+			r.Logger.InfoContext(ctx, r.Name+": Invocation lease expired, requeued", "invokeId", row.ID, "queueId", row.QueueID)
+			requeued[row.QueueID] = struct{}{}
+			continue
+		}
+
+		r.Logger.InfoContext(ctx, r.Name+": Invocation lease expired, finalized", "invokeId", row.ID, "queueId", row.QueueID, "state", row.State)
+		countFinalized(row.QueueID, row.State)
+		err := querier.PgNotifyOne(ctx, r.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   responseTopic,
+			Payload: strconv.FormatInt(row.ID, 10),
+		})
+		if err != nil {
+			r.Logger.ErrorContext(ctx, r.Name+": Failed to notify response", "invokeId", row.ID, "err", err)
+		}
+	}
+
+	for queueID := range requeued {
+		err := querier.PgNotifyOne(ctx, r.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   invokeTopic,
+			Payload: strconv.FormatInt(queueID, 10),
+		})
+		if err != nil {
+			r.Logger.ErrorContext(ctx, r.Name+": Failed to notify invoke", "queueId", queueID, "err", err)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package invocation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func TestLeaseReaper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// setup inserts a running invocation for an actor with the given max attempts
+	// and moves its lease into the past or the future.
+	setup := func(t *testing.T, maxAttempts int16, expired bool) (*pgxpool.Pool, *invocation.LeaseReaper, int64) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		_, err := querier.ActorUpdate(ctx, dbPool, &dbsqlc.ActorUpdateParams{ID: actor.ID, MaxAttempts: lo.ToPtr(maxAttempts)})
+		require.NoError(t, err)
+		_, err = querier.QueueUpdate(ctx, dbPool, &dbsqlc.QueueUpdateParams{ID: actor.QueueID, LeaseSeconds: lo.ToPtr(int32(300))})
+		require.NoError(t, err)
+
+		invocationId := fixture.InsertInvocation(t, ctx, dbPool, "available", `{"seq": 1}`, actor.Name)
+		running, err := querier.InvocationGetAvailable(ctx, dbPool, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: actor.ID,
+			QueueID:     actor.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+		require.Len(t, running, 1)
+		require.NotNil(t, running[0].LeaseExpiresAt)
+
+		offset := lo.Ternary(expired, -1, 60)
+		_, err = dbPool.Exec(ctx, "UPDATE invocations SET lease_expires_at = EXTRACT(EPOCH FROM NOW()) + $1 WHERE id = $2", offset, invocationId)
+		require.NoError(t, err)
+
+		reaper := invocation.NewLeaseReaper(testhelper.Logger(t), dbPool, invocation.LeaseReaperConfig{})
+		return dbPool, reaper, invocationId
+	}
+
+	t.Run("Lease not expired", func(t *testing.T) {
+		dbPool, reaper, invocationId := setup(t, 1, false)
+
+		reaped, err := reaper.ReapOnce(ctx)
+		require.NoError(t, err)
+		require.Zero(t, reaped)
+
+		row, err := querier.InvocationFindById(ctx, dbPool, invocationId)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateRunning, row.State)
+	})
+
+	t.Run("Expired lease with attempts left is requeued", func(t *testing.T) {
+		dbPool, reaper, invocationId := setup(t, 2, true)
+
+		reaped, err := reaper.ReapOnce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, reaped)
+
+		row, err := querier.InvocationFindById(ctx, dbPool, invocationId)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateAvailable, row.State)
+		require.Nil(t, row.FinalizedAt)
+		require.JSONEq(t, `{"error": "lease expired"}`, string(row.Errors))
+	})
+
+	t.Run("Expired lease without attempts left is discarded", func(t *testing.T) {
+		dbPool, reaper, invocationId := setup(t, 1, true)
+
+		reaped, err := reaper.ReapOnce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, reaped)
+
+		row, err := querier.InvocationFindById(ctx, dbPool, invocationId)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateDiscarded, row.State)
+		require.NotNil(t, row.FinalizedAt)
+	})
+}
@@ -1,13 +1,16 @@
 package invocation
 
 import (
+	"cmp"
 	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jackc/pgx/v5"
 	jsoniter "github.com/json-iterator/go"
@@ -16,14 +19,39 @@ import (
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/listener"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/notifier"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/tracing"
 	"gitlab.com/navyx/ai/maos/maos-core/util"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	invokeTopic   = "maos_invoke"
 	responseTopic = "maos_response"
+
+	// MaxRetryAttempts and MaxRetryBackoffSeconds limit the retry policies of the actors and the invocations.
+	MaxRetryAttempts       = 25
+	MaxRetryBackoffSeconds = 86400
+
+	// MinPriority and MaxPriority limit the priorities of the invocations and the max priorities of the actors.
+	MinPriority = 1
+	MaxPriority = 8
+
+	// MinLeaseSeconds and MaxLeaseSeconds limit the lease of the queues.
+	MinLeaseSeconds = 10
+	MaxLeaseSeconds = 86400
+
+	maxDelaySeconds = 30 * 24 * 60 * 60
+
+	maxIdempotencyKeyLength = 255
+
+	maxTags      = 32
+	maxTagLength = 255
+
+	maxNextBatchSize   = 100
+	maxInsertBatchSize = 1000
 )
 
 var (
@@ -47,6 +75,9 @@ func NewManager(logger *slog.Logger, pool dbaccess.SourcePool) *Manager {
 		dataSource:       pool,
 		notifier:         notifier,
 		invokeDispatcher: NewDispatcher[InvokeRequest](),
+		leaseReaper:      NewLeaseReaper(logger, pool, LeaseReaperConfig{}),
+		dueScheduler:     NewDueScheduler(logger, pool, DueSchedulerConfig{}),
+		scheduleRunner:   NewScheduleRunner(logger, pool, ScheduleRunnerConfig{}),
 	}
 }
 
@@ -60,6 +91,9 @@ type Manager struct {
 	invokeDispatcher *Dispatcher[InvokeRequest]
 	invokeSub        *notifier.Subscription
 	responseSub      *notifier.Subscription
+	leaseReaper      *LeaseReaper
+	dueScheduler     *DueScheduler
+	scheduleRunner   *ScheduleRunner
 }
 
 func (m *Manager) Start(ctx context.Context) error {
@@ -82,6 +116,30 @@ func (m *Manager) Start(ctx context.Context) error {
 		return err
 	}
 
+	err = m.leaseReaper.Start(ctx)
+	if err != nil {
+		invokeSub.Unlisten(ctx)
+		m.notifier.Stop()
+		return err
+	}
+
+	err = m.dueScheduler.Start(ctx)
+	if err != nil {
+		invokeSub.Unlisten(ctx)
+		m.leaseReaper.Stop()
+		m.notifier.Stop()
+		return err
+	}
+
+	err = m.scheduleRunner.Start(ctx)
+	if err != nil {
+		invokeSub.Unlisten(ctx)
+		m.dueScheduler.Stop()
+		m.leaseReaper.Stop()
+		m.notifier.Stop()
+		return err
+	}
+
 	m.invokeSub = invokeSub
 	m.responseSub = responseSub
 	return nil
@@ -101,6 +159,9 @@ func (m *Manager) Close(ctx context.Context) error {
 	}
 
 	m.invokeDispatcher.Close()
+	m.scheduleRunner.Stop()
+	m.dueScheduler.Stop()
+	m.leaseReaper.Stop()
 	m.notifier.Stop()
 
 	return nil
@@ -109,62 +170,145 @@ func (m *Manager) Close(ctx context.Context) error {
 func (m *Manager) InsertInvocation(ctx context.Context, callerActorId int64, request api.CreateInvocationAsyncRequestObject) (api.CreateInvocationAsyncResponseObject, error) {
 	m.logger.Debug("InsertInvocation start", "traceId", request.Body.Meta["trace_id"], "callerActorId", callerActorId, "requestBody", request.Body)
 
-	if len(request.Body.Meta) == 0 {
-		return api.CreateInvocationAsync400JSONResponse{
-			N400JSONResponse: api.N400JSONResponse{Error: "Meta is required"},
-		}, nil
-	}
-
-	// ensure trace_id is set
-	traceId := request.Body.Meta["trace_id"]
-	if traceId == nil {
-		request.Body.Meta["trace_id"] = generateTraceId()
-	}
-
-	metadata, err := json.Marshal(request.Body.Meta)
+	params, err := invocationInsertParams(ctx, callerActorId, request.Body, request.Params.IdempotencyKey)
 	if err != nil {
-		m.logger.Error("Failed to marshal metadata", "err", err)
-		return api.CreateInvocationAsync500JSONResponse{
-			N500JSONResponse: api.N500JSONResponse{Error: "Failed to marshal metadata"},
+		return api.CreateInvocationAsync400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
 		}, nil
 	}
 
-	payload, err := json.Marshal(request.Body.Payload)
-	if err != nil {
-		m.logger.Error("Failed to marshal payload", "err", err)
-		return api.CreateInvocationAsync500JSONResponse{
-			N500JSONResponse: api.N500JSONResponse{Error: "Failed to marshal payload"},
-		}, nil
-	}
-
-	invocation, err := querier.InvocationInsert(ctx, m.dataSource, &dbsqlc.InvocationInsertParams{
-		ActorName: request.Body.Actor,
-		State:     "available",
-		Metadata:  metadata,
-		Priority:  1,
-		Payload:   payload,
-	})
+	invocation, err := querier.InvocationInsert(ctx, m.dataSource, params)
 	if err != nil {
 		if err == pgx.ErrNoRows {
+			existing, err := findIdempotentInvocation(ctx, m.dataSource, params)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				m.logger.Debug("InsertInvocation replayed", "callerActorId", callerActorId, "idempotencyKey", *params.IdempotencyKey, "invokeId", existing.ID)
+				return api.CreateInvocationAsync200JSONResponse{
+					Id:    strconv.FormatInt(existing.ID, 10),
+					State: api.InvocationState(existing.State),
+				}, nil
+			}
+			rejected, err := queueRejectsInvocations(ctx, m.dataSource, params.ActorName)
+			if err != nil {
+				return nil, err
+			}
+			if rejected {
+				return api.CreateInvocationAsync503JSONResponse{
+					N503JSONResponse: api.N503JSONResponse{Error: "queue paused"},
+				}, nil
+			}
 			return api.CreateInvocationAsync400JSONResponse{
 				N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
 			}, nil
 		}
 		return nil, err
 	}
-
-	// notify invoke topic with the queue id
-	queueId := strconv.FormatInt(invocation.QueueID, 10)
-	querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
-		Topic:   invokeTopic,
-		Payload: queueId,
-	})
+	metrics.InvocationsEnqueued.WithLabelValues(metrics.QueueLabel(invocation.QueueID)).Inc()
+
+	// notify invoke topic with the queue id.
+	// a delayed invocation is notified by the scheduler once it's due.
+	if invocation.ScheduledAt <= time.Now().Unix() {
+		queueId := strconv.FormatInt(invocation.QueueID, 10)
+		querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   invokeTopic,
+			Payload: queueId,
+		})
+	}
 
 	return api.CreateInvocationAsync201JSONResponse{
 		Id: strconv.FormatInt(invocation.ID, 10),
 	}, nil
 }
 
+// InsertInvocationBatch creates the invocations of a batch in one transaction. An invocation for an unknown actor is
+// reported in its result instead of failing the whole batch, while an invalid invocation rejects the batch.
+// The queues of the due invocations are notified once each after the commit.
+func (m *Manager) InsertInvocationBatch(ctx context.Context, callerActorId int64, request api.CreateInvocationBatchRequestObject) (api.CreateInvocationBatchResponseObject, error) {
+	m.logger.Debug("InsertInvocationBatch start", "callerActorId", callerActorId, "count", len(request.Body.Invocations))
+
+	if len(request.Body.Invocations) == 0 || len(request.Body.Invocations) > maxInsertBatchSize {
+		return api.CreateInvocationBatch400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("invocations must have between 1 and %d items", maxInsertBatchSize)},
+		}, nil
+	}
+
+	paramsList := make([]*dbsqlc.InvocationInsertParams, len(request.Body.Invocations))
+	for i := range request.Body.Invocations {
+		params, err := invocationInsertParams(ctx, callerActorId, &request.Body.Invocations[i], nil)
+		if err != nil {
+			return api.CreateInvocationBatch400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("invocations[%d]: %s", i, err.Error())},
+			}, nil
+		}
+		paramsList[i] = params
+	}
+
+	dueQueueIds := make(map[int64]struct{})
+	var insertedQueueIds []int64
+	results, err := dbaccess.WithTxV(ctx, m.dataSource, func(ctx context.Context, tx dbaccess.DataSource) ([]api.InvocationBatchResult, error) {
+		now := time.Now().Unix()
+		results := make([]api.InvocationBatchResult, len(paramsList))
+		for i, params := range paramsList {
+			invocation, err := querier.InvocationInsert(ctx, tx, params)
+			if err == nil {
+				insertedQueueIds = append(insertedQueueIds, invocation.QueueID)
+				results[i] = api.InvocationBatchResult{
+					Id:    lo.ToPtr(strconv.FormatInt(invocation.ID, 10)),
+					State: lo.ToPtr(api.InvocationStateAvailable),
+				}
+				if invocation.ScheduledAt <= now {
+					dueQueueIds[invocation.QueueID] = struct{}{}
+				}
+				continue
+			}
+			if err != pgx.ErrNoRows {
+				return nil, err
+			}
+
+			existing, err := findIdempotentInvocation(ctx, tx, params)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				results[i] = api.InvocationBatchResult{
+					Id:    lo.ToPtr(strconv.FormatInt(existing.ID, 10)),
+					State: lo.ToPtr(api.InvocationState(existing.State)),
+				}
+				continue
+			}
+
+			rejected, err := queueRejectsInvocations(ctx, tx, params.ActorName)
+			if err != nil {
+				return nil, err
+			}
+			if rejected {
+				results[i] = api.InvocationBatchResult{Error: lo.ToPtr("queue paused")}
+				continue
+			}
+			results[i] = api.InvocationBatchResult{Error: lo.ToPtr("actor not found")}
+		}
+		return results, nil
+	})
+	if err != nil {
+		m.logger.Error("Failed to insert invocation batch", "err", err)
+		return api.CreateInvocationBatch500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: "Failed to insert invocations"},
+		}, nil
+	}
+
+	for _, queueId := range insertedQueueIds {
+		metrics.InvocationsEnqueued.WithLabelValues(metrics.QueueLabel(queueId)).Inc()
+	}
+
+	// notify invoke topic once per queue with due invocations
+	NotifyQueues(ctx, m.dataSource, lo.Keys(dueQueueIds))
+
+	return api.CreateInvocationBatch201JSONResponse{Results: results}, nil
+}
+
 func (m *Manager) GetInvocationById(ctx context.Context, callerActorId int64, request api.GetInvocationByIdRequestObject) (api.GetInvocationByIdResponseObject, error) {
 	m.logger.Debug("GetInvocationById start", "callerActorId", callerActorId, "id", request.Id, "wait", request.Params.Wait)
 
@@ -186,10 +330,11 @@ func (m *Manager) GetInvocationById(ctx context.Context, callerActorId int64, re
 		}
 		result, err1 := parseJson(invocation.Result)
 		errors, err2 := parseJson(invocation.Errors)
-		if err1 != nil || err2 != nil {
-			m.logger.Error("Failed to parse result", "err1", err, "err2", err2)
+		progress, err3 := parseJson(invocation.Progress)
+		if err1 != nil || err2 != nil || err3 != nil {
+			m.logger.Error("Failed to parse result", "err1", err, "err2", err2, "err3", err3)
 			return api.GetInvocationById500JSONResponse{
-				N500JSONResponse: api.N500JSONResponse{Error: "Failed to parse result or errors"},
+				N500JSONResponse: api.N500JSONResponse{Error: "Failed to parse result, errors or progress"},
 			}
 		}
 
@@ -210,6 +355,7 @@ func (m *Manager) GetInvocationById(ctx context.Context, callerActorId int64, re
 				State:       api.InvocationState(invocation.State),
 				Result:      result,
 				Errors:      errors,
+				Progress:    progress,
 			}
 		}
 		return api.GetInvocationById202JSONResponse{
@@ -220,6 +366,7 @@ func (m *Manager) GetInvocationById(ctx context.Context, callerActorId int64, re
 			State:       api.InvocationState(invocation.State),
 			Result:      result,
 			Errors:      errors,
+			Progress:    progress,
 		}
 	}
 
@@ -263,31 +410,17 @@ func (m *Manager) GetInvocationById(ctx context.Context, callerActorId int64, re
 func (m *Manager) ExecuteInvocationSync(ctx context.Context, callerActorId int64, request api.CreateInvocationSyncRequestObject) (api.CreateInvocationSyncResponseObject, error) {
 	m.logger.Info("ExecuteInvocationSync start", "traceId", request.Body.Meta["trace_id"], "callerActorId", callerActorId, "requestBody", request.Body)
 
-	if len(request.Body.Meta) == 0 {
-		return api.CreateInvocationSync400JSONResponse{
-			N400JSONResponse: api.N400JSONResponse{Error: "Meta is required"},
-		}, nil
-	}
-
-	// ensure trace_id is set
-	traceId := request.Body.Meta["trace_id"]
-	if traceId == nil {
-		request.Body.Meta["trace_id"] = generateTraceId()
-	}
-
-	metadata, err := json.Marshal(request.Body.Meta)
+	params, err := invocationInsertParams(ctx, callerActorId, &api.InvocationCreateRequest{
+		Actor:       request.Body.Actor,
+		Meta:        request.Body.Meta,
+		Payload:     request.Body.Payload,
+		Priority:    request.Body.Priority,
+		RetryPolicy: request.Body.RetryPolicy,
+		Tags:        request.Body.Tags,
+	}, nil)
 	if err != nil {
-		m.logger.Error("Failed to marshal metadata", "err", err)
-		return api.CreateInvocationSync500JSONResponse{
-			N500JSONResponse: api.N500JSONResponse{Error: "Failed to marshal metadata"},
-		}, nil
-	}
-
-	payload, err := json.Marshal(request.Body.Payload)
-	if err != nil {
-		m.logger.Error("Failed to marshal payload", "err", err)
-		return api.CreateInvocationSync500JSONResponse{
-			N500JSONResponse: api.N500JSONResponse{Error: "Failed to marshal payload"},
+		return api.CreateInvocationSync400JSONResponse{
+			N400JSONResponse: api.N400JSONResponse{Error: err.Error()},
 		}, nil
 	}
 
@@ -306,37 +439,54 @@ func (m *Manager) ExecuteInvocationSync(ctx context.Context, callerActorId int64
 	})
 	defer responseSub.Unlisten(ctx)
 
-	invocation, err := querier.InvocationInsert(ctx, m.dataSource, &dbsqlc.InvocationInsertParams{
-		ActorName: request.Body.Actor,
-		State:     "available",
-		Metadata:  metadata,
-		Priority:  1,
-		Payload:   payload,
-	})
+	var invocationId int64
+	invocation, err := querier.InvocationInsert(ctx, m.dataSource, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if err != pgx.ErrNoRows {
+			return nil, err
+		}
+
+		// a request replayed with the idempotency key of its meta waits for the invocation created the first time
+		existing, err := findIdempotentInvocation(ctx, m.dataSource, params)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			rejected, err := queueRejectsInvocations(ctx, m.dataSource, params.ActorName)
+			if err != nil {
+				return nil, err
+			}
+			if rejected {
+				return api.CreateInvocationSync503JSONResponse{
+					N503JSONResponse: api.N503JSONResponse{Error: "queue paused"},
+				}, nil
+			}
 			return api.CreateInvocationSync400JSONResponse{
 				N400JSONResponse: api.N400JSONResponse{Error: "actor not found"},
 			}, nil
 		}
-		return nil, err
+		m.logger.Debug("ExecuteInvocationSync replayed", "callerActorId", callerActorId, "idempotencyKey", *params.IdempotencyKey, "invokeId", existing.ID)
+		invocationId = existing.ID
+	} else {
+		invocationId = invocation.ID
+		metrics.InvocationsEnqueued.WithLabelValues(metrics.QueueLabel(invocation.QueueID)).Inc()
+
+		// notify invoke topic with the queue id
+		queueId := strconv.FormatInt(invocation.QueueID, 10)
+		querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   invokeTopic,
+			Payload: queueId,
+		})
 	}
 
-	// notify invoke topic with the queue id
-	queueId := strconv.FormatInt(invocation.QueueID, 10)
-	querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
-		Topic:   invokeTopic,
-		Payload: queueId,
-	})
-
 	waitSec := util.Clamp(*lo.CoalesceOrEmpty(request.Params.Wait, &defaultWaitSec), 0, 60)
 	timeContext, cancel := context.WithTimeout(ctx, time.Duration(waitSec)*time.Second)
 	defer cancel()
 
-	invocationIdStr := strconv.FormatInt(invocation.ID, 10)
+	invocationIdStr := strconv.FormatInt(invocationId, 10)
 
 	returnInvication := func() (api.CreateInvocationSyncResponseObject, error) {
-		latestInvocation, err := querier.InvocationFindById(ctx, m.dataSource, invocation.ID)
+		latestInvocation, err := querier.InvocationFindById(ctx, m.dataSource, invocationId)
 		result, err1 := parseJson(latestInvocation.Result)
 		errors, err2 := parseJson(latestInvocation.Errors)
 		if err1 != nil || err2 != nil {
@@ -416,6 +566,7 @@ func (m *Manager) ReturnInvocationResponse(ctx context.Context, callerActorId in
 	if invocation == nil {
 		return api.ReturnInvocationResponse404Response{}, nil
 	}
+	countFinalized(invocation.QueueID, invocation.State)
 
 	// notify response topic with the invocation id
 	m.logger.Debug("Notify response topic", "topic", responseTopic, "payload", request.InvokeId)
@@ -424,9 +575,113 @@ func (m *Manager) ReturnInvocationResponse(ctx context.Context, callerActorId in
 		Payload: request.InvokeId,
 	})
 
+	if invocation.State == dbsqlc.InvocationStateCancelled {
+		return api.ReturnInvocationResponse409Response{}, nil
+	}
 	return api.ReturnInvocationResponse200Response{}, nil
 }
 
+func (m *Manager) HeartbeatInvocation(ctx context.Context, callerActorId int64, request api.HeartbeatInvocationRequestObject) (api.HeartbeatInvocationResponseObject, error) {
+	m.logger.Debug("HeartbeatInvocation start", "InvokeId", request.InvokeId, "callerActorId", callerActorId)
+
+	invocationId, err := strconv.ParseInt(request.InvokeId, 10, 64)
+	if err != nil {
+		return api.HeartbeatInvocation404Response{}, nil
+	}
+
+	var progress []byte
+	if request.Body != nil && request.Body.Progress != nil {
+		progress, err = json.Marshal(request.Body.Progress)
+		if err != nil {
+			m.logger.Error("Failed to marshal invocation progress", "err", err)
+			return api.HeartbeatInvocation500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: "Failed to marshal progress"},
+			}, nil
+		}
+	}
+
+	invocation, err := querier.InvocationHeartbeatIfRunning(ctx, m.dataSource, &dbsqlc.InvocationHeartbeatIfRunningParams{
+		ID:          invocationId,
+		AttemptedBy: callerActorId,
+		Progress:    progress,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.HeartbeatInvocation404Response{}, nil
+		}
+
+		m.logger.Error("Failed to extend invocation lease", "err", err)
+		return api.HeartbeatInvocation500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: "Failed to extend invocation lease"},
+		}, nil
+	}
+
+	cancelled := invocation.State == dbsqlc.InvocationStateCancelled
+	if cancelled {
+		// the cancellation was requested while the invocation was running, and it's finalized now.
+		querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
+			Topic:   responseTopic,
+			Payload: request.InvokeId,
+		})
+	}
+
+	return api.HeartbeatInvocation200JSONResponse{
+		LeaseExpiresAt: invocation.LeaseExpiresAt,
+		Cancelled:      cancelled,
+	}, nil
+}
+
+func (m *Manager) CancelInvocation(ctx context.Context, callerActorId int64, isAdmin bool, request api.CancelInvocationRequestObject) (api.CancelInvocationResponseObject, error) {
+	m.logger.Info("CancelInvocation start", "id", request.Id, "callerActorId", callerActorId, "isAdmin", isAdmin)
+
+	invocationId, err := strconv.ParseInt(request.Id, 10, 64)
+	if err != nil {
+		return api.CancelInvocation404Response{}, nil
+	}
+
+	invocation, err := querier.InvocationFindById(ctx, m.dataSource, invocationId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.CancelInvocation404Response{}, nil
+		}
+
+		m.logger.Error("Failed to find invocation", "err", err)
+		return api.CancelInvocation500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: "Failed to find invocation"},
+		}, nil
+	}
+
+	// hide the invocations created by others from non-admin callers
+	if !isAdmin && lo.FromPtr(invocation.CreatedBy) != callerActorId {
+		return api.CancelInvocation404Response{}, nil
+	}
+
+	cancelled, err := querier.InvocationCancel(ctx, m.dataSource, invocationId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.CancelInvocation409Response{}, nil
+		}
+
+		m.logger.Error("Failed to cancel invocation", "err", err)
+		return api.CancelInvocation500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: "Failed to cancel invocation"},
+		}, nil
+	}
+
+	countFinalized(cancelled.QueueID, cancelled.State)
+
+	// wake up the waiters of the invocation
+	querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
+		Topic:   responseTopic,
+		Payload: request.Id,
+	})
+
+	return api.CancelInvocation200JSONResponse{
+		Id:    request.Id,
+		State: api.InvocationState(cancelled.State),
+	}, nil
+}
+
 func (m *Manager) ReturnInvocationError(ctx context.Context, callerActorId int64, request api.ReturnInvocationErrorRequestObject) (api.ReturnInvocationErrorResponseObject, error) {
 	m.logger.Info("ReturnInvocationError start", "InvokeId", request.InvokeId, "callerActorId", callerActorId, "requestBody", request.Body.Errors)
 
@@ -465,6 +720,20 @@ func (m *Manager) ReturnInvocationError(ctx context.Context, callerActorId int64
 	if invocation == nil {
 		return api.ReturnInvocationError404Response{}, nil
 	}
+	countFinalized(invocation.QueueID, invocation.State)
+
+	if invocation.State == dbsqlc.InvocationStateAvailable {
+		// the invocation has attempts left and goes back to the queue.
+		// wake up the waiting actors only if it is due now, otherwise the due scheduler does it later.
+		m.logger.Info("Invocation scheduled for retry", "InvokeId", request.InvokeId, "scheduledAt", invocation.ScheduledAt)
+		if invocation.ScheduledAt <= time.Now().Unix() {
+			querier.PgNotifyOne(ctx, m.dataSource, &dbsqlc.PgNotifyOneParams{
+				Topic:   invokeTopic,
+				Payload: strconv.FormatInt(invocation.QueueID, 10),
+			})
+		}
+		return api.ReturnInvocationError200Response{}, nil
+	}
 
 	// notify response topic with the invocation id
 	m.logger.Debug("Notify response topic", "topic", responseTopic, "payload", request.InvokeId)
@@ -473,22 +742,36 @@ func (m *Manager) ReturnInvocationError(ctx context.Context, callerActorId int64
 		Payload: request.InvokeId,
 	})
 
+	if invocation.State == dbsqlc.InvocationStateCancelled {
+		return api.ReturnInvocationError409Response{}, nil
+	}
 	return api.ReturnInvocationError200Response{}, nil
 }
 
 func (m *Manager) GetNextInvocation(ctx context.Context, callerActorId int64, queueId int64, request api.GetNextInvocationRequestObject) (api.GetNextInvocationResponseObject, error) {
-	m.logger.Info("GetNextInvocation start", "callerActorId", callerActorId, "queueId", queueId, "wait", request.Params.Wait)
+	m.logger.Info("GetNextInvocation start", "callerActorId", callerActorId, "queueId", queueId, "wait", request.Params.Wait, "batchSize", request.Params.BatchSize)
 
-	getAvailble := func() (*dbsqlc.Invocation, error) {
+	batchSize := 1
+	if request.Params.BatchSize != nil {
+		if *request.Params.BatchSize < 1 || *request.Params.BatchSize > maxNextBatchSize {
+			return api.GetNextInvocation400JSONResponse{
+				N400JSONResponse: api.N400JSONResponse{Error: fmt.Sprintf("batch_size must be between 1 and %d", maxNextBatchSize)},
+			}, nil
+		}
+		batchSize = *request.Params.BatchSize
+	}
+
+	getAvailble := func() ([]*dbsqlc.Invocation, error) {
 		invocations, err := querier.InvocationGetAvailable(ctx, m.dataSource, &dbsqlc.InvocationGetAvailableParams{
 			AttemptedBy: callerActorId,
 			QueueID:     queueId,
-			Max:         1,
+			Max:         int32(batchSize),
 		})
-		if len(invocations) > 0 {
-			return invocations[0], nil
-		}
-		return nil, err
+		// the rows come back from the UPDATE in no particular order
+		slices.SortFunc(invocations, func(a, b *dbsqlc.Invocation) int {
+			return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(a.ID, b.ID))
+		})
+		return invocations, err
 	}
 
 	waitSec := util.Clamp(*lo.CoalesceOrEmpty(request.Params.Wait, &defaultWaitSec), 1, 60)
@@ -498,15 +781,16 @@ func (m *Manager) GetNextInvocation(ctx context.Context, callerActorId int64, qu
 
 	startTime := time.Now().Unix()
 	for {
-		invocation, err := getAvailble()
+		invocations, err := getAvailble()
 		if err != nil {
 			m.logger.Error("Failed to get next invocation", "err", err)
 			return createGetNext500Response("Cannot get next invocation: " + err.Error()), nil
 		}
 
-		if invocation != nil {
-			m.logger.Debug("Return NextInvocation", "InvokeId", invocation.ID)
-			return m.createGetNextResponse(invocation)
+		if len(invocations) > 0 {
+			metrics.InvocationsDequeued.WithLabelValues(metrics.QueueLabel(queueId)).Add(float64(len(invocations)))
+			m.logger.Debug("Return NextInvocation", "InvokeIds", lo.Map(invocations, func(i *dbsqlc.Invocation, _ int) int64 { return i.ID }))
+			return m.createGetNextResponse(invocations, request.Params.BatchSize != nil)
 		}
 
 		remainingSec := waitSec - int(time.Now().Unix()-startTime)
@@ -524,25 +808,38 @@ func (m *Manager) GetNextInvocation(ctx context.Context, callerActorId int64, qu
 	return api.GetNextInvocation404Response{}, nil
 }
 
-func (m *Manager) createGetNextResponse(invocation *dbsqlc.Invocation) (api.GetNextInvocationResponseObject, error) {
-	metadata := make(map[string]interface{})
-	err := json.Unmarshal(invocation.Metadata, &metadata)
-	if err != nil {
-		m.logger.Error("Failed to unmarshal metadata", "err", err)
-		return createGetNext500Response("Failed to unmarshal metadata"), nil
-	}
+// createGetNextResponse returns the invocations in data for a batch request, otherwise the only invocation as is.
+func (m *Manager) createGetNextResponse(invocations []*dbsqlc.Invocation, batch bool) (api.GetNextInvocationResponseObject, error) {
+	jobs := make([]api.InvocationJob, 0, len(invocations))
+	for _, invocation := range invocations {
+		metadata := make(map[string]interface{})
+		err := json.Unmarshal(invocation.Metadata, &metadata)
+		if err != nil {
+			m.logger.Error("Failed to unmarshal metadata", "err", err)
+			return createGetNext500Response("Failed to unmarshal metadata"), nil
+		}
 
-	payload := make(map[string]interface{})
-	err = json.Unmarshal(invocation.Payload, &payload)
-	if err != nil {
-		m.logger.Error("Failed to unmarshal payload", "err", err)
-		return createGetNext500Response("Failed to unmarshal payload"), nil
+		payload := make(map[string]interface{})
+		err = json.Unmarshal(invocation.Payload, &payload)
+		if err != nil {
+			m.logger.Error("Failed to unmarshal payload", "err", err)
+			return createGetNext500Response("Failed to unmarshal payload"), nil
+		}
+
+		jobs = append(jobs, api.InvocationJob{
+			Id:      strconv.FormatInt(invocation.ID, 10),
+			Meta:    metadata,
+			Payload: payload,
+		})
 	}
 
+	if batch {
+		return api.GetNextInvocation200JSONResponse{Data: &jobs}, nil
+	}
 	return api.GetNextInvocation200JSONResponse{
-		Id:      strconv.FormatInt(invocation.ID, 10),
-		Meta:    metadata,
-		Payload: payload,
+		Id:      &jobs[0].Id,
+		Meta:    &jobs[0].Meta,
+		Payload: &jobs[0].Payload,
 	}, nil
 }
 
@@ -552,6 +849,223 @@ func createGetNext500Response(err string) api.GetNextInvocationResponseObject {
 	}
 }
 
+// countFinalized counts the invocation in the finalized metric if it's in a final state. An invocation going back to
+// the queue for a retry, or still running until its cancellation is acknowledged, is not counted.
+func countFinalized(queueId int64, state dbsqlc.InvocationState) {
+	if lo.Contains(finalizedStatuses, state) {
+		metrics.InvocationsFinalized.WithLabelValues(metrics.QueueLabel(queueId), string(state)).Inc()
+	}
+}
+
+// NotifyQueues notifies the invoke topic that invocations are available in the given queues, waking up the actors
+// waiting on them.
+func NotifyQueues(ctx context.Context, db dbsqlc.DBTX, queueIds []int64) error {
+	for _, queueId := range queueIds {
+		err := querier.PgNotifyOne(ctx, db, &dbsqlc.PgNotifyOneParams{
+			Topic:   invokeTopic,
+			Payload: strconv.FormatInt(queueId, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invocationInsertParams validates an invocation of a create request and converts it to the insert parameters.
+// The meta is linked to the trace of ctx, see setTraceContext.
+func invocationInsertParams(ctx context.Context, callerActorId int64, body *api.InvocationCreateRequest, idempotencyKeyHeader *string) (*dbsqlc.InvocationInsertParams, error) {
+	if len(body.Meta) == 0 {
+		return nil, fmt.Errorf("Meta is required")
+	}
+
+	setTraceContext(ctx, body.Meta)
+
+	metadata, err := json.Marshal(body.Meta)
+	if err != nil {
+		return nil, fmt.Errorf("invalid meta: %w", err)
+	}
+
+	payload, err := json.Marshal(body.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	maxAttempts, retryBackoffSeconds, err := RetryPolicyParams(body.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := PriorityParam("priority", body.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledAt, err := scheduledAtParam(body.RunAt, body.DelaySeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotencyKey, err := idempotencyKeyParam(idempotencyKeyHeader, body.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := tagsParam(body.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbsqlc.InvocationInsertParams{
+		ActorName:           body.Actor,
+		State:               "available",
+		Metadata:            metadata,
+		Priority:            priority,
+		Tags:                tags,
+		Payload:             payload,
+		MaxAttempts:         maxAttempts,
+		RetryBackoffSeconds: retryBackoffSeconds,
+		CreatedBy:           &callerActorId,
+		ScheduledAt:         scheduledAt,
+		IdempotencyKey:      idempotencyKey,
+	}, nil
+}
+
+// findIdempotentInvocation returns the invocation the caller already created with the idempotency key of the insert
+// parameters, which is why nothing was inserted. A nil invocation means nothing was inserted because the actor was not found.
+func findIdempotentInvocation(ctx context.Context, db dbsqlc.DBTX, params *dbsqlc.InvocationInsertParams) (*dbsqlc.InvocationFindByIdempotencyKeyRow, error) {
+	if params.IdempotencyKey == nil {
+		return nil, nil
+	}
+	existing, err := querier.InvocationFindByIdempotencyKey(ctx, db, &dbsqlc.InvocationFindByIdempotencyKeyParams{
+		CreatedBy:      *params.CreatedBy,
+		IdempotencyKey: *params.IdempotencyKey,
+	})
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return existing, err
+}
+
+// queueRejectsInvocations tells whether nothing was inserted for the actor because its queue is paused and rejects new
+// invocations, rather than because the actor was not found.
+func queueRejectsInvocations(ctx context.Context, db dbsqlc.DBTX, actorName string) (bool, error) {
+	queue, err := querier.QueueFindByActorName(ctx, db, actorName)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return queue.PausedAt != nil && queue.RejectInvocationsWhenPaused, nil
+}
+
+// RetryPolicyParams validates the retry policy of a request and converts it to the query parameters.
+// A nil field falls back to the retry policy of the target actor, or is left unchanged.
+func RetryPolicyParams(policy *api.RetryPolicy) (*int16, *int32, error) {
+	if policy == nil {
+		return nil, nil, nil
+	}
+
+	var maxAttempts *int16
+	if policy.MaxAttempts != nil {
+		if *policy.MaxAttempts < 1 || *policy.MaxAttempts > MaxRetryAttempts {
+			return nil, nil, fmt.Errorf("retry_policy.max_attempts must be between 1 and %d", MaxRetryAttempts)
+		}
+		maxAttempts = lo.ToPtr(int16(*policy.MaxAttempts))
+	}
+
+	var backoffSeconds *int32
+	if policy.BackoffSeconds != nil {
+		if *policy.BackoffSeconds < 0 || *policy.BackoffSeconds > MaxRetryBackoffSeconds {
+			return nil, nil, fmt.Errorf("retry_policy.backoff_seconds must be between 0 and %d", MaxRetryBackoffSeconds)
+		}
+		backoffSeconds = lo.ToPtr(int32(*policy.BackoffSeconds))
+	}
+
+	return maxAttempts, backoffSeconds, nil
+}
+
+// PriorityParam validates the priority of an invocation or the max priority of an actor, named field in the request.
+// A nil priority falls back to the max priority of the caller, or is left unchanged.
+func PriorityParam(field string, priority *int) (*int16, error) {
+	if priority == nil {
+		return nil, nil
+	}
+	if *priority < MinPriority || *priority > MaxPriority {
+		return nil, fmt.Errorf("%s must be between %d and %d", field, MinPriority, MaxPriority)
+	}
+	return lo.ToPtr(int16(*priority)), nil
+}
+
+// LeaseSecondsParam validates the lease seconds of a queue. A nil lease is left unchanged, and 0 removes the lease.
+func LeaseSecondsParam(leaseSeconds *int) (*int32, error) {
+	if leaseSeconds == nil {
+		return nil, nil
+	}
+	if *leaseSeconds != 0 && (*leaseSeconds < MinLeaseSeconds || *leaseSeconds > MaxLeaseSeconds) {
+		return nil, fmt.Errorf("lease_seconds must be 0 or between %d and %d", MinLeaseSeconds, MaxLeaseSeconds)
+	}
+	return lo.ToPtr(int32(*leaseSeconds)), nil
+}
+
+// scheduledAtParam validates the run_at and delay_seconds of a create request and returns the time the invocation becomes due.
+// A nil result means the invocation is due right away.
+func scheduledAtParam(runAt *int64, delaySeconds *int) (*int64, error) {
+	if runAt != nil && delaySeconds != nil {
+		return nil, fmt.Errorf("run_at and delay_seconds cannot be used together")
+	}
+	if delaySeconds != nil {
+		if *delaySeconds < 0 || *delaySeconds > maxDelaySeconds {
+			return nil, fmt.Errorf("delay_seconds must be between 0 and %d", maxDelaySeconds)
+		}
+		return lo.ToPtr(time.Now().Unix() + int64(*delaySeconds)), nil
+	}
+	// run_at has the same horizon as delay_seconds, a time in the past means right away
+	if runAt != nil && *runAt > time.Now().Unix()+maxDelaySeconds {
+		return nil, fmt.Errorf("run_at must be at most %d seconds from now", maxDelaySeconds)
+	}
+	return runAt, nil
+}
+
+// tagsParam validates the tags of a create request against the varchar(255)[] column of the invocations.
+func tagsParam(tags *[]string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+	if len(*tags) > maxTags {
+		return nil, fmt.Errorf("tags must have at most %d items", maxTags)
+	}
+	for _, tag := range *tags {
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, fmt.Errorf("tags must be at most %d characters", maxTagLength)
+		}
+	}
+	return *tags, nil
+}
+
+// idempotencyKeyParam returns the idempotency key of a create request, taken from the Idempotency-Key header or else from
+// the idempotency_key of the meta.
+func idempotencyKeyParam(header *string, meta map[string]interface{}) (*string, error) {
+	key := header
+	if key == nil {
+		if value, ok := meta["idempotency_key"]; ok && value != nil {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("meta.idempotency_key must be a string")
+			}
+			key = &str
+		}
+	}
+	if key == nil || *key == "" {
+		return nil, nil
+	}
+	if len(*key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	return key, nil
+}
+
 func parseJson(data []byte) (*map[string]interface{}, error) {
 	if data == nil {
 		return nil, nil
@@ -561,6 +1075,25 @@ func parseJson(data []byte) (*map[string]interface{}, error) {
 	return &result, err
 }
 
+// setTraceContext links the invocation to the trace of ctx by storing the W3C traceparent and tracestate of ctx in its
+// meta, so that the actor which gets it can continue the trace. Those given by the caller are kept. The trace_id
+// defaults to the id of the trace, or to a random one when ctx has no trace.
+func setTraceContext(ctx context.Context, meta map[string]interface{}) {
+	for key, value := range tracing.Inject(ctx) {
+		if meta[key] == nil {
+			meta[key] = value
+		}
+	}
+
+	if meta["trace_id"] == nil {
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+			meta["trace_id"] = spanContext.TraceID().String()
+		} else {
+			meta["trace_id"] = generateTraceId()
+		}
+	}
+}
+
 func generateTraceId() string {
 	numBytes := (32*3)/4 + 1
 
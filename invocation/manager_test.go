@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/navyx/ai/maos/maos-core/api"
@@ -73,6 +74,69 @@ func TestInsertInvocation(t *testing.T) {
 		)
 	})
 
+	t.Run("Invocation insertion with priority and tags", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		manager := invocation.NewManager(testhelper.Logger(t), dbPool)
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		caller := fixture.InsertActor(t, ctx, dbPool, "caller")
+		_, err := querier.ActorUpdate(ctx, dbPool, &dbsqlc.ActorUpdateParams{ID: caller.ID, MaxPriority: lo.ToPtr(int16(3))})
+		require.NoError(t, err)
+
+		insert := func(priority *int) *dbsqlc.Invocation {
+			request := api.CreateInvocationAsyncRequestObject{
+				Body: &api.CreateInvocationAsyncJSONRequestBody{
+					Actor:    actor.Name,
+					Meta:     map[string]interface{}{"kind": "test"},
+					Payload:  map[string]interface{}{},
+					Priority: priority,
+					Tags:     &[]string{"tag1", "tag2"},
+				},
+			}
+			response, err := manager.InsertInvocation(ctx, caller.ID, request)
+			require.NoError(t, err)
+			require.IsType(t, api.CreateInvocationAsync201JSONResponse{}, response)
+
+			id, err := strconv.ParseInt(response.(api.CreateInvocationAsync201JSONResponse).Id, 10, 64)
+			require.NoError(t, err)
+			invocation, err := querier.InvocationFindById(ctx, dbPool, id)
+			require.NoError(t, err)
+			return invocation
+		}
+
+		invocation := insert(lo.ToPtr(5))
+		assert.EqualValues(t, 5, invocation.Priority)
+		assert.Equal(t, []string{"tag1", "tag2"}, invocation.Tags)
+
+		// the caller cannot go beyond its max priority
+		invocation = insert(lo.ToPtr(1))
+		assert.EqualValues(t, 3, invocation.Priority)
+
+		// the max priority of the caller is the default
+		invocation = insert(nil)
+		assert.EqualValues(t, 3, invocation.Priority)
+	})
+
+	t.Run("Invalid priority", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		manager := invocation.NewManager(testhelper.Logger(t), dbPool)
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+
+		request := api.CreateInvocationAsyncRequestObject{
+			Body: &api.CreateInvocationAsyncJSONRequestBody{
+				Actor:    actor.Name,
+				Meta:     map[string]interface{}{"kind": "test"},
+				Payload:  map[string]interface{}{},
+				Priority: lo.ToPtr(9),
+			},
+		}
+		response, err := manager.InsertInvocation(ctx, actor.ID, request)
+
+		assert.NoError(t, err)
+		assert.IsType(t, api.CreateInvocationAsync400JSONResponse{}, response)
+	})
+
 	// Test case 2: Invalid payload (for JSON marshalling error)
 	t.Run("Invalid payload", func(t *testing.T) {
 		dbPool := testhelper.TestDB(ctx, t)
@@ -0,0 +1,87 @@
+package invocation
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleTemplateData holds the fields available to the payload and meta
+// templates of a schedule.
+type ScheduleTemplateData struct {
+	ScheduleName  string
+	ScheduledAt   int64
+	ScheduledTime string
+}
+
+// NextScheduleRunAt parses a cron expression and returns the unix time of its
+// first run after the given time. It accepts standard 5-field expressions and
+// descriptors such as @daily, optionally prefixed with CRON_TZ=<zone>. A nil
+// time is returned if the expression never runs again.
+func NextScheduleRunAt(cronExpression string, after time.Time) (*int64, error) {
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return nil, nil
+	}
+	nextUnix := next.Unix()
+	return &nextUnix, nil
+}
+
+// RenderScheduleTemplate returns a copy of the template in which every string
+// value containing an action is rendered as a Go template with the given data.
+func RenderScheduleTemplate(tmpl map[string]interface{}, data ScheduleTemplateData) (map[string]interface{}, error) {
+	rendered, err := renderTemplateValue(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	return rendered.(map[string]interface{}), nil
+}
+
+func renderTemplateValue(value interface{}, data ScheduleTemplateData) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			renderedItem, err := renderTemplateValue(item, data)
+			if err != nil {
+				return nil, err
+			}
+			rendered[key] = renderedItem
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, len(v))
+		for i, item := range v {
+			renderedItem, err := renderTemplateValue(item, data)
+			if err != nil {
+				return nil, err
+			}
+			rendered[i] = renderedItem
+		}
+		return rendered, nil
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		t, err := template.New("").Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %q: %w", v, err)
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("invalid template %q: %w", v, err)
+		}
+		return buf.String(), nil
+	default:
+		return v, nil
+	}
+}
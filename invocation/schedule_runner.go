@@ -0,0 +1,273 @@
+package invocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/baseservice"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/leadership"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/startstop"
+)
+
+const (
+	ScheduleRunnerIntervalDefault  = time.Second
+	ScheduleRunnerBatchSizeDefault = 100
+
+	scheduleRunnerLeadership = "schedule_runner"
+)
+
+// errScheduleRunTaken is returned inside the run transaction when the schedule
+// has been changed or run by someone else since it was listed.
+var errScheduleRunTaken = errors.New("schedule run already taken")
+
+// errScheduleActorUnavailable is returned when the invocation of a schedule
+// can't be created because its actor is gone or its queue is paused and
+// rejects invocations.
+var errScheduleActorUnavailable = errors.New("actor not found or its queue rejects invocations")
+
+type ScheduleRunnerConfig struct {
+	// Interval is the time between two checks for due schedules.
+	Interval time.Duration
+	// BatchSize is the maximum number of schedules run in one check.
+	BatchSize int
+}
+
+// ScheduleRunner creates the invocations of the cron schedules when they are
+// due, and records their last and next run times.
+//
+// Only the elected leader among the server instances runs the schedules. A
+// schedule that missed several runs, e.g. because no server was up, runs only
+// once when it's picked up again.
+type ScheduleRunner struct {
+	baseservice.BaseService
+	startstop.BaseStartStop
+
+	config     ScheduleRunnerConfig
+	dataSource dbaccess.DataSource
+	elector    *leadership.Elector
+}
+
+func NewScheduleRunner(logger *slog.Logger, dataSource dbaccess.DataSource, config ScheduleRunnerConfig) *ScheduleRunner {
+	if config.Interval <= 0 {
+		config.Interval = ScheduleRunnerIntervalDefault
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = ScheduleRunnerBatchSizeDefault
+	}
+
+	return baseservice.Init(logger, &ScheduleRunner{
+		config:     config,
+		dataSource: dataSource,
+		elector:    leadership.NewElector(logger, dataSource, leadership.ElectorConfig{Name: scheduleRunnerLeadership}),
+	})
+}
+
+func (r *ScheduleRunner) Start(ctx context.Context) error {
+	ctx, shouldStart, started, stopped := r.StartInit(ctx)
+	if !shouldStart {
+		return nil
+	}
+
+	if err := r.elector.Start(ctx); err != nil {
+		stopped()
+		return err
+	}
+
+	go func() {
+		started()
+		defer stopped()
+		defer r.elector.Stop()
+
+		r.Logger.DebugContext(ctx, r.Name+": Run loop started")
+		defer r.Logger.DebugContext(ctx, r.Name+": Run loop stopped")
+
+		ticker := time.NewTicker(r.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if !r.elector.IsLeader() {
+				continue
+			}
+
+			if _, err := r.RunDue(ctx, time.Now()); err != nil && ctx.Err() == nil {
+				r.Logger.ErrorContext(ctx, r.Name+": Error running due schedules", "err", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RunDue creates an invocation for every enabled schedule due at the given
+// time, one batch at a time, and returns the number of invocations created.
+// A schedule that fails to run is logged, and moved to its next run with the
+// error recorded on it.
+func (r *ScheduleRunner) RunDue(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+	for {
+		rows, err := querier.ScheduleListDue(ctx, r.dataSource, &dbsqlc.ScheduleListDueParams{
+			Now: now.Unix(),
+			Max: int32(r.config.BatchSize),
+		})
+		if err != nil {
+			return total, err
+		}
+
+		ran := 0
+		for _, row := range rows {
+			ok, err := r.run(ctx, row, now)
+			if err != nil {
+				if ctx.Err() != nil {
+					return total, ctx.Err()
+				}
+				r.Logger.ErrorContext(ctx, r.Name+": Failed to run schedule", "scheduleId", row.ID, "name", row.Name, "err", err)
+				continue
+			}
+			if ok {
+				ran++
+			}
+		}
+		total += ran
+
+		// stop when the batch is not full, or when nothing in it could be run,
+		// so that failing schedules don't keep us looping.
+		if len(rows) < r.config.BatchSize || ran == 0 {
+			return total, nil
+		}
+	}
+}
+
+// run creates the invocation of one due schedule and moves the schedule to its
+// next run. It returns false if the schedule was run by someone else.
+//
+// A schedule that fails to run, e.g. because its actor is gone or its queue
+// rejects invocations, is moved to its next run as well, with the error
+// recorded on it, so that it doesn't stay due.
+func (r *ScheduleRunner) run(ctx context.Context, row *dbsqlc.ScheduleListDueRow, now time.Time) (bool, error) {
+	scheduledAt := *row.NextRunAt
+	nextRunAt, err := NextScheduleRunAt(row.CronExpression, now)
+	if err != nil {
+		return false, r.fail(ctx, row, scheduledAt, nil, err)
+	}
+
+	invocation, err := r.invoke(ctx, row, scheduledAt, nextRunAt, now)
+	if err != nil {
+		if errors.Is(err, errScheduleRunTaken) {
+			return false, nil
+		}
+		if ctx.Err() != nil {
+			return false, err
+		}
+		return false, r.fail(ctx, row, scheduledAt, nextRunAt, err)
+	}
+
+	metrics.InvocationsEnqueued.WithLabelValues(metrics.QueueLabel(invocation.QueueID)).Inc()
+
+	r.Logger.InfoContext(ctx, r.Name+": Schedule ran", "scheduleId", row.ID, "name", row.Name, "invokeId", invocation.ID, "nextRunAt", nextRunAt)
+	err = querier.PgNotifyOne(ctx, r.dataSource, &dbsqlc.PgNotifyOneParams{
+		Topic:   invokeTopic,
+		Payload: strconv.FormatInt(invocation.QueueID, 10),
+	})
+	if err != nil {
+		r.Logger.ErrorContext(ctx, r.Name+": Failed to notify invoke", "queueId", invocation.QueueID, "err", err)
+	}
+	return true, nil
+}
+
+// invoke renders the templates of a schedule and creates its invocation,
+// marking the schedule as run in the same transaction.
+func (r *ScheduleRunner) invoke(ctx context.Context, row *dbsqlc.ScheduleListDueRow, scheduledAt int64, nextRunAt *int64, now time.Time) (*dbsqlc.InvocationInsertRow, error) {
+	data := ScheduleTemplateData{
+		ScheduleName:  row.Name,
+		ScheduledAt:   scheduledAt,
+		ScheduledTime: time.Unix(scheduledAt, 0).UTC().Format(time.RFC3339),
+	}
+	payload, err := renderScheduleJson(row.Payload, data, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render payload: %w", err)
+	}
+	metadata, err := renderScheduleJson(row.Metadata, data, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot render meta: %w", err)
+	}
+
+	return dbaccess.WithTxV(ctx, r.dataSource, func(ctx context.Context, tx dbaccess.DataSource) (*dbsqlc.InvocationInsertRow, error) {
+		invocation, err := querier.InvocationInsert(ctx, tx, &dbsqlc.InvocationInsertParams{
+			ActorName: row.ActorName,
+			State:     dbsqlc.InvocationStateAvailable,
+			CreatedAt: now.Unix(),
+			Payload:   payload,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, errScheduleActorUnavailable
+			}
+			return nil, err
+		}
+
+		marked, err := querier.ScheduleMarkRun(ctx, tx, &dbsqlc.ScheduleMarkRunParams{
+			ID:               row.ID,
+			LastRunAt:        scheduledAt,
+			LastInvocationID: invocation.ID,
+			NextRunAt:        nextRunAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if marked == 0 {
+			return nil, errScheduleRunTaken
+		}
+		return invocation, nil
+	})
+}
+
+// fail records the error of a schedule run and moves the schedule to its next
+// run. It returns the error of the run.
+func (r *ScheduleRunner) fail(ctx context.Context, row *dbsqlc.ScheduleListDueRow, scheduledAt int64, nextRunAt *int64, runErr error) error {
+	_, err := querier.ScheduleMarkFailed(ctx, r.dataSource, &dbsqlc.ScheduleMarkFailedParams{
+		ID:        row.ID,
+		LastRunAt: scheduledAt,
+		LastError: runErr.Error(),
+		NextRunAt: nextRunAt,
+	})
+	if err != nil {
+		return errors.Join(runErr, fmt.Errorf("cannot record schedule failure: %w", err))
+	}
+	return runErr
+}
+
+// renderScheduleJson renders a payload or meta template stored as JSON. A
+// trace_id is generated for the meta of every run unless the template has one.
+func renderScheduleJson(raw []byte, data ScheduleTemplateData, isMeta bool) ([]byte, error) {
+	var tmpl map[string]interface{}
+	if err := json.Unmarshal(raw, &tmpl); err != nil {
+		return nil, err
+	}
+	if tmpl == nil {
+		tmpl = map[string]interface{}{}
+	}
+
+	rendered, err := RenderScheduleTemplate(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	if isMeta && rendered["trace_id"] == nil {
+		rendered["trace_id"] = generateTraceId()
+	}
+	return json.Marshal(rendered)
+}
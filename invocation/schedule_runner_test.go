@@ -0,0 +1,143 @@
+package invocation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/invocation"
+)
+
+func TestScheduleRunner(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("Due schedule creates an invocation and moves to its next run", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+		runner := invocation.NewScheduleRunner(testhelper.Logger(t), dbPool, invocation.ScheduleRunnerConfig{})
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		now := time.Now().Truncate(time.Minute)
+		dueAt := now.Add(-time.Minute).Unix()
+		schedule, err := querier.ScheduleInsert(ctx, dbPool, &dbsqlc.ScheduleInsertParams{
+			Name:           "every-minute",
+			ActorId:        actor.ID,
+			CronExpression: "* * * * *",
+			Payload:        []byte(`{"name": "{{ .ScheduleName }}", "at": "{{ .ScheduledAt }}"}`),
+			Metadata:       []byte(`{"kind": "cron"}`),
+			Enabled:        true,
+			NextRunAt:      &dueAt,
+		})
+		require.NoError(t, err)
+
+		ran, err := runner.RunDue(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, ran)
+
+		row, err := querier.ScheduleFindById(ctx, dbPool, schedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, dueAt, lo.FromPtr(row.Schedule.LastRunAt))
+		require.Equal(t, now.Add(time.Minute).Unix(), lo.FromPtr(row.Schedule.NextRunAt))
+		require.NotNil(t, row.Schedule.LastInvocationID)
+
+		created, err := querier.InvocationFindById(ctx, dbPool, *row.Schedule.LastInvocationID)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateAvailable, created.State)
+		require.Equal(t, actor.QueueID, created.QueueID)
+		require.JSONEq(t, fmt.Sprintf(`{"name": "every-minute", "at": "%d"}`, dueAt), string(created.Payload))
+		require.Equal(t, "cron", testhelper.JsonToMap(t, string(created.Metadata))["kind"])
+		require.NotEmpty(t, testhelper.JsonToMap(t, string(created.Metadata))["trace_id"])
+
+		// the schedule isn't due anymore
+		ran, err = runner.RunDue(ctx, now)
+		require.NoError(t, err)
+		require.Zero(t, ran)
+	})
+
+	t.Run("Failing schedule moves to its next run with its error", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+		runner := invocation.NewScheduleRunner(testhelper.Logger(t), dbPool, invocation.ScheduleRunnerConfig{})
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		_, err := dbPool.Exec(ctx, "UPDATE queues SET paused_at = $1, reject_invocations_when_paused = true WHERE id = $2", time.Now().Unix(), actor.QueueID)
+		require.NoError(t, err)
+
+		now := time.Now().Truncate(time.Minute)
+		dueAt := now.Add(-time.Minute).Unix()
+		schedule, err := querier.ScheduleInsert(ctx, dbPool, &dbsqlc.ScheduleInsertParams{
+			Name:           "rejected",
+			ActorId:        actor.ID,
+			CronExpression: "* * * * *",
+			Payload:        []byte(`{}`),
+			Metadata:       []byte(`{}`),
+			Enabled:        true,
+			NextRunAt:      &dueAt,
+		})
+		require.NoError(t, err)
+
+		ran, err := runner.RunDue(ctx, now)
+		require.NoError(t, err)
+		require.Zero(t, ran)
+
+		row, err := querier.ScheduleFindById(ctx, dbPool, schedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, dueAt, lo.FromPtr(row.Schedule.LastRunAt))
+		require.Equal(t, now.Add(time.Minute).Unix(), lo.FromPtr(row.Schedule.NextRunAt))
+		require.Nil(t, row.Schedule.LastInvocationID)
+		require.Contains(t, lo.FromPtr(row.Schedule.LastError), "rejects invocations")
+
+		// the error is cleared by the next successful run
+		_, err = dbPool.Exec(ctx, "UPDATE queues SET paused_at = NULL WHERE id = $1", actor.QueueID)
+		require.NoError(t, err)
+		ran, err = runner.RunDue(ctx, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, 1, ran)
+
+		row, err = querier.ScheduleFindById(ctx, dbPool, schedule.ID)
+		require.NoError(t, err)
+		require.NotNil(t, row.Schedule.LastInvocationID)
+		require.Nil(t, row.Schedule.LastError)
+	})
+
+	t.Run("Disabled and future schedules are not run", func(t *testing.T) {
+		dbPool := testhelper.TestDB(ctx, t)
+		t.Cleanup(dbPool.Close)
+		runner := invocation.NewScheduleRunner(testhelper.Logger(t), dbPool, invocation.ScheduleRunnerConfig{})
+
+		actor := fixture.InsertActor(t, ctx, dbPool, "actor1")
+		now := time.Now()
+		_, err := querier.ScheduleInsert(ctx, dbPool, &dbsqlc.ScheduleInsertParams{
+			Name:           "disabled",
+			ActorId:        actor.ID,
+			CronExpression: "* * * * *",
+			Payload:        []byte(`{}`),
+			Metadata:       []byte(`{}`),
+			Enabled:        false,
+			NextRunAt:      lo.ToPtr(now.Add(-time.Minute).Unix()),
+		})
+		require.NoError(t, err)
+		_, err = querier.ScheduleInsert(ctx, dbPool, &dbsqlc.ScheduleInsertParams{
+			Name:           "future",
+			ActorId:        actor.ID,
+			CronExpression: "* * * * *",
+			Payload:        []byte(`{}`),
+			Metadata:       []byte(`{}`),
+			Enabled:        true,
+			NextRunAt:      lo.ToPtr(now.Add(time.Minute).Unix()),
+		})
+		require.NoError(t, err)
+
+		ran, err := runner.RunDue(ctx, now)
+		require.NoError(t, err)
+		require.Zero(t, ran)
+	})
+}
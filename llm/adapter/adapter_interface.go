@@ -8,4 +8,16 @@ import (
 
 type LLMAdapter interface {
 	GetCompletion(ctx context.Context, request llm.CompletionRequest) (llm.CompletionResult, error)
+	// StreamCompletion generates the completion like GetCompletion, but calls onDelta with every increment of it as
+	// it arrives, and returns the token usage once it's finished. It stops and returns the error of onDelta if any.
+	// The usage consumed until a failure is returned along with the error, so that it can still be accounted.
+	StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error)
+}
+
+type EmbeddingAdapter interface {
+	GetEmbedding(ctx context.Context, request llm.EmbeddingRequest) (llm.EmbeddingResult, error)
+}
+
+type RerankAdapter interface {
+	Rerank(ctx context.Context, request llm.RerankRequest) (llm.RerankResult, error)
 }
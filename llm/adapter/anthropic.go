@@ -1,13 +1,16 @@
 package adapter
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/samber/lo"
 	"gitlab.com/navyx/ai/maos/maos-core/llm"
 	"gitlab.com/navyx/ai/maos/maos-core/util"
 )
@@ -34,34 +37,21 @@ func (a *_AnthropicAdapter) GetCompletion(ctx context.Context, request llm.Compl
 	if err != nil {
 		return llm.CompletionResult{}, err
 	}
-	requestBody, err := util.NewObjectJsonReader(&msgRequest)
-	if err != nil {
-		return llm.CompletionResult{}, err
-	}
-	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.anthropic.com/v1/messages", requestBody)
-	if err != nil {
-		return llm.CompletionResult{}, err
-	}
-	httpRequest.Header.Add("x-api-key", a.apiKey)
-	httpRequest.Header.Add("anthropic-version", "2023-06-01")
-	httpRequest.Header.Add("Content-Type", "application/json")
-
-	httpResponse, err := a.httpClient.Do(httpRequest)
+	httpResponse, err := a.postMessages(ctx, msgRequest)
 	if err != nil {
 		return llm.CompletionResult{}, err
 	}
 	defer httpResponse.Body.Close()
 
-	if statusCodeCategory := httpResponse.StatusCode / 100; statusCodeCategory != 2 && statusCodeCategory != 4 {
-		return llm.CompletionResult{}, fmt.Errorf("unexpected status code %d", httpResponse.StatusCode)
-	}
-
 	responseBody := &MessageResponse{}
 	if err := json.NewDecoder(httpResponse.Body).Decode(responseBody); err != nil {
 		return llm.CompletionResult{}, err
 	}
 	if responseBody.Error != nil {
-		return llm.CompletionResult{}, fmt.Errorf("Anthropic API error: %s", *responseBody.Error)
+		return llm.CompletionResult{}, &StatusError{
+			StatusCode: httpResponse.StatusCode,
+			Err:        fmt.Errorf("Anthropic API error: %s", *responseBody.Error),
+		}
 	}
 	if len(responseBody.Content) == 0 {
 		return llm.CompletionResult{}, fmt.Errorf("no content in response")
@@ -79,6 +69,10 @@ func (a *_AnthropicAdapter) GetCompletion(ctx context.Context, request llm.Compl
 				Role: responseBody.Role,
 			},
 		},
+		Usage: llm.CompletionUsage{
+			InputTokens:  responseBody.Usage.InputTokens,
+			OutputTokens: responseBody.Usage.OutputTokens,
+		},
 	}
 	for _, c := range responseBody.Content {
 		content, err := FromAnthropicContentMessage(c)
@@ -91,6 +85,152 @@ func (a *_AnthropicAdapter) GetCompletion(ctx context.Context, request llm.Compl
 	return result, nil
 }
 
+func (a *_AnthropicAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	msgRequest, err := ToAnthropicMessageRequest(request)
+	if err != nil {
+		return llm.CompletionUsage{}, err
+	}
+	msgRequest.Stream = true
+
+	httpResponse, err := a.postMessages(ctx, msgRequest)
+	if err != nil {
+		return llm.CompletionUsage{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	// errors detected before the stream starts are returned as a plain JSON response
+	if httpResponse.StatusCode/100 != 2 {
+		responseBody := &MessageResponse{}
+		if err := json.NewDecoder(httpResponse.Body).Decode(responseBody); err != nil {
+			return llm.CompletionUsage{}, err
+		}
+		if responseBody.Error != nil {
+			return llm.CompletionUsage{}, &StatusError{
+				StatusCode: httpResponse.StatusCode,
+				Err:        fmt.Errorf("Anthropic API error: %s", *responseBody.Error),
+			}
+		}
+		return llm.CompletionUsage{}, &StatusError{
+			StatusCode: httpResponse.StatusCode,
+			Err:        fmt.Errorf("unexpected status code %d", httpResponse.StatusCode),
+		}
+	}
+
+	return FromAnthropicMessageStream(httpResponse.Body, onDelta)
+}
+
+// postMessages sends the request to the Messages API. The caller must close the body of the returned response.
+func (a *_AnthropicAdapter) postMessages(ctx context.Context, msgRequest MessageRequest) (*http.Response, error) {
+	requestBody, err := util.NewObjectJsonReader(&msgRequest)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.anthropic.com/v1/messages", requestBody)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Add("x-api-key", a.apiKey)
+	httpRequest.Header.Add("anthropic-version", "2023-06-01")
+	httpRequest.Header.Add("Content-Type", "application/json")
+
+	httpResponse, err := a.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCodeCategory := httpResponse.StatusCode / 100; statusCodeCategory != 2 && statusCodeCategory != 4 {
+		httpResponse.Body.Close()
+		return nil, &StatusError{
+			StatusCode: httpResponse.StatusCode,
+			Err:        fmt.Errorf("unexpected status code %d", httpResponse.StatusCode),
+		}
+	}
+	return httpResponse, nil
+}
+
+// FromAnthropicMessageStream reads the Server-Sent Events of a streamed Messages API response, calls onDelta with
+// the text and the tool use parts of every content block delta, and returns the token usage of the message. If the
+// stream fails, the usage reported until then is returned with the error, since those tokens are billed anyway.
+func FromAnthropicMessageStream(body io.Reader, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	usage := llm.CompletionUsage{}
+	// the Messages API content block index covers the text blocks too, while tool calls are indexed on their own
+	toolCallIndexes := make(map[int]int)
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+
+		event := MessageStreamEvent{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+			return usage, err
+		}
+
+		var delta *llm.CompletionDelta
+		switch event.Type {
+		case "message_start":
+			if event.Message != nil {
+				usage.InputTokens = event.Message.Usage.InputTokens
+				usage.OutputTokens = event.Message.Usage.OutputTokens
+			}
+		case "message_delta":
+			// the output tokens of a message delta are cumulative
+			if event.Usage != nil {
+				usage.OutputTokens = event.Usage.OutputTokens
+			}
+		case "content_block_start":
+			if event.ContentBlock == nil || event.ContentBlock.Type != "tool_use" {
+				continue
+			}
+			index := len(toolCallIndexes)
+			toolCallIndexes[event.Index] = index
+			delta = &llm.CompletionDelta{ToolCall: &llm.ToolCallDelta{
+				Index:        index,
+				ID:           lo.FromPtr(event.ContentBlock.Id),
+				FunctionName: lo.FromPtr(event.ContentBlock.Name),
+			}}
+		case "content_block_delta":
+			if event.Delta == nil {
+				return usage, fmt.Errorf("content block delta is nil")
+			}
+			switch event.Delta.Type {
+			case "text_delta":
+				delta = &llm.CompletionDelta{Text: event.Delta.Text}
+			case "input_json_delta":
+				index, ok := toolCallIndexes[event.Index]
+				if !ok {
+					return usage, fmt.Errorf("input JSON delta for unknown content block %d", event.Index)
+				}
+				delta = &llm.CompletionDelta{ToolCall: &llm.ToolCallDelta{
+					Index:     index,
+					Arguments: event.Delta.PartialJson,
+				}}
+			}
+		case "message_stop":
+			return usage, nil
+		case "error":
+			if event.Error == nil {
+				return usage, fmt.Errorf("Anthropic API error")
+			}
+			return usage, fmt.Errorf("Anthropic API error: %s", *event.Error)
+		}
+
+		if delta == nil || (delta.Text == "" && delta.ToolCall == nil) {
+			continue
+		}
+		if err := onDelta(*delta); err != nil {
+			return usage, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return usage, err
+	}
+	return usage, io.ErrUnexpectedEOF
+}
+
 func GetAnthropicLLMModelByModelID(modelID string) (string, error) {
 	model, ok := AnthropicModelMap[modelID]
 	if !ok {
@@ -151,6 +291,20 @@ func ToAnthropicMessageRequest(req llm.CompletionRequest) (MessageRequest, error
 			InputSchema: tool.Parameters,
 		})
 	}
+	if req.ToolChoice != nil {
+		request.ToolChoice = &ToolChoice{}
+		switch req.ToolChoice.Type {
+		case llm.ToolChoiceAuto, llm.ToolChoiceNone:
+			request.ToolChoice.Type = req.ToolChoice.Type
+		case llm.ToolChoiceRequired:
+			request.ToolChoice.Type = "any"
+		case llm.ToolChoiceFunction:
+			request.ToolChoice.Type = "tool"
+			request.ToolChoice.Name = req.ToolChoice.FunctionName
+		default:
+			return MessageRequest{}, fmt.Errorf("invalid tool choice %s", req.ToolChoice.Type)
+		}
+	}
 
 	return request, nil
 }
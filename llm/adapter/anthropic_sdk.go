@@ -3,13 +3,15 @@ package adapter
 import "encoding/json"
 
 type MessageRequest struct {
-	Model         string    `json:"model"`
-	System        *string   `json:"system,omitempty"` // System prompt
-	Messages      []Message `json:"messages"`
-	Tools         []Tool    `json:"tools,omitempty"`
-	MaxTokens     int32     `json:"max_tokens"`
-	StopSequences []string  `json:"stop_sequences,omitempty"`
-	Temperature   *float32  `json:"temperature,omitempty"`
+	Model         string      `json:"model"`
+	System        *string     `json:"system,omitempty"` // System prompt
+	Messages      []Message   `json:"messages"`
+	Tools         []Tool      `json:"tools,omitempty"`
+	ToolChoice    *ToolChoice `json:"tool_choice,omitempty"`
+	MaxTokens     int32       `json:"max_tokens"`
+	StopSequences []string    `json:"stop_sequences,omitempty"`
+	Temperature   *float32    `json:"temperature,omitempty"`
+	Stream        bool        `json:"stream,omitempty"`
 }
 
 type Message struct {
@@ -39,6 +41,11 @@ type Source struct {
 	Data      []byte `json:"data"`       // image data
 }
 
+type ToolChoice struct {
+	Type string `json:"type"`           // "auto", "any", "tool", "none"
+	Name string `json:"name,omitempty"` // Tool name when the type is "tool"
+}
+
 type Tool struct {
 	Name        string          `json:"name"`
 	Description string          `json:"description"`
@@ -66,3 +73,19 @@ type MessageResponseError struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
 }
+
+type MessageStreamEvent struct {
+	Type         string                `json:"type"` // "message_start", "content_block_start", "content_block_delta", "content_block_stop", "message_delta", "message_stop", "ping", "error"
+	Index        int                   `json:"index"`
+	Message      *MessageResponse      `json:"message,omitempty"`       // Message start
+	ContentBlock *Content              `json:"content_block,omitempty"` // Content block start
+	Delta        *MessageStreamDelta   `json:"delta,omitempty"`         // Content block delta
+	Usage        *MessageResponseUsage `json:"usage,omitempty"`         // Message delta
+	Error        *MessageResponseError `json:"error,omitempty"`
+}
+
+type MessageStreamDelta struct {
+	Type        string `json:"type"`         // "text_delta", "input_json_delta"
+	Text        string `json:"text"`         // Text delta
+	PartialJson string `json:"partial_json"` // Input JSON delta
+}
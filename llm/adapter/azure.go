@@ -3,7 +3,9 @@ package adapter
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
@@ -56,10 +58,100 @@ func NewAzureAdapter(endpoint, credential string) (*AzureAdapter, error) {
 func (a *AzureAdapter) GetCompletion(ctx context.Context, request llm.CompletionRequest) (llm.CompletionResult, error) {
 	slog.Info("AzureAdapter Getting completion", "modelID", request.ModelID)
 
+	body, err := ToChatCompletionsOptions(request)
+	if err != nil {
+		return llm.CompletionResult{}, err
+	}
+
+	resp, err := a.client.GetChatCompletions(ctx, body, nil)
+	if err != nil {
+		slog.Error("AzureAdapter Getting completion", "error", err)
+		return llm.CompletionResult{}, err
+	}
+	return FromGetChatCompletionsResponse(resp), nil
+}
+
+func (a *AzureAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	slog.Info("AzureAdapter Streaming completion", "modelID", request.ModelID)
+
+	body, err := ToChatCompletionsOptions(request)
+	if err != nil {
+		return llm.CompletionUsage{}, err
+	}
+
+	resp, err := a.client.GetChatCompletionsStream(ctx, ToChatCompletionsStreamOptions(body), nil)
+	if err != nil {
+		slog.Error("AzureAdapter Streaming completion", "error", err)
+		return llm.CompletionUsage{}, err
+	}
+	defer resp.ChatCompletionsStream.Close()
+
+	// the tool call deltas carry no index, a tool call starts with the delta having its ID and continues until the next
+	toolCalls := 0
+	// the usage is requested by the stream options, and comes in the last chunk without choices
+	var usage *llm.CompletionUsage
+	// output counts the characters streamed so far, to estimate the usage if the stream fails before its last chunk
+	output := 0
+	currentUsage := func() llm.CompletionUsage {
+		if usage != nil {
+			return *usage
+		}
+		return estimateStreamUsage(request, output)
+	}
+	for {
+		chunk, err := resp.ChatCompletionsStream.Read()
+		if errors.Is(err, io.EOF) {
+			return currentUsage(), nil
+		}
+		if err != nil {
+			slog.Error("AzureAdapter Reading completion stream", "error", err)
+			return currentUsage(), err
+		}
+		if chunk.Usage != nil {
+			usage = lo.ToPtr(fromCompletionsUsage(chunk.Usage))
+		}
+
+		for _, choice := range chunk.Choices {
+			if choice.Delta == nil {
+				continue
+			}
+			if choice.Delta.Content != nil && *choice.Delta.Content != "" {
+				output += len(*choice.Delta.Content)
+				if err := onDelta(llm.CompletionDelta{Text: *choice.Delta.Content}); err != nil {
+					return currentUsage(), err
+				}
+			}
+			for _, callInterface := range choice.Delta.ToolCalls {
+				call, _ := callInterface.(*azopenai.ChatCompletionsFunctionToolCall)
+				if call == nil || call.Function == nil {
+					continue
+				}
+				if call.ID != nil && *call.ID != "" {
+					toolCalls++
+				}
+				if toolCalls == 0 {
+					return currentUsage(), fmt.Errorf("tool call delta without ID")
+				}
+				output += len(lo.FromPtr(call.Function.Name)) + len(lo.FromPtr(call.Function.Arguments))
+				err := onDelta(llm.CompletionDelta{ToolCall: &llm.ToolCallDelta{
+					Index:        toolCalls - 1,
+					ID:           lo.FromPtr(call.ID),
+					FunctionName: lo.FromPtr(call.Function.Name),
+					Arguments:    lo.FromPtr(call.Function.Arguments),
+				}})
+				if err != nil {
+					return currentUsage(), err
+				}
+			}
+		}
+	}
+}
+
+func ToChatCompletionsOptions(request llm.CompletionRequest) (azopenai.ChatCompletionsOptions, error) {
 	deploymentName, err := GetAzureDeploymentByModelID(request.ModelID)
 	if err != nil {
 		slog.Error("AzureAdapter GetAzureDeploymentByModelID failed", "error", err)
-		return llm.CompletionResult{}, err
+		return azopenai.ChatCompletionsOptions{}, err
 	}
 
 	body := azopenai.ChatCompletionsOptions{
@@ -73,27 +165,78 @@ func (a *AzureAdapter) GetCompletion(ctx context.Context, request llm.Completion
 	for _, msg := range request.Messages {
 		classifications, err := ToChatRequestMessageClassification(msg)
 		if err != nil {
-			return llm.CompletionResult{}, err
+			return azopenai.ChatCompletionsOptions{}, err
 		}
 		body.Messages = append(body.Messages, classifications...)
 	}
 	for _, tool := range request.Tools {
 		body.Tools = append(body.Tools, &azopenai.ChatCompletionsFunctionToolDefinition{
 			Type: to.Ptr("function"),
-			Function: &azopenai.FunctionDefinition{
+			Function: &azopenai.ChatCompletionsFunctionToolDefinitionFunction{
 				Name:        to.Ptr(tool.Name),
 				Description: to.Ptr(tool.Description),
 				Parameters:  tool.Parameters,
 			},
 		})
 	}
+	if request.ToolChoice != nil {
+		body.ToolChoice, err = toChatCompletionsToolChoice(*request.ToolChoice)
+		if err != nil {
+			return azopenai.ChatCompletionsOptions{}, err
+		}
+	}
+	return body, nil
+}
 
-	resp, err := a.client.GetChatCompletions(ctx, body, nil)
-	if err != nil {
-		slog.Error("AzureAdapter Getting completion", "error", err)
-		return llm.CompletionResult{}, err
+// estimateStreamUsage roughly estimates the usage of a stream which ended without reporting it, at 4 characters per
+// token, so that an interrupted completion still counts against the quotas.
+func estimateStreamUsage(request llm.CompletionRequest, output int) llm.CompletionUsage {
+	input := 0
+	for _, msg := range request.Messages {
+		for _, c := range msg.Content {
+			input += len(c.Text)
+			if c.ToolCall != nil {
+				input += len(c.ToolCall.FunctionName) + len(c.ToolCall.Arguments)
+			}
+			if c.ToolResult != nil {
+				input += len(c.ToolResult.Result)
+			}
+		}
 	}
-	return FromGetChatCompletionsResponse(resp), nil
+	for _, tool := range request.Tools {
+		input += len(tool.Name) + len(tool.Description) + len(tool.Parameters)
+	}
+	return llm.CompletionUsage{InputTokens: int32((input + 3) / 4), OutputTokens: int32((output + 3) / 4)}
+}
+
+// ToChatCompletionsStreamOptions returns the options of a streamed completion, which request the usage in the stream
+func ToChatCompletionsStreamOptions(body azopenai.ChatCompletionsOptions) azopenai.ChatCompletionsStreamOptions {
+	return azopenai.ChatCompletionsStreamOptions{
+		DeploymentName: body.DeploymentName,
+		Messages:       body.Messages,
+		MaxTokens:      body.MaxTokens,
+		Temperature:    body.Temperature,
+		Stop:           body.Stop,
+		Tools:          body.Tools,
+		ToolChoice:     body.ToolChoice,
+		StreamOptions:  &azopenai.ChatCompletionStreamOptions{IncludeUsage: to.Ptr(true)},
+	}
+}
+
+func toChatCompletionsToolChoice(toolChoice llm.ToolChoice) (*azopenai.ChatCompletionsToolChoice, error) {
+	switch toolChoice.Type {
+	case llm.ToolChoiceAuto:
+		return azopenai.ChatCompletionsToolChoiceAuto, nil
+	case llm.ToolChoiceNone:
+		return azopenai.ChatCompletionsToolChoiceNone, nil
+	case llm.ToolChoiceRequired:
+		// the SDK has no value for it, so it's set from its JSON form
+		result := &azopenai.ChatCompletionsToolChoice{}
+		return result, result.UnmarshalJSON([]byte(`"required"`))
+	case llm.ToolChoiceFunction:
+		return azopenai.NewChatCompletionsToolChoice(azopenai.ChatCompletionsToolChoiceFunction{Name: toolChoice.FunctionName}), nil
+	}
+	return nil, fmt.Errorf("invalid tool choice %s", toolChoice.Type)
 }
 
 func GetAzureDeploymentByModelID(modelID string) (string, error) {
@@ -136,7 +279,7 @@ func ToChatRequestMessageClassification(msg llm.Message) ([]azopenai.ChatRequest
 						},
 					}
 				} else {
-					assistantMsg.Content = to.Ptr(content.Text)
+					assistantMsg.Content = azopenai.NewChatRequestAssistantMessageContent(content.Text)
 				}
 
 				return assistantMsg
@@ -148,7 +291,7 @@ func ToChatRequestMessageClassification(msg llm.Message) ([]azopenai.ChatRequest
 			msg.Content,
 			func(content llm.Content, _ int) azopenai.ChatRequestMessageClassification {
 				return &azopenai.ChatRequestSystemMessage{
-					Content: to.Ptr(content.Text),
+					Content: azopenai.NewChatRequestSystemMessageContent(content.Text),
 				}
 			},
 		)
@@ -158,7 +301,7 @@ func ToChatRequestMessageClassification(msg llm.Message) ([]azopenai.ChatRequest
 			msg.Content,
 			func(content llm.Content, _ int) azopenai.ChatRequestMessageClassification {
 				return &azopenai.ChatRequestToolMessage{
-					Content:    to.Ptr(content.ToolResult.Result),
+					Content:    azopenai.NewChatRequestToolMessageContent(content.ToolResult.Result),
 					ToolCallID: to.Ptr(content.ToolResult.ID),
 				}
 			},
@@ -231,7 +374,7 @@ func FromGetChatCompletionsResponse(resp azopenai.GetChatCompletionsResponse) ll
 		return role, contents
 	}
 
-	return llm.CompletionResult{
+	result := llm.CompletionResult{
 		Messages: lo.Map(
 			resp.Choices,
 			func(msg azopenai.ChatChoice, _ int) llm.Message {
@@ -243,4 +386,15 @@ func FromGetChatCompletionsResponse(resp azopenai.GetChatCompletionsResponse) ll
 			},
 		),
 	}
+	if resp.Usage != nil {
+		result.Usage = fromCompletionsUsage(resp.Usage)
+	}
+	return result
+}
+
+func fromCompletionsUsage(usage *azopenai.CompletionsUsage) llm.CompletionUsage {
+	return llm.CompletionUsage{
+		InputTokens:  lo.FromPtr(usage.PromptTokens),
+		OutputTokens: lo.FromPtr(usage.CompletionTokens),
+	}
 }
@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.com/navyx/ai/maos/maos-core/llm"
 	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
 )
@@ -233,3 +236,72 @@ func TestAzureOpenAIWithImageURL(t *testing.T) {
 	}
 	_ = result
 }
+
+func TestAzureOpenAIStreamWithText(t *testing.T) {
+	t.Skip()
+	client, err := adapter.NewAzureAdapter(
+		os.Getenv("AOAI_ENDPOINT"),
+		os.Getenv("AOAI_API_KEY"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := llm.CompletionRequest{
+		ModelID: "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o",
+		Messages: []llm.Message{
+			{
+				Role: "user",
+				Content: []llm.Content{
+					{Text: "Count from 1 to 20."},
+				},
+			},
+		},
+	}
+	text := strings.Builder{}
+	_, err = client.StreamCompletion(context.Background(), req, func(delta llm.CompletionDelta) error {
+		text.WriteString(delta.Text)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text.Len() == 0 {
+		t.Fatal("no text streamed")
+	}
+}
+
+func TestToChatCompletionsToolChoice(t *testing.T) {
+	cases := map[string]llm.ToolChoice{
+		`"auto"`:     {Type: llm.ToolChoiceAuto},
+		`"none"`:     {Type: llm.ToolChoiceNone},
+		`"required"`: {Type: llm.ToolChoiceRequired},
+		`{"type":"function","function":{"name":"add"}}`: {Type: llm.ToolChoiceFunction, FunctionName: "add"},
+	}
+	for expected, toolChoice := range cases {
+		options, err := adapter.ToChatCompletionsOptions(llm.CompletionRequest{
+			ModelID:    "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o",
+			ToolChoice: &toolChoice,
+		})
+		require.NoError(t, err)
+		encoded, err := json.Marshal(options.ToolChoice)
+		require.NoError(t, err)
+		assert.JSONEq(t, expected, string(encoded))
+	}
+}
+
+func TestToChatCompletionsStreamOptions(t *testing.T) {
+	options, err := adapter.ToChatCompletionsOptions(llm.CompletionRequest{
+		ModelID:   "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o",
+		Messages:  []llm.Message{{Role: "user", Content: []llm.Content{{Text: "Hello"}}}},
+		MaxTokens: lo.ToPtr(int32(100)),
+	})
+	require.NoError(t, err)
+
+	streamOptions := adapter.ToChatCompletionsStreamOptions(options)
+	require.NotNil(t, streamOptions.StreamOptions)
+	assert.True(t, *streamOptions.StreamOptions.IncludeUsage)
+	assert.Equal(t, options.DeploymentName, streamOptions.DeploymentName)
+	assert.Equal(t, options.Messages, streamOptions.Messages)
+	assert.Equal(t, int32(100), *streamOptions.MaxTokens)
+}
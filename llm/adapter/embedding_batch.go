@@ -0,0 +1,35 @@
+package adapter
+
+import (
+	"context"
+
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+// BatchedEmbeddingAdapter splits the input of an embedding request into batches of at most BatchSize, and merges
+// the embeddings of them with their indices in the original input.
+type BatchedEmbeddingAdapter struct {
+	EmbeddingAdapter
+	BatchSize int
+}
+
+func (a *BatchedEmbeddingAdapter) GetEmbedding(ctx context.Context, request llm.EmbeddingRequest) (llm.EmbeddingResult, error) {
+	if a.BatchSize <= 0 || len(request.Input) <= a.BatchSize {
+		return a.EmbeddingAdapter.GetEmbedding(ctx, request)
+	}
+
+	result := llm.EmbeddingResult{Data: make([]llm.Embedding, 0, len(request.Input))}
+	for start := 0; start < len(request.Input); start += a.BatchSize {
+		batch := request
+		batch.Input = request.Input[start:min(start+a.BatchSize, len(request.Input))]
+		batchResult, err := a.EmbeddingAdapter.GetEmbedding(ctx, batch)
+		if err != nil {
+			return llm.EmbeddingResult{}, err
+		}
+		for _, embedding := range batchResult.Data {
+			embedding.Index += start
+			result.Data = append(result.Data, embedding)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package adapter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+// fakeEmbeddingAdapter embeds every input into its length, and records the requests it receives.
+type fakeEmbeddingAdapter struct {
+	requests []llm.EmbeddingRequest
+	err      error
+}
+
+func (a *fakeEmbeddingAdapter) GetEmbedding(ctx context.Context, request llm.EmbeddingRequest) (llm.EmbeddingResult, error) {
+	a.requests = append(a.requests, request)
+	if a.err != nil {
+		return llm.EmbeddingResult{}, a.err
+	}
+	return llm.EmbeddingResult{
+		Data: lo.Map(request.Input, func(input string, i int) llm.Embedding {
+			return llm.Embedding{Embedding: []float64{float64(len(input))}, Index: i}
+		}),
+	}, nil
+}
+
+func TestBatchedEmbeddingAdapter(t *testing.T) {
+	ctx := context.Background()
+	request := llm.EmbeddingRequest{
+		ModelID:   "model",
+		Input:     []string{"a", "bb", "ccc", "dddd", "eeeee"},
+		InputType: lo.ToPtr("query"),
+	}
+
+	t.Run("Splits input into batches", func(t *testing.T) {
+		fake := &fakeEmbeddingAdapter{}
+		batched := &adapter.BatchedEmbeddingAdapter{EmbeddingAdapter: fake, BatchSize: 2}
+
+		result, err := batched.GetEmbedding(ctx, request)
+		require.NoError(t, err)
+
+		require.Len(t, fake.requests, 3)
+		assert.Equal(t, []string{"a", "bb"}, fake.requests[0].Input)
+		assert.Equal(t, []string{"ccc", "dddd"}, fake.requests[1].Input)
+		assert.Equal(t, []string{"eeeee"}, fake.requests[2].Input)
+		for _, r := range fake.requests {
+			assert.Equal(t, "model", r.ModelID)
+			assert.Equal(t, "query", *r.InputType)
+		}
+
+		require.Len(t, result.Data, 5)
+		for i, embedding := range result.Data {
+			assert.Equal(t, i, embedding.Index)
+			assert.Equal(t, []float64{float64(i + 1)}, embedding.Embedding)
+		}
+	})
+
+	t.Run("Sends small input at once", func(t *testing.T) {
+		fake := &fakeEmbeddingAdapter{}
+		batched := &adapter.BatchedEmbeddingAdapter{EmbeddingAdapter: fake, BatchSize: 5}
+
+		result, err := batched.GetEmbedding(ctx, request)
+		require.NoError(t, err)
+		require.Len(t, fake.requests, 1)
+		require.Len(t, result.Data, 5)
+	})
+
+	t.Run("Returns error of any batch", func(t *testing.T) {
+		fake := &fakeEmbeddingAdapter{err: fmt.Errorf("rate limited")}
+		batched := &adapter.BatchedEmbeddingAdapter{EmbeddingAdapter: fake, BatchSize: 2}
+
+		_, err := batched.GetEmbedding(ctx, request)
+		require.EqualError(t, err, "rate limited")
+		require.Len(t, fake.requests, 1)
+	})
+}
@@ -1,6 +1,10 @@
 package adapter
 
-import "gitlab.com/navyx/ai/maos/maos-core/llm"
+import (
+	"fmt"
+
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
 
 var embeddingModelList = []llm.EmbeddingModel{
 	{
@@ -74,10 +78,42 @@ func init() {
 	}
 }
 
+// embeddingBatchSizes is the maximum number of inputs each provider accepts in one request.
+var embeddingBatchSizes = map[string]int{
+	PROVIDER_AZURE:  2048,
+	PROVIDER_VOYAGE: 128,
+}
+
 func GetEmbeddingModelList() []llm.EmbeddingModel {
 	return embeddingModelList
 }
+
 func GetEmbeddingModelByID(id string) (llm.EmbeddingModel, bool) {
 	model, ok := embeddingModelMap[id]
 	return model, ok
 }
+
+// CreateEmbeddingAdapter creates an embedding adapter for the given model ID, which splits the input into as many
+// requests as the provider needs.
+// This is a variable so we can inject it for testing
+var CreateEmbeddingAdapter = func(modelId string, credentials AdapterCredentials) (EmbeddingAdapter, error) {
+	model, ok := GetEmbeddingModelByID(modelId)
+	if !ok {
+		return nil, fmt.Errorf("model %s not found", modelId)
+	}
+
+	var adapter EmbeddingAdapter
+	switch model.Provider {
+	case PROVIDER_AZURE:
+		azureAdapter, err := NewAzureEmbeddingAdapter(credentials.AOAIEndpoint, credentials.AOAIAPIKey)
+		if err != nil {
+			return nil, err
+		}
+		adapter = azureAdapter
+	case PROVIDER_VOYAGE:
+		adapter = NewVoyageEmbeddingAdapter()
+	default:
+		return nil, fmt.Errorf("unsupported provider: %s", model.Provider)
+	}
+	return &BatchedEmbeddingAdapter{EmbeddingAdapter: adapter, BatchSize: embeddingBatchSizes[model.Provider]}, nil
+}
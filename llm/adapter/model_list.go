@@ -14,24 +14,32 @@ const (
 
 var modelList = []llm.Model{
 	{
-		ID:       "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o",
-		Provider: PROVIDER_AZURE,
-		Name:     "Azure gpt-4o",
+		ID:         "5a265146-4e05-4cd7-a0a9-9adda7bf7a38-azure-gpt4o",
+		Provider:   PROVIDER_AZURE,
+		Name:       "Azure gpt-4o",
+		InputCost:  5,
+		OutputCost: 15,
 	},
 	{
-		ID:       "bdf5c21b-ad28-4096-9bca-667927b5c742-azure-gpt4",
-		Provider: PROVIDER_AZURE,
-		Name:     "Azure gpt-4",
+		ID:         "bdf5c21b-ad28-4096-9bca-667927b5c742-azure-gpt4",
+		Provider:   PROVIDER_AZURE,
+		Name:       "Azure gpt-4",
+		InputCost:  30,
+		OutputCost: 60,
 	},
 	{
-		ID:       "3db6db92-a091-4944-9f7e-9d43e70218d3-anthropic-claude-3-opus-20240229",
-		Provider: PROVIDER_ANTHROPIC,
-		Name:     "Anthropic Claude 3 Opus 20240229",
+		ID:         "3db6db92-a091-4944-9f7e-9d43e70218d3-anthropic-claude-3-opus-20240229",
+		Provider:   PROVIDER_ANTHROPIC,
+		Name:       "Anthropic Claude 3 Opus 20240229",
+		InputCost:  15,
+		OutputCost: 75,
 	},
 	{
-		ID:       "93d07ee3-c9fb-4f0e-9fc1-df1a7af10b6c-anthropic-claude-3.5-sonnet-20240620",
-		Provider: PROVIDER_ANTHROPIC,
-		Name:     "Anthropic Claude 3.5 Sonnet 20240620",
+		ID:         "93d07ee3-c9fb-4f0e-9fc1-df1a7af10b6c-anthropic-claude-3.5-sonnet-20240620",
+		Provider:   PROVIDER_ANTHROPIC,
+		Name:       "Anthropic Claude 3.5 Sonnet 20240620",
+		InputCost:  3,
+		OutputCost: 15,
 	},
 }
 
@@ -67,12 +75,18 @@ var CreateAdapter = func(modelId string, credentials AdapterCredentials) (LLMAda
 		return nil, fmt.Errorf("model %s not found", modelId)
 	}
 
+	var adapter LLMAdapter
 	switch model.Provider {
 	case PROVIDER_AZURE:
-		return NewAzureAdapter(credentials.AOAIEndpoint, credentials.AOAIAPIKey)
+		azureAdapter, err := NewAzureAdapter(credentials.AOAIEndpoint, credentials.AOAIAPIKey)
+		if err != nil {
+			return nil, err
+		}
+		adapter = azureAdapter
 	case PROVIDER_ANTHROPIC:
-		return NewAnthropicAdapter(credentials.AnthropicAPIKey), nil
+		adapter = NewAnthropicAdapter(credentials.AnthropicAPIKey)
 	default:
 		return nil, fmt.Errorf("unsupported provider: %s", model.Provider)
 	}
+	return &tracedAdapter{LLMAdapter: adapter, provider: model.Provider}, nil
 }
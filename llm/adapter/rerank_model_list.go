@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"fmt"
+
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+var rerankModelList = []llm.RerankModel{
+	{
+		ID:       "0f6a4e0c-9d0b-4a4e-8d8e-2c5b6f1e7a31-voyage-rerank-1",
+		Provider: PROVIDER_VOYAGE,
+		Name:     "rerank-1",
+	},
+	{
+		ID:       "5b2d9c47-3e1f-4f6a-b0c8-7d4e2a9f1c56-voyage-rerank-lite-1",
+		Provider: PROVIDER_VOYAGE,
+		Name:     "rerank-lite-1",
+	},
+}
+
+var rerankModelMap = map[string]llm.RerankModel{}
+
+func init() {
+	rerankModelMap = make(map[string]llm.RerankModel)
+	for _, model := range rerankModelList {
+		rerankModelMap[model.ID] = model
+	}
+}
+
+func GetRerankModelList() []llm.RerankModel {
+	return rerankModelList
+}
+
+func GetRerankModelByID(id string) (llm.RerankModel, bool) {
+	model, ok := rerankModelMap[id]
+	return model, ok
+}
+
+// CreateRerankAdapter creates a rerank adapter for the given model ID
+// This is a variable so we can inject it for testing
+var CreateRerankAdapter = func(modelId string, credentials AdapterCredentials) (RerankAdapter, error) {
+	model, ok := GetRerankModelByID(modelId)
+	if !ok {
+		return nil, fmt.Errorf("model %s not found", modelId)
+	}
+
+	switch model.Provider {
+	case PROVIDER_VOYAGE:
+		return NewVoyageRerankAdapter(), nil
+	default:
+		return nil, fmt.Errorf("unsupported provider: %s", model.Provider)
+	}
+}
@@ -0,0 +1,239 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+// StatusError is an error response of a provider API, which keeps its HTTP status code.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable tells whether a completion error is transient, i.e. the provider is rate limited (429), fails (5xx)
+// or can't be reached, so the completion may succeed when retried or routed to another model.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	statusCode := 0
+	var statusErr *StatusError
+	var responseErr *azcore.ResponseError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &statusErr):
+		statusCode = statusErr.StatusCode
+	case errors.As(err, &responseErr):
+		statusCode = responseErr.StatusCode
+	case errors.As(err, &netErr):
+		return true
+	}
+	return statusCode == 429 || statusCode/100 == 5
+}
+
+// Route is a model of a routing policy, which is tried first in proportion to its weight.
+type Route struct {
+	ModelID string
+	Weight  int
+}
+
+// RoutingPolicy tells which models a completion is routed to, and how it's retried when they fail.
+type RoutingPolicy struct {
+	Routes []Route
+	// Fallbacks are tried in order after all the routes fail.
+	Fallbacks []string
+	// MaxRetries is the number of times a model is retried on a retryable error before the next model is tried.
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// SingleModelPolicy routes every completion to the model without retries.
+func SingleModelPolicy(modelId string) RoutingPolicy {
+	return RoutingPolicy{Routes: []Route{{ModelID: modelId, Weight: 1}}}
+}
+
+type routedModel struct {
+	model   llm.Model
+	adapter LLMAdapter
+	weight  int
+	route   bool
+}
+
+// RoutedAdapter generates a completion with the models of a routing policy. A model is retried with exponential
+// backoff while its errors are retryable, and the next model is tried once its retries are exhausted. Any other
+// error is returned as is, as well as the error of a stream which has already sent some deltas.
+// It's created for every completion, since it keeps the model of the last attempt.
+type RoutedAdapter struct {
+	policy RoutingPolicy
+	models []routedModel
+	// model is the model of the last attempt
+	model llm.Model
+
+	random func() float64
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewRoutedAdapter creates the adapters of the models of the policy. The models whose adapter can't be created are
+// skipped, and an error is returned only if none of them can be created.
+func NewRoutedAdapter(policy RoutingPolicy, credentials AdapterCredentials) (*RoutedAdapter, error) {
+	a := &RoutedAdapter{policy: policy, random: rand.Float64, sleep: sleepContext}
+
+	var firstErr error
+	add := func(modelId string, weight int, route bool) {
+		if slices.ContainsFunc(a.models, func(m routedModel) bool { return m.model.ID == modelId }) {
+			return
+		}
+		adapter, err := CreateAdapter(modelId, credentials)
+		if err != nil {
+			slog.Warn("Cannot create adapter of routed model", "model", modelId, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		model, ok := GetModelByID(modelId)
+		if !ok {
+			model = llm.Model{ID: modelId}
+		}
+		a.models = append(a.models, routedModel{model: model, adapter: adapter, weight: weight, route: route})
+	}
+	for _, route := range policy.Routes {
+		add(route.ModelID, route.Weight, true)
+	}
+	for _, modelId := range policy.Fallbacks {
+		add(modelId, 0, false)
+	}
+
+	if len(a.models) == 0 {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("no model to route")
+		}
+		return nil, firstErr
+	}
+	a.model = a.models[0].model
+	return a, nil
+}
+
+// Model returns the model of the last attempt, which generated the completion if it succeeded.
+func (a *RoutedAdapter) Model() llm.Model {
+	return a.model
+}
+
+func (a *RoutedAdapter) GetCompletion(ctx context.Context, request llm.CompletionRequest) (llm.CompletionResult, error) {
+	var result llm.CompletionResult
+	err := a.try(ctx, func(adapter LLMAdapter, modelId string) (bool, error) {
+		request.ModelID = modelId
+		var err error
+		result, err = adapter.GetCompletion(ctx, request)
+		return IsRetryable(err), err
+	})
+	return result, err
+}
+
+func (a *RoutedAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	var usage llm.CompletionUsage
+	err := a.try(ctx, func(adapter LLMAdapter, modelId string) (bool, error) {
+		request.ModelID = modelId
+		started := false
+		var err error
+		usage, err = adapter.StreamCompletion(ctx, request, func(delta llm.CompletionDelta) error {
+			started = true
+			return onDelta(delta)
+		})
+		return !started && IsRetryable(err), err
+	})
+	return usage, err
+}
+
+// try calls attempt with the models in routing order, until it succeeds or fails with an error which isn't retryable.
+func (a *RoutedAdapter) try(ctx context.Context, attempt func(adapter LLMAdapter, modelId string) (bool, error)) error {
+	var err error
+	for _, model := range a.order() {
+		a.model = model.model
+		for retry := 0; ; retry++ {
+			var retryable bool
+			if retryable, err = attempt(model.adapter, model.model.ID); err == nil || !retryable {
+				return err
+			}
+			if retry >= a.policy.MaxRetries {
+				break
+			}
+
+			backoff := a.backoff(retry)
+			slog.Warn("Retrying completion", "model", model.model.ID, "retry", retry+1, "backoff", backoff, "error", err)
+			if err := a.sleep(ctx, backoff); err != nil {
+				return err
+			}
+		}
+		slog.Warn("Routing completion to the next model", "model", model.model.ID, "error", err)
+	}
+	return err
+}
+
+// order returns the routes shuffled by weight, so that every route comes first in proportion to its weight,
+// followed by the fallbacks.
+func (a *RoutedAdapter) order() []routedModel {
+	keys := make(map[string]float64, len(a.models))
+	for _, model := range a.models {
+		if model.route && model.weight > 0 {
+			// weighted random sampling without replacement by Efraimidis and Spirakis
+			keys[model.model.ID] = math.Pow(a.random(), 1/float64(model.weight))
+		}
+	}
+
+	models := slices.Clone(a.models)
+	slices.SortStableFunc(models, func(x, y routedModel) int {
+		if x.route != y.route {
+			return lo.Ternary(x.route, -1, 1)
+		}
+		switch kx, ky := keys[x.model.ID], keys[y.model.ID]; {
+		case kx > ky:
+			return -1
+		case kx < ky:
+			return 1
+		}
+		return 0
+	})
+	return models
+}
+
+func (a *RoutedAdapter) backoff(retry int) time.Duration {
+	backoff := a.policy.InitialBackoff << retry
+	if backoff > a.policy.MaxBackoff || backoff < 0 {
+		return a.policy.MaxBackoff
+	}
+	return backoff
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
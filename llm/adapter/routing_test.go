@@ -0,0 +1,168 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+)
+
+// scriptedAdapter fails with its errors in turn, and succeeds once they run out.
+type scriptedAdapter struct {
+	modelId string
+	errs    []error
+	calls   *[]string
+}
+
+func (a *scriptedAdapter) next(request llm.CompletionRequest) error {
+	*a.calls = append(*a.calls, request.ModelID)
+	if len(a.errs) == 0 {
+		return nil
+	}
+	err := a.errs[0]
+	a.errs = a.errs[1:]
+	return err
+}
+
+func (a *scriptedAdapter) GetCompletion(ctx context.Context, request llm.CompletionRequest) (llm.CompletionResult, error) {
+	if err := a.next(request); err != nil {
+		return llm.CompletionResult{}, err
+	}
+	return llm.CompletionResult{Messages: []llm.Message{{Role: "assistant", Content: []llm.Content{{Text: a.modelId}}}}}, nil
+}
+
+func (a *scriptedAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	if err := onDelta(llm.CompletionDelta{Text: a.modelId}); err != nil {
+		return llm.CompletionUsage{}, err
+	}
+	return llm.CompletionUsage{}, a.next(request)
+}
+
+// newScriptedRoutedAdapter creates a routed adapter whose models fail with the scripted errors, and records
+// the backoffs it sleeps.
+func newScriptedRoutedAdapter(t *testing.T, policy RoutingPolicy, errs map[string][]error) (*RoutedAdapter, *[]string, *[]time.Duration) {
+	calls := &[]string{}
+	originalCreateAdapter := CreateAdapter
+	CreateAdapter = func(modelId string, credentials AdapterCredentials) (LLMAdapter, error) {
+		if modelId == "unknown" {
+			return nil, fmt.Errorf("model %s not found", modelId)
+		}
+		return &scriptedAdapter{modelId: modelId, errs: errs[modelId], calls: calls}, nil
+	}
+	defer func() { CreateAdapter = originalCreateAdapter }()
+
+	routed, err := NewRoutedAdapter(policy, AdapterCredentials{})
+	require.NoError(t, err)
+	backoffs := &[]time.Duration{}
+	routed.sleep = func(ctx context.Context, d time.Duration) error {
+		*backoffs = append(*backoffs, d)
+		return nil
+	}
+	return routed, calls, backoffs
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(&StatusError{StatusCode: 429, Err: fmt.Errorf("rate limited")}))
+	assert.True(t, IsRetryable(fmt.Errorf("wrapped: %w", &StatusError{StatusCode: 503, Err: fmt.Errorf("unavailable")})))
+	assert.False(t, IsRetryable(&StatusError{StatusCode: 400, Err: fmt.Errorf("bad request")}))
+	assert.False(t, IsRetryable(fmt.Errorf("invalid request")))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(nil))
+}
+
+func TestRoutedAdapterFailover(t *testing.T) {
+	rateLimited := &StatusError{StatusCode: 429, Err: fmt.Errorf("rate limited")}
+	policy := RoutingPolicy{
+		Routes:         []Route{{ModelID: "primary", Weight: 1}},
+		Fallbacks:      []string{"primary", "unknown", "fallback"},
+		MaxRetries:     2,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     800 * time.Millisecond,
+	}
+	routed, calls, backoffs := newScriptedRoutedAdapter(t, policy, map[string][]error{
+		"primary": {rateLimited, rateLimited, rateLimited},
+	})
+
+	result, err := routed.GetCompletion(context.Background(), llm.CompletionRequest{ModelID: "alias"})
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", result.Messages[0].Content[0].Text)
+	assert.Equal(t, []string{"primary", "primary", "primary", "fallback"}, *calls)
+	assert.Equal(t, []time.Duration{500 * time.Millisecond, 800 * time.Millisecond}, *backoffs)
+	assert.Equal(t, "fallback", routed.Model().ID)
+}
+
+func TestRoutedAdapterErrors(t *testing.T) {
+	t.Run("Error which isn't retryable", func(t *testing.T) {
+		routed, calls, _ := newScriptedRoutedAdapter(t, RoutingPolicy{
+			Routes:     []Route{{ModelID: "primary", Weight: 1}},
+			Fallbacks:  []string{"fallback"},
+			MaxRetries: 2,
+		}, map[string][]error{
+			"primary": {&StatusError{StatusCode: 400, Err: fmt.Errorf("bad request")}},
+		})
+
+		_, err := routed.GetCompletion(context.Background(), llm.CompletionRequest{})
+		require.EqualError(t, err, "bad request")
+		assert.Equal(t, []string{"primary"}, *calls)
+	})
+
+	t.Run("All models fail", func(t *testing.T) {
+		unavailable := &StatusError{StatusCode: 503, Err: fmt.Errorf("unavailable")}
+		routed, calls, _ := newScriptedRoutedAdapter(t, RoutingPolicy{
+			Routes:    []Route{{ModelID: "primary", Weight: 1}},
+			Fallbacks: []string{"fallback"},
+		}, map[string][]error{
+			"primary":  {unavailable},
+			"fallback": {unavailable},
+		})
+
+		_, err := routed.GetCompletion(context.Background(), llm.CompletionRequest{})
+		require.ErrorIs(t, err, unavailable)
+		assert.Equal(t, []string{"primary", "fallback"}, *calls)
+	})
+
+	t.Run("Stream which has started", func(t *testing.T) {
+		routed, calls, _ := newScriptedRoutedAdapter(t, RoutingPolicy{
+			Routes:     []Route{{ModelID: "primary", Weight: 1}},
+			Fallbacks:  []string{"fallback"},
+			MaxRetries: 2,
+		}, map[string][]error{
+			"primary": {&StatusError{StatusCode: 500, Err: fmt.Errorf("server error")}},
+		})
+
+		var texts []string
+		_, err := routed.StreamCompletion(context.Background(), llm.CompletionRequest{}, func(delta llm.CompletionDelta) error {
+			texts = append(texts, delta.Text)
+			return nil
+		})
+		require.EqualError(t, err, "server error")
+		assert.Equal(t, []string{"primary"}, *calls)
+		assert.Equal(t, []string{"primary"}, texts)
+	})
+
+	t.Run("No model", func(t *testing.T) {
+		_, err := NewRoutedAdapter(SingleModelPolicy("unknown"), AdapterCredentials{})
+		require.Error(t, err)
+	})
+}
+
+func TestRoutedAdapterOrder(t *testing.T) {
+	routed, _, _ := newScriptedRoutedAdapter(t, RoutingPolicy{
+		Routes:    []Route{{ModelID: "a", Weight: 3}, {ModelID: "b", Weight: 1}, {ModelID: "standby", Weight: 0}},
+		Fallbacks: []string{"fallback"},
+	}, nil)
+
+	counts := map[string]int{}
+	for i := 0; i < 4000; i++ {
+		order := lo.Map(routed.order(), func(m routedModel, _ int) string { return m.model.ID })
+		require.ElementsMatch(t, []string{"a", "b"}, order[:2])
+		require.Equal(t, []string{"standby", "fallback"}, order[2:])
+		counts[order[0]]++
+	}
+	assert.InDelta(t, 3000, counts["a"], 200)
+}
@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"context"
+
+	"gitlab.com/navyx/ai/maos/maos-core/internal/tracing"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedAdapter records a client span for every completion of the wrapped adapter.
+type tracedAdapter struct {
+	LLMAdapter
+	provider string
+}
+
+func (a *tracedAdapter) GetCompletion(ctx context.Context, request llm.CompletionRequest) (llm.CompletionResult, error) {
+	ctx, span := a.startSpan(ctx, request, false)
+	defer span.End()
+
+	result, err := a.LLMAdapter.GetCompletion(ctx, request)
+	recordSpanError(span, err)
+	return result, err
+}
+
+func (a *tracedAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	ctx, span := a.startSpan(ctx, request, true)
+	defer span.End()
+
+	usage, err := a.LLMAdapter.StreamCompletion(ctx, request, onDelta)
+	recordSpanError(span, err)
+	return usage, err
+}
+
+func (a *tracedAdapter) startSpan(ctx context.Context, request llm.CompletionRequest, stream bool) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "llm.completion",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("gen_ai.system", a.provider),
+			attribute.String("gen_ai.request.model", request.ModelID),
+			attribute.Int("gen_ai.request.messages", len(request.Messages)),
+			attribute.Bool("gen_ai.request.stream", stream),
+		),
+	)
+}
+
+func recordSpanError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -2,8 +2,11 @@ package adapter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/samber/lo"
@@ -131,3 +134,52 @@ func TestVoyageEmbeddingAdapter_GetEmbedding_With_Different_Type(t *testing.T) {
 
 	fmt.Printf("docResult: %v, queryResult: %v", docResult, queryResult)
 }
+
+func TestVoyageRerankAdapter_Rerank(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rerank" || r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("unexpected request %s with authorization %q", r.URL.Path, r.Header.Get("Authorization"))
+		}
+
+		var request VoyageRerankRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if request.Model != "rerank-lite-1" || request.Query != "What is the capital of Taiwan?" ||
+			len(request.Documents) != 3 || request.TopK == nil || *request.TopK != 2 || !request.ReturnDocuments {
+			t.Errorf("unexpected request %+v", request)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"object":"list","data":[` +
+			`{"index":1,"relevance_score":0.92,"document":"The capital of Taiwan is Taipei."},` +
+			`{"index":0,"relevance_score":0.31,"document":"The capital of Japan is Tokyo."}` +
+			`],"model":"rerank-lite-1","usage":{"total_tokens":42}}`))
+	}))
+	defer server.Close()
+	t.Setenv("VOYAGE_ENDPOINT", server.URL)
+
+	adapter := &VoyageRerankAdapter{httpClient: server.Client(), apiKey: "test-key"}
+	result, err := adapter.Rerank(context.Background(), llm.RerankRequest{
+		ModelID:         "5b2d9c47-3e1f-4f6a-b0c8-7d4e2a9f1c56-voyage-rerank-lite-1",
+		Query:           "What is the capital of Taiwan?",
+		Documents:       []string{"The capital of Japan is Tokyo.", "The capital of Taiwan is Taipei.", "The capital of Korea is Seoul."},
+		TopK:            lo.ToPtr(2),
+		ReturnDocuments: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []llm.RerankedDocument{
+		{Index: 1, Score: 0.92, Text: "The capital of Taiwan is Taipei."},
+		{Index: 0, Score: 0.31, Text: "The capital of Japan is Tokyo."},
+	}
+	if fmt.Sprint(result.Data) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, result.Data)
+	}
+
+	if _, err := adapter.Rerank(context.Background(), llm.RerankRequest{ModelID: "unknown"}); err == nil {
+		t.Fatalf("expected error for unknown model")
+	}
+}
@@ -41,42 +41,8 @@ func (a *VoyageEmbeddingAdapter) GetEmbedding(ctx context.Context, request llm.E
 		return llm.EmbeddingResult{}, err
 	}
 
-	body, err := util.NewObjectJsonReader(voyageRequest)
-	if err != nil {
-		return llm.EmbeddingResult{}, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", os.Getenv("VOYAGE_ENDPOINT")+"/embeddings", body)
-	if err != nil {
-		return llm.EmbeddingResult{}, err
-	}
-
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.apiKey))
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := a.httpClient.Do(req)
-	if err != nil {
-		return llm.EmbeddingResult{}, err
-	}
-	defer resp.Body.Close()
-
-	status := resp.StatusCode
-	if status != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return llm.EmbeddingResult{}, fmt.Errorf("status code %d", status)
-		}
-		return llm.EmbeddingResult{}, fmt.Errorf("status code %d, body %s", status, string(body))
-	}
-
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return llm.EmbeddingResult{}, err
-	}
-
 	var response VoyageEmbeddingResponse
-	err = json.Unmarshal(responseBody, &response)
-	if err != nil {
+	if err := postVoyage(ctx, a.httpClient, a.apiKey, "/embeddings", voyageRequest, &response); err != nil {
 		return llm.EmbeddingResult{}, err
 	}
 
@@ -113,3 +79,100 @@ func ToVoyageEmbeddingRequest(request llm.EmbeddingRequest) (VoyageEmbeddingRequ
 		InputType: request.InputType,
 	}, nil
 }
+
+// VoyageRerankModelMap is a map of rerank model ID to Voyage model name.
+var VoyageRerankModelMap = map[string]string{
+	"0f6a4e0c-9d0b-4a4e-8d8e-2c5b6f1e7a31-voyage-rerank-1":      "rerank-1",
+	"5b2d9c47-3e1f-4f6a-b0c8-7d4e2a9f1c56-voyage-rerank-lite-1": "rerank-lite-1",
+}
+
+type VoyageRerankAdapter struct {
+	httpClient *http.Client
+	apiKey     string
+}
+
+func NewVoyageRerankAdapter() *VoyageRerankAdapter {
+	return &VoyageRerankAdapter{
+		httpClient: &http.Client{},
+		apiKey:     os.Getenv("VOYAGE_API_KEY"),
+	}
+}
+
+func (a *VoyageRerankAdapter) Rerank(ctx context.Context, request llm.RerankRequest) (llm.RerankResult, error) {
+	voyageRequest, err := ToVoyageRerankRequest(request)
+	if err != nil {
+		return llm.RerankResult{}, err
+	}
+
+	var response VoyageRerankResponse
+	if err := postVoyage(ctx, a.httpClient, a.apiKey, "/rerank", voyageRequest, &response); err != nil {
+		return llm.RerankResult{}, err
+	}
+
+	return FromVoyageRerankResponse(response), nil
+}
+
+func ToVoyageRerankRequest(request llm.RerankRequest) (VoyageRerankRequest, error) {
+	model, ok := VoyageRerankModelMap[request.ModelID]
+	if !ok {
+		return VoyageRerankRequest{}, fmt.Errorf("model not found for model ID %s", request.ModelID)
+	}
+
+	return VoyageRerankRequest{
+		Model:           model,
+		Query:           request.Query,
+		Documents:       request.Documents,
+		TopK:            request.TopK,
+		ReturnDocuments: request.ReturnDocuments,
+	}, nil
+}
+
+func FromVoyageRerankResponse(response VoyageRerankResponse) llm.RerankResult {
+	return llm.RerankResult{
+		Data: lo.Map(response.Data, func(item VoyageRerankData, _ int) llm.RerankedDocument {
+			return llm.RerankedDocument{
+				Index: item.Index,
+				Score: item.RelevanceScore,
+				Text:  item.Document,
+			}
+		}),
+	}
+}
+
+// postVoyage posts the request to the given path of the Voyage API, and decodes the response into response.
+func postVoyage(ctx context.Context, httpClient *http.Client, apiKey string, path string, request interface{}, response interface{}) error {
+	body, err := util.NewObjectJsonReader(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", os.Getenv("VOYAGE_ENDPOINT")+path, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	status := resp.StatusCode
+	if status != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("status code %d", status)
+		}
+		return fmt.Errorf("status code %d, body %s", status, string(body))
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBody, response)
+}
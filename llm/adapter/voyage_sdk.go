@@ -20,3 +20,26 @@ type VoyageEmbeddingData struct {
 	Embedding []float64 `json:"embedding"`
 	Index     int       `json:"index"`
 }
+
+type VoyageRerankRequest struct {
+	Query           string   `json:"query"`
+	Documents       []string `json:"documents"`
+	Model           string   `json:"model"`
+	TopK            *int     `json:"top_k,omitempty"`
+	ReturnDocuments bool     `json:"return_documents"`
+}
+
+type VoyageRerankResponse struct {
+	Object string             `json:"object"`
+	Data   []VoyageRerankData `json:"data"`
+	Model  string             `json:"model"`
+	Usage  struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+type VoyageRerankData struct {
+	Index          int     `json:"index"`
+	RelevanceScore float64 `json:"relevance_score"`
+	Document       string  `json:"document,omitempty"`
+}
@@ -6,6 +6,14 @@ type Model struct {
 	ID       string `json:"id"`
 	Provider string `json:"provider"`
 	Name     string `json:"name"`
+	// InputCost and OutputCost are the prices in USD of a million input and output tokens
+	InputCost  float64 `json:"input_cost"`
+	OutputCost float64 `json:"output_cost"`
+}
+
+// Cost returns the price in USD of the given token usage of the model
+func (m Model) Cost(usage CompletionUsage) float64 {
+	return (float64(usage.InputTokens)*m.InputCost + float64(usage.OutputTokens)*m.OutputCost) / 1_000_000
 }
 
 type EmbeddingModel struct {
@@ -15,6 +23,12 @@ type EmbeddingModel struct {
 	Dimension int    `json:"dimension"`
 }
 
+type RerankModel struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider"`
+	Name     string `json:"name"`
+}
+
 // ModelListResponse represents the response for the model list endpoint
 type ModelListResponse struct {
 	Data []Model `json:"data"`
@@ -60,10 +74,49 @@ type CompletionRequest struct {
 	StopSequences []string  `json:"stop_sequences,omitempty"`
 	Temperature   *float32  `json:"temperature,omitempty"`
 	MaxTokens     *int32    `json:"max_tokens,omitempty"`
+	// ToolChoice tells whether the model must call the tools. The model decides by itself if it's nil.
+	ToolChoice *ToolChoice `json:"tool_choice,omitempty"`
 }
 
 type CompletionResult struct {
-	Messages []Message `json:"messages"`
+	Messages []Message       `json:"messages"`
+	Usage    CompletionUsage `json:"usage"`
+}
+
+// CompletionUsage represents the number of tokens consumed by a completion
+type CompletionUsage struct {
+	InputTokens  int32 `json:"input_tokens"`
+	OutputTokens int32 `json:"output_tokens"`
+}
+
+// CompletionDelta represents an increment of a streamed completion, either some text or a part of a tool call
+type CompletionDelta struct {
+	Text     string         `json:"text,omitempty"`
+	ToolCall *ToolCallDelta `json:"tool_call,omitempty"`
+}
+
+// ToolCallDelta represents a part of a streamed tool call. The ID and function name come with the first part of a
+// call, and its JSON arguments are split across the following parts.
+type ToolCallDelta struct {
+	Index        int    `json:"index"`
+	ID           string `json:"id,omitempty"`
+	FunctionName string `json:"name,omitempty"`
+	Arguments    string `json:"arguments,omitempty"`
+}
+
+const (
+	ToolChoiceAuto     = "auto"
+	ToolChoiceNone     = "none"
+	ToolChoiceRequired = "required"
+	ToolChoiceFunction = "function"
+)
+
+// ToolChoice represents how the model calls the tools: it decides by itself when the type is ToolChoiceAuto, never
+// calls them when ToolChoiceNone, calls any of them when ToolChoiceRequired, and calls the tool of FunctionName
+// when ToolChoiceFunction.
+type ToolChoice struct {
+	Type         string `json:"type"`
+	FunctionName string `json:"name,omitempty"`
 }
 
 type Tool struct {
@@ -89,3 +142,26 @@ type Embedding struct {
 	Embedding []float64 `json:"embedding"`
 	Index     int       `json:"index"`
 }
+
+// RerankRequest represents the request body for the rerank endpoint
+type RerankRequest struct {
+	ModelID   string   `json:"model_id"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+	// TopK limits the result to the most relevant documents. All documents are returned if it's nil.
+	TopK *int `json:"top_k"`
+	// ReturnDocuments tells whether the text of the documents is included in the result.
+	ReturnDocuments bool `json:"return_documents"`
+}
+
+// RerankResult represents the response body for the rerank endpoint, in descending order of relevance
+type RerankResult struct {
+	Data []RerankedDocument `json:"data"`
+}
+
+// RerankedDocument represents the relevance of a document to the query
+type RerankedDocument struct {
+	Index int     `json:"index"`
+	Score float64 `json:"score"`
+	Text  string  `json:"text,omitempty"`
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+)
+
+// the generated response types are named after their status code, e.g. CreateInvocationAsync201JSONResponse
+var responseStatusPattern = regexp.MustCompile(`(\d{3})\w*Response$`)
+
+// NewMetricsMiddleware counts the API requests and records their latency by operation ID. It should be the last of
+// the middlewares so that it wraps the others, including the auth middleware which writes its errors directly.
+func NewMetricsMiddleware() api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w}
+
+			response, err := f(ctx, recorder, r, args)
+
+			metrics.HTTPRequests.WithLabelValues(operationID, responseStatus(recorder, response, err)).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(operationID).Observe(time.Since(start).Seconds())
+			return response, err
+		}
+	}
+}
+
+// responseStatus returns the status code written by the middlewares if any, otherwise the one of the response
+// object returned by the handler.
+func responseStatus(recorder *statusRecorder, response interface{}, err error) string {
+	if recorder.status != 0 {
+		return fmt.Sprint(recorder.status)
+	}
+	if err != nil {
+		return "500"
+	}
+	if match := responseStatusPattern.FindStringSubmatch(fmt.Sprintf("%T", response)); match != nil {
+		return match[1]
+	}
+	return "unknown"
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
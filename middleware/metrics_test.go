@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
+)
+
+func TestNewMetricsMiddleware(t *testing.T) {
+	run := func(operationID string, handler api.StrictHandlerFunc) {
+		req := httptest.NewRequest("GET", "/test", nil)
+		w := httptest.NewRecorder()
+		NewMetricsMiddleware()(handler, operationID)(context.Background(), w, req, nil)
+	}
+
+	t.Run("Status of the response object", func(t *testing.T) {
+		run("MetricsTestResponse", func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			return api.CreateInvocationAsync201JSONResponse{}, nil
+		})
+		run("MetricsTestResponse", func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			return api.CreateInvocationAsync401Response{}, nil
+		})
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("MetricsTestResponse", "201")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("MetricsTestResponse", "401")))
+	})
+
+	t.Run("Status written by a middleware", func(t *testing.T) {
+		run("MetricsTestWritten", func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			http.Error(w, `{"error":"Invalid token"}`, http.StatusUnauthorized)
+			return nil, nil
+		})
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("MetricsTestWritten", "401")))
+	})
+
+	t.Run("Handler error", func(t *testing.T) {
+		run("MetricsTestError", func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			return nil, errors.New("boom")
+		})
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("MetricsTestError", "500")))
+	})
+}
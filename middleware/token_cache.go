@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/ristretto"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/metrics"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -38,12 +39,15 @@ func NewApiTokenCache(fetcher TokenFetcher, ttl time.Duration) *ApiTokenCache {
 func (c *ApiTokenCache) GetToken(ctx context.Context, apiToken string) *Token {
 	value, found := c.cache.Get(apiToken)
 	if found {
+		metrics.TokenCacheRequests.WithLabelValues("hit").Inc()
 		if value == nil {
 			return nil
 		}
 		return value.(*Token)
 	}
 
+	metrics.TokenCacheRequests.WithLabelValues("miss").Inc()
+
 	// singleflight to prevent thundering herd problem
 	fetched, err, _ := c.group.Do(apiToken, func() (interface{}, error) {
 		token, err := c.fetcher(ctx, apiToken)
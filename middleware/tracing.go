@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracingMiddleware records a server span named after the operation ID for every API request, continuing the
+// trace of its W3C traceparent header if any. It should be the last of the middlewares so that the span covers the
+// others.
+func NewTracingMiddleware() api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			ctx = tracing.Propagator.Extract(ctx, propagation.HeaderCarrier(r.Header))
+			ctx, span := tracing.Tracer().Start(ctx, operationID,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(r.Method),
+					semconv.URLPath(r.URL.Path),
+				),
+			)
+			defer span.End()
+
+			recorder := &statusRecorder{ResponseWriter: w}
+			response, err := f(ctx, recorder, r, args)
+
+			if code, convErr := strconv.Atoi(responseStatus(recorder, response, err)); convErr == nil {
+				span.SetAttributes(semconv.HTTPResponseStatusCode(code))
+				if code >= http.StatusInternalServerError {
+					span.SetStatus(codes.Error, http.StatusText(code))
+				}
+			}
+			if err != nil {
+				span.RecordError(err)
+			}
+			return response, err
+		}
+	}
+}
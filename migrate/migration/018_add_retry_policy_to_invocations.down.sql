@@ -0,0 +1,12 @@
+ALTER TABLE invocations
+  DROP CONSTRAINT IF EXISTS retry_backoff_seconds_in_range,
+  DROP CONSTRAINT IF EXISTS max_attempts_in_range,
+  DROP COLUMN IF EXISTS scheduled_at,
+  DROP COLUMN IF EXISTS retry_backoff_seconds,
+  DROP COLUMN IF EXISTS max_attempts;
+
+ALTER TABLE actors
+  DROP CONSTRAINT IF EXISTS retry_backoff_seconds_in_range,
+  DROP CONSTRAINT IF EXISTS max_attempts_in_range,
+  DROP COLUMN IF EXISTS retry_backoff_seconds,
+  DROP COLUMN IF EXISTS max_attempts;
@@ -0,0 +1,12 @@
+ALTER TABLE actors
+  ADD COLUMN IF NOT EXISTS max_attempts smallint NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS retry_backoff_seconds integer NOT NULL DEFAULT 10,
+  ADD CONSTRAINT max_attempts_in_range CHECK (max_attempts >= 1 AND max_attempts <= 25),
+  ADD CONSTRAINT retry_backoff_seconds_in_range CHECK (retry_backoff_seconds >= 0 AND retry_backoff_seconds <= 86400);
+
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS max_attempts smallint NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS retry_backoff_seconds integer NOT NULL DEFAULT 10,
+  ADD COLUMN IF NOT EXISTS scheduled_at bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  ADD CONSTRAINT max_attempts_in_range CHECK (max_attempts >= 1 AND max_attempts <= 25),
+  ADD CONSTRAINT retry_backoff_seconds_in_range CHECK (retry_backoff_seconds >= 0 AND retry_backoff_seconds <= 86400);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS invocations_running_lease_expires_at_index;
+
+ALTER TABLE invocations
+  DROP COLUMN IF EXISTS lease_expires_at;
+
+ALTER TABLE queues
+  DROP CONSTRAINT IF EXISTS lease_seconds_in_range,
+  DROP COLUMN IF EXISTS lease_seconds;
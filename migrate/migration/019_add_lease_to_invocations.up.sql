@@ -0,0 +1,9 @@
+ALTER TABLE queues
+  -- NULL leaves the invocations of the queue running until they are finalized
+  ADD COLUMN IF NOT EXISTS lease_seconds integer,
+  ADD CONSTRAINT lease_seconds_in_range CHECK (lease_seconds >= 10 AND lease_seconds <= 86400);
+
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS lease_expires_at bigint;
+
+CREATE INDEX IF NOT EXISTS invocations_running_lease_expires_at_index ON invocations USING btree(lease_expires_at) WHERE state = 'running'::invocation_state;
@@ -0,0 +1,2 @@
+ALTER TABLE invocations
+  DROP COLUMN IF EXISTS progress;
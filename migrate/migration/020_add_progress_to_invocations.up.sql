@@ -0,0 +1,2 @@
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS progress jsonb;
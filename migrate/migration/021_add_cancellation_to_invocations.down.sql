@@ -0,0 +1,3 @@
+ALTER TABLE invocations
+  DROP COLUMN IF EXISTS cancel_requested_at,
+  DROP COLUMN IF EXISTS created_by;
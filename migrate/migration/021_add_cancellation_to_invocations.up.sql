@@ -0,0 +1,3 @@
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS created_by bigint,
+  ADD COLUMN IF NOT EXISTS cancel_requested_at bigint;
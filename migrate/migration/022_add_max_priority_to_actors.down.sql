@@ -0,0 +1,3 @@
+ALTER TABLE actors
+  DROP CONSTRAINT IF EXISTS max_priority_in_range,
+  DROP COLUMN IF EXISTS max_priority;
@@ -0,0 +1,3 @@
+ALTER TABLE actors
+  ADD COLUMN IF NOT EXISTS max_priority smallint NOT NULL DEFAULT 1,
+  ADD CONSTRAINT max_priority_in_range CHECK (max_priority >= 1 AND max_priority <= 8);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS invocations_available_scheduled_at_index;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS invocations_available_scheduled_at_index ON invocations USING btree(scheduled_at) WHERE state = 'available'::invocation_state;
@@ -0,0 +1,27 @@
+CREATE TABLE schedules(
+  id bigserial PRIMARY KEY,
+  name text NOT NULL,
+  actor_id bigint NOT NULL REFERENCES actors(id),
+  cron_expression text NOT NULL,
+  payload jsonb NOT NULL DEFAULT '{}' ::jsonb,
+  metadata jsonb NOT NULL DEFAULT '{}' ::jsonb,
+  enabled boolean NOT NULL DEFAULT true,
+  last_run_at bigint,
+  last_invocation_id bigint,
+  next_run_at bigint,
+  created_at bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_at bigint,
+
+  CONSTRAINT name_length CHECK (char_length(name) > 0 AND char_length(name) < 128)
+);
+
+CREATE UNIQUE INDEX schedules_name ON schedules USING btree(name);
+
+CREATE INDEX schedules_enabled_next_run_at_index ON schedules USING btree(next_run_at) WHERE enabled;
+
+CREATE TABLE leaders(
+  name text PRIMARY KEY,
+  leader_id text NOT NULL,
+  elected_at bigint NOT NULL,
+  expires_at bigint NOT NULL
+);
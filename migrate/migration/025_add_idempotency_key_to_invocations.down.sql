@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS invocations_created_by_idempotency_key_index;
+
+ALTER TABLE invocations
+  DROP COLUMN IF EXISTS idempotency_key;
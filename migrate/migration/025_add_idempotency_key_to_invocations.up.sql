@@ -0,0 +1,4 @@
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS idempotency_key varchar(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS invocations_created_by_idempotency_key_index ON invocations USING btree(created_by, idempotency_key) WHERE idempotency_key IS NOT NULL;
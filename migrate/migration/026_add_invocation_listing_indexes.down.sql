@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS invocations_tags_index;
+
+DROP INDEX IF EXISTS invocations_created_at_index;
+
+DROP INDEX IF EXISTS invocations_queue_id_id_index;
@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS invocations_queue_id_id_index ON invocations USING btree(queue_id, id);
+
+CREATE INDEX IF NOT EXISTS invocations_created_at_index ON invocations USING btree(created_at);
+
+CREATE INDEX IF NOT EXISTS invocations_tags_index ON invocations USING GIN(tags);
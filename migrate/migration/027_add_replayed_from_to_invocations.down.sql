@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS invocations_discarded_queue_id_index;
+
+DROP INDEX IF EXISTS invocations_replayed_from_index;
+
+ALTER TABLE invocations
+  DROP COLUMN IF EXISTS replayed_from;
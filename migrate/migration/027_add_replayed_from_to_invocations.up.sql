@@ -0,0 +1,6 @@
+ALTER TABLE invocations
+  ADD COLUMN IF NOT EXISTS replayed_from bigint REFERENCES invocations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS invocations_replayed_from_index ON invocations USING btree(replayed_from) WHERE replayed_from IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS invocations_discarded_queue_id_index ON invocations USING btree(queue_id, id) WHERE state = 'discarded'::invocation_state;
@@ -0,0 +1,2 @@
+ALTER TABLE queues
+  DROP COLUMN IF EXISTS reject_invocations_when_paused;
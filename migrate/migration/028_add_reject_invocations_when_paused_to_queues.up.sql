@@ -0,0 +1,2 @@
+ALTER TABLE queues
+  ADD COLUMN IF NOT EXISTS reject_invocations_when_paused boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+DROP TABLE actor_quotas;
+DROP TABLE token_usages;
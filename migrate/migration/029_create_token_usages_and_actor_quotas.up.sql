@@ -0,0 +1,25 @@
+CREATE TABLE token_usages(
+  api_token_id text NOT NULL,
+  actor_id bigint NOT NULL,
+  model_id text NOT NULL,
+  day bigint NOT NULL,
+  requests bigint NOT NULL DEFAULT 0,
+  input_tokens bigint NOT NULL DEFAULT 0,
+  output_tokens bigint NOT NULL DEFAULT 0,
+  cost double precision NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (api_token_id, actor_id, model_id, day)
+);
+
+CREATE INDEX token_usages_actor_id_day_index ON token_usages USING btree(actor_id, day);
+
+CREATE TABLE actor_quotas(
+  actor_id bigint NOT NULL REFERENCES actors(id) ON DELETE CASCADE,
+  period text NOT NULL,
+  max_tokens bigint,
+  max_cost double precision,
+  created_at bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+
+  PRIMARY KEY (actor_id, period),
+  CONSTRAINT period_value CHECK (period IN ('daily', 'monthly'))
+);
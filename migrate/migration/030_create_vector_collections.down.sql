@@ -0,0 +1,10 @@
+DO $$
+DECLARE
+  collection_id bigint;
+BEGIN
+  FOR collection_id IN SELECT id FROM vector_collections LOOP
+    EXECUTE format('DROP TABLE IF EXISTS %I', 'vector_collection_' || collection_id);
+  END LOOP;
+END $$;
+
+DROP TABLE vector_collections;
@@ -0,0 +1,13 @@
+CREATE TABLE vector_collections(
+  id bigserial PRIMARY KEY,
+  database_name text NOT NULL,
+  name text NOT NULL,
+  fields jsonb NOT NULL,
+  indexes jsonb NOT NULL DEFAULT '[]' ::jsonb,
+  created_at bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+
+  CONSTRAINT database_name_length CHECK (char_length(database_name) > 0 AND char_length(database_name) < 256),
+  CONSTRAINT name_length CHECK (char_length(name) > 0 AND char_length(name) < 256)
+);
+
+CREATE UNIQUE INDEX vector_collections_database_name_name ON vector_collections USING btree(database_name, name);
@@ -0,0 +1,80 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestAdminQueuePauseEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+
+	actor := fixture.InsertActor(t, ctx, ds, "actor1")
+	fixture.InsertToken(t, ctx, ds, "actor-token", actor.ID, []string{"create:invocation", "read:invocation"})
+	fixture.InsertToken(t, ctx, ds, "admin-token", actor.ID, []string{"admin"})
+
+	pauseUrl := fmt.Sprintf("%s/v1/admin/actors/%d/pause", server.URL, actor.ID)
+	resumeUrl := fmt.Sprintf("%s/v1/admin/actors/%d/resume", server.URL, actor.ID)
+	body := `{"actor":"actor1","meta":{"kind":"test"},"payload":{}}`
+
+	t.Run("Non-admin token", func(t *testing.T) {
+		resp, _ := PostHttp(t, pauseUrl, `{}`, "actor-token")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Unknown actor", func(t *testing.T) {
+		resp, _ := PostHttp(t, server.URL+"/v1/admin/actors/999999/pause", `{}`, "admin-token")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Paused queue accepts but holds invocations", func(t *testing.T) {
+		resp, resBody := PostHttp(t, pauseUrl, `{}`, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var state api.QueuePauseState
+		require.NoError(t, json.Unmarshal([]byte(resBody), &state))
+		require.Equal(t, actor.QueueID, state.QueueId)
+		require.NotNil(t, state.PausedAt)
+		require.False(t, state.RejectInvocations)
+
+		resp, _ = PostHttp(t, server.URL+"/v1/invocations/async", body, "actor-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, _ = GetHttp(t, server.URL+"/v1/invocations/next?wait=1", "actor-token")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, resBody = PostHttp(t, resumeUrl, ``, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, json.Unmarshal([]byte(resBody), &state))
+		require.Nil(t, state.PausedAt)
+
+		resp, _ = GetHttp(t, server.URL+"/v1/invocations/next?wait=1", "actor-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Paused queue rejects invocations", func(t *testing.T) {
+		resp, _ := PostHttp(t, pauseUrl, `{"reject_invocations":true}`, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = PostHttp(t, server.URL+"/v1/invocations/async", body, "actor-token")
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		resp, resBody := PostHttp(t, server.URL+"/v1/invocations/batch", `{"invocations":[`+body+`]}`, "actor-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.JSONEq(t, `{"results":[{"error":"queue paused"}]}`, resBody)
+
+		resp, _ = PostHttp(t, resumeUrl, ``, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = PostHttp(t, server.URL+"/v1/invocations/async", body, "actor-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+}
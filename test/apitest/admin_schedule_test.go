@@ -0,0 +1,68 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestAdminScheduleEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+
+	actor1 := fixture.InsertActor(t, ctx, ds, "actor1")
+	actor2 := fixture.InsertActor(t, ctx, ds, "actor2")
+	fixture.InsertToken(t, ctx, ds, "admin-token", actor1.ID, []string{"admin"})
+	fixture.InsertToken(t, ctx, ds, "actor-token", actor2.ID, []string{"user"})
+
+	body := `{"name": "nightly", "actor": "actor2", "cron_expression": "@daily", "payload": {"k": "v"}, "meta": {"kind": "cron"}}`
+
+	t.Run("Non-admin token", func(t *testing.T) {
+		resp, _ := PostHttp(t, server.URL+"/v1/admin/schedules", body, "actor-token")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, _ = GetHttp(t, server.URL+"/v1/admin/schedules", "actor-token")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Create, update and delete", func(t *testing.T) {
+		resp, resBody := PostHttp(t, server.URL+"/v1/admin/schedules", body, "admin-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created api.Schedule
+		require.NoError(t, json.Unmarshal([]byte(resBody), &created))
+		require.Equal(t, "actor2", created.Actor)
+		require.NotNil(t, created.NextRunAt)
+
+		scheduleUrl := fmt.Sprintf("%s/v1/admin/schedules/%d", server.URL, created.Id)
+		resp, resBody = PatchHttp(t, scheduleUrl, `{"cron_expression": "not a cron"}`, "admin-token")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, resBody)
+
+		resp, resBody = PatchHttp(t, scheduleUrl, `{"enabled": false}`, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var updated api.AdminUpdateSchedule200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &updated))
+		require.False(t, updated.Data.Enabled)
+		require.Nil(t, updated.Data.NextRunAt)
+
+		resp, resBody = GetHttp(t, server.URL+"/v1/admin/schedules", "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var list api.AdminListSchedules200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &list))
+		require.Len(t, list.Data, 1)
+
+		resp, _ = DeleteHttp(t, scheduleUrl, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = GetHttp(t, scheduleUrl, "admin-token")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,192 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+func TestCreateChatCompletion(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"create:completion"})
+
+	mockAdapter := new(MockAdapter)
+	originalCreateAdapter := adapter.CreateAdapter
+	adapter.CreateAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.LLMAdapter, error) {
+		return mockAdapter, nil
+	}
+	defer func() { adapter.CreateAdapter = originalCreateAdapter }()
+
+	t.Run("Successful chat completion with tool calls", func(t *testing.T) {
+		expectedRequest := llm.CompletionRequest{
+			ModelID: "test-model",
+			Messages: []llm.Message{
+				{Role: "system", Content: []llm.Content{{Text: "You are a calculator."}}},
+				{Role: "user", Content: []llm.Content{{Text: "Add 1 and 2, then 3 and 4."}}},
+				{Role: "assistant", Content: []llm.Content{
+					{ToolCall: &llm.ToolCall{ID: "call_1", FunctionName: "add", Arguments: `{"nums":[1,2]}`}},
+					{ToolCall: &llm.ToolCall{ID: "call_2", FunctionName: "add", Arguments: `{"nums":[3,4]}`}},
+				}},
+				{Role: "tool", Content: []llm.Content{
+					{ToolResult: &llm.ToolResult{ID: "call_1", Result: "3"}},
+					{ToolResult: &llm.ToolResult{ID: "call_2", Result: "7"}},
+				}},
+			},
+			Tools: []llm.Tool{{
+				Name:        "add",
+				Description: "Add numbers",
+				Parameters:  json.RawMessage(`{"type":"object"}`),
+			}},
+			ToolChoice:    &llm.ToolChoice{Type: llm.ToolChoiceFunction, FunctionName: "add"},
+			StopSequences: []string{"END"},
+			MaxTokens:     lo.ToPtr(int32(100)),
+		}
+		mockAdapter.On("GetCompletion", mock.Anything, expectedRequest).Return(llm.CompletionResult{
+			Messages: []llm.Message{{Role: "assistant", Content: []llm.Content{
+				{Text: "Let me add them."},
+				{ToolCall: &llm.ToolCall{ID: "call_3", FunctionName: "add", Arguments: `{"nums":[3,7]}`}},
+			}}},
+			Usage: llm.CompletionUsage{InputTokens: 30, OutputTokens: 12},
+		}, nil).Once()
+
+		requestBody := `{
+			"model": "test-model",
+			"messages": [
+				{"role": "developer", "content": "You are a calculator."},
+				{"role": "user", "content": [{"type": "text", "text": "Add 1 and 2, then 3 and 4."}]},
+				{"role": "assistant", "content": null, "tool_calls": [
+					{"id": "call_1", "type": "function", "function": {"name": "add", "arguments": "{\"nums\":[1,2]}"}},
+					{"id": "call_2", "type": "function", "function": {"name": "add", "arguments": "{\"nums\":[3,4]}"}}
+				]},
+				{"role": "tool", "tool_call_id": "call_1", "content": "3"},
+				{"role": "tool", "tool_call_id": "call_2", "content": "7"}
+			],
+			"tools": [{"type": "function", "function": {"name": "add", "description": "Add numbers", "parameters": {"type": "object"}}}],
+			"tool_choice": {"type": "function", "function": {"name": "add"}},
+			"stop": "END",
+			"max_tokens": 100
+		}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/chat/completions", requestBody, "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+		var response api.ChatCompletion
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		assert.True(t, strings.HasPrefix(response.Id, "chatcmpl-"))
+		assert.Equal(t, "chat.completion", response.Object)
+		assert.Equal(t, "test-model", response.Model)
+		assert.Equal(t, api.ChatCompletionUsage{PromptTokens: 30, CompletionTokens: 12, TotalTokens: 42}, response.Usage)
+		require.Len(t, response.Choices, 1)
+		choice := response.Choices[0]
+		assert.Equal(t, "tool_calls", choice.FinishReason)
+		assert.Equal(t, api.ChatCompletionMessageRoleAssistant, choice.Message.Role)
+		assert.Equal(t, "Let me add them.", *choice.Message.Content)
+		assert.Equal(t, []api.ChatCompletionToolCall{{
+			Id:       "call_3",
+			Type:     api.ChatCompletionToolCallTypeFunction,
+			Function: api.ChatCompletionFunctionCall{Name: lo.ToPtr("add"), Arguments: `{"nums":[3,7]}`},
+		}}, *choice.Message.ToolCalls)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Successful streaming chat completion", func(t *testing.T) {
+		expectedRequest := llm.CompletionRequest{
+			ModelID:   "test-model",
+			Messages:  []llm.Message{{Role: "user", Content: []llm.Content{{Text: "Stream it, AI!"}}}},
+			Tools:     []llm.Tool{},
+			MaxTokens: lo.ToPtr(int32(8000)),
+		}
+		mockAdapter.On("StreamCompletion", mock.Anything, expectedRequest).Return([]llm.CompletionDelta{
+			{Text: "Hello"},
+			{ToolCall: &llm.ToolCallDelta{Index: 0, ID: "call_1", FunctionName: "add"}},
+			{ToolCall: &llm.ToolCallDelta{Index: 0, Arguments: `{"nums":[1,2]}`}},
+		}, llm.CompletionUsage{InputTokens: 20, OutputTokens: 7}, nil).Once()
+
+		requestBody := `{
+			"model": "test-model",
+			"stream": true,
+			"stream_options": {"include_usage": true},
+			"messages": [{"role": "user", "content": "Stream it, AI!"}]
+		}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/chat/completions", requestBody, "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		events := strings.Split(strings.TrimSuffix(resBody, "\n\n"), "\n\n")
+		require.Len(t, events, 6)
+		assert.Equal(t, "data: [DONE]", events[5])
+
+		chunks := lo.Map(events[:5], func(event string, _ int) api.ChatCompletionChunk {
+			var chunk api.ChatCompletionChunk
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(event, "data: ")), &chunk))
+			assert.Equal(t, "chat.completion.chunk", chunk.Object)
+			assert.Equal(t, "test-model", chunk.Model)
+			return chunk
+		})
+		for _, chunk := range chunks[1:] {
+			assert.Equal(t, chunks[0].Id, chunk.Id)
+		}
+		assert.Equal(t, api.ChatCompletionDelta{Role: lo.ToPtr("assistant"), Content: lo.ToPtr("Hello")}, chunks[0].Choices[0].Delta)
+		assert.Equal(t, []api.ChatCompletionToolCallDelta{{
+			Index:    0,
+			Id:       lo.ToPtr("call_1"),
+			Type:     lo.ToPtr("function"),
+			Function: &api.ChatCompletionFunctionCall{Name: lo.ToPtr("add")},
+		}}, *chunks[1].Choices[0].Delta.ToolCalls)
+		assert.Equal(t, []api.ChatCompletionToolCallDelta{{
+			Index:    0,
+			Function: &api.ChatCompletionFunctionCall{Arguments: `{"nums":[1,2]}`},
+		}}, *chunks[2].Choices[0].Delta.ToolCalls)
+		assert.Nil(t, chunks[2].Choices[0].FinishReason)
+		assert.Equal(t, "tool_calls", *chunks[3].Choices[0].FinishReason)
+		assert.Empty(t, chunks[4].Choices)
+		assert.Equal(t, &api.ChatCompletionUsage{PromptTokens: 20, CompletionTokens: 7, TotalTokens: 27}, chunks[4].Usage)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Usage is recorded in the ledger", func(t *testing.T) {
+		var requests, inputTokens, outputTokens int64
+		err := ds.QueryRow(ctx,
+			"SELECT SUM(requests), SUM(input_tokens), SUM(output_tokens) FROM token_usages WHERE api_token_id = $1 AND model_id = $2",
+			"test-token", "test-model",
+		).Scan(&requests, &inputTokens, &outputTokens)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), requests)
+		assert.Equal(t, int64(50), inputTokens)
+		assert.Equal(t, int64(19), outputTokens)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		requestBody := `{"model": "test-model", "messages": [{"role": "user", "content": "Hello"}]}`
+		resp, _ := PostHttp(t, server.URL+"/v1/chat/completions", requestBody, "invalid-token")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Bad request", func(t *testing.T) {
+		invalidBodies := map[string]string{
+			"Invalid tool choice":     `{"model": "test-model", "messages": [{"role": "user", "content": "Hi"}], "tool_choice": "any"}`,
+			"Invalid content part":    `{"model": "test-model", "messages": [{"role": "user", "content": [{"type": "audio"}]}]}`,
+			"Tool message without id": `{"model": "test-model", "messages": [{"role": "tool", "content": "3"}]}`,
+		}
+		for name, body := range invalidBodies {
+			resp, resBody := PostHttp(t, server.URL+"/v1/chat/completions", body, "test-token")
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, name)
+			assert.Contains(t, resBody, `"error"`, name)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 	"gitlab.com/navyx/ai/maos/maos-core/api"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
 	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/usage"
 	"gitlab.com/navyx/ai/maos/maos-core/llm"
 	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
 )
@@ -28,6 +30,16 @@ func (m *MockAdapter) GetCompletion(ctx context.Context, request llm.CompletionR
 	return args.Get(0).(llm.CompletionResult), args.Error(1)
 }
 
+func (m *MockAdapter) StreamCompletion(ctx context.Context, request llm.CompletionRequest, onDelta func(llm.CompletionDelta) error) (llm.CompletionUsage, error) {
+	args := m.Called(ctx, request)
+	for _, delta := range args.Get(0).([]llm.CompletionDelta) {
+		if err := onDelta(delta); err != nil {
+			return llm.CompletionUsage{}, err
+		}
+	}
+	return args.Get(1).(llm.CompletionUsage), args.Error(2)
+}
+
 func TestCreateCompletion(t *testing.T) {
 	ctx := context.Background()
 
@@ -74,6 +86,7 @@ func TestCreateCompletion(t *testing.T) {
 					},
 				},
 			},
+			Usage: llm.CompletionUsage{InputTokens: 12, OutputTokens: 9},
 		}
 
 		mockAdapter.On("GetCompletion", mock.Anything, expectedRequest).Return(mockResponse, nil)
@@ -111,6 +124,7 @@ func TestCreateCompletion(t *testing.T) {
 		content, err := response.Messages[0].Content[0].AsMessageContent0()
 		require.NoError(t, err)
 		assert.Equal(t, "Hello, human! How can I assist you today?", content.Text)
+		assert.Equal(t, api.CompletionUsage{InputTokens: 12, OutputTokens: 9}, response.Usage)
 
 		// Verify that the mock expectations were met
 		mockAdapter.AssertExpectations(t)
@@ -255,6 +269,118 @@ func TestCreateCompletion(t *testing.T) {
 		mockAdapter.AssertExpectations(t)
 	})
 
+	t.Run("Successful streaming completion", func(t *testing.T) {
+		expectedRequest := llm.CompletionRequest{
+			ModelID: "test-model",
+			Messages: []llm.Message{
+				{Role: "user", Content: []llm.Content{{Text: "Stream it, AI!"}}},
+			},
+			Tools:     []llm.Tool{},
+			MaxTokens: lo.ToPtr(int32(8000)),
+		}
+		mockAdapter.On("StreamCompletion", mock.Anything, expectedRequest).Return([]llm.CompletionDelta{
+			{Text: "Hello"},
+			{Text: ", human!"},
+			{ToolCall: &llm.ToolCallDelta{Index: 0, ID: "call_1", FunctionName: "add"}},
+			{ToolCall: &llm.ToolCallDelta{Index: 0, Arguments: `{"nums":`}},
+			{ToolCall: &llm.ToolCallDelta{Index: 0, Arguments: `[1,2]}`}},
+		}, llm.CompletionUsage{InputTokens: 20, OutputTokens: 7}, nil)
+
+		requestBody := api.CreateCompletionJSONRequestBody{
+			ModelId: "test-model",
+			Stream:  lo.ToPtr(true),
+			Messages: []api.Message{{
+				Role:    api.MessageRole("user"),
+				Content: []api.MessageContent{{}},
+			}},
+		}
+		requestBody.Messages[0].Content[0].FromMessageContent0(api.MessageContent0{Text: "Stream it, AI!"})
+
+		resp, resBody := PostHttp(t, server.URL+"/v1/completion", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		expected := "event: delta\ndata: {\"text\":\"Hello\"}\n\n" +
+			"event: delta\ndata: {\"text\":\", human!\"}\n\n" +
+			"event: delta\ndata: {\"tool_call\":{\"id\":\"call_1\",\"index\":0,\"name\":\"add\"}}\n\n" +
+			"event: delta\ndata: {\"tool_call\":{\"arguments\":\"{\\\"nums\\\":\",\"index\":0}}\n\n" +
+			"event: delta\ndata: {\"tool_call\":{\"arguments\":\"[1,2]}\",\"index\":0}}\n\n" +
+			"event: done\ndata: {\"input_tokens\":20,\"output_tokens\":7}\n\n"
+		assert.Equal(t, expected, resBody)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Streaming completion failure", func(t *testing.T) {
+		expectedRequest := llm.CompletionRequest{
+			ModelID: "test-model",
+			Messages: []llm.Message{
+				{Role: "user", Content: []llm.Content{{Text: "Fail it, AI!"}}},
+			},
+			Tools:     []llm.Tool{},
+			MaxTokens: lo.ToPtr(int32(8000)),
+		}
+		mockAdapter.On("StreamCompletion", mock.Anything, expectedRequest).Return([]llm.CompletionDelta{
+			{Text: "Hello"},
+		}, llm.CompletionUsage{InputTokens: 5, OutputTokens: 1}, fmt.Errorf("connection reset"))
+
+		requestBody := api.CreateCompletionJSONRequestBody{
+			ModelId: "test-model",
+			Stream:  lo.ToPtr(true),
+			Messages: []api.Message{{
+				Role:    api.MessageRole("user"),
+				Content: []api.MessageContent{{}},
+			}},
+		}
+		requestBody.Messages[0].Content[0].FromMessageContent0(api.MessageContent0{Text: "Fail it, AI!"})
+
+		resp, resBody := PostHttp(t, server.URL+"/v1/completion", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		expected := "event: delta\ndata: {\"text\":\"Hello\"}\n\n" +
+			"event: error\ndata: {\"error\":\"connection reset\"}\n\n"
+		assert.Equal(t, expected, resBody)
+	})
+
+	t.Run("Usage is recorded in the ledger", func(t *testing.T) {
+		var requests, inputTokens, outputTokens int64
+		err := ds.QueryRow(ctx,
+			"SELECT SUM(requests), SUM(input_tokens), SUM(output_tokens) FROM token_usages WHERE api_token_id = $1 AND actor_id = $2 AND model_id = $3",
+			"test-token", actor.ID, "test-model",
+		).Scan(&requests, &inputTokens, &outputTokens)
+		require.NoError(t, err)
+		// the usage of the failed stream is accounted too
+		assert.Equal(t, int64(4), requests)
+		assert.Equal(t, int64(37), inputTokens)
+		assert.Equal(t, int64(17), outputTokens)
+	})
+
+	t.Run("Quota exceeded", func(t *testing.T) {
+		limitedActor := fixture.InsertActor(t, ctx, ds, "limited-actor")
+		fixture.InsertToken(t, ctx, ds, "limited-token", limitedActor.ID, []string{"create:completion"})
+		_, err := ds.Exec(ctx, "INSERT INTO actor_quotas (actor_id, period, max_tokens) VALUES ($1, 'daily', 100)", limitedActor.ID)
+		require.NoError(t, err)
+		_, err = ds.Exec(ctx,
+			"INSERT INTO token_usages (api_token_id, actor_id, model_id, day, requests, input_tokens, output_tokens, cost) VALUES ($1, $2, $3, $4, 1, 80, 20, 0)",
+			"limited-token", limitedActor.ID, "test-model", usage.Day(time.Now()),
+		)
+		require.NoError(t, err)
+
+		requestBody := api.CreateCompletionJSONRequestBody{
+			ModelId: "test-model",
+			Messages: []api.Message{{
+				Role:    api.MessageRole("user"),
+				Content: []api.MessageContent{{}},
+			}},
+		}
+		requestBody.Messages[0].Content[0].FromMessageContent0(api.MessageContent0{Text: "Hello, AI!"})
+
+		resp, resBody := PostHttp(t, server.URL+"/v1/completion", testhelper.SerializeToJson(t, requestBody), "limited-token")
+
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Contains(t, resBody, "daily token quota exceeded")
+	})
+
 	t.Run("Unauthorized access", func(t *testing.T) {
 		requestBody := api.CreateCompletionJSONRequestBody{
 			ModelId: "test-model",
@@ -341,6 +467,66 @@ func TestCreateCompletion(t *testing.T) {
 	})
 }
 
+func TestCreateCompletionWithVirtualModel(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"create:completion"})
+
+	opus := "3db6db92-a091-4944-9f7e-9d43e70218d3-anthropic-claude-3-opus-20240229"
+	sonnet := "93d07ee3-c9fb-4f0e-9fc1-df1a7af10b6c-anthropic-claude-3.5-sonnet-20240620"
+	_, err := querier.SettingUpdateSystem(ctx, ds, json.RawMessage(`{"virtual_models": [{
+		"id": "smart",
+		"routes": [{"model_id": "`+opus+`"}],
+		"fallbacks": ["`+sonnet+`"],
+		"max_retries": 0
+	}]}`))
+	require.NoError(t, err)
+
+	// the primary model is unavailable, so the completion fails over to the fallback
+	primaryAdapter := new(MockAdapter)
+	primaryAdapter.On("GetCompletion", mock.Anything, mock.MatchedBy(func(r llm.CompletionRequest) bool { return r.ModelID == opus })).
+		Return(llm.CompletionResult{}, &adapter.StatusError{StatusCode: 529, Err: fmt.Errorf("overloaded")})
+	fallbackAdapter := new(MockAdapter)
+	fallbackAdapter.On("GetCompletion", mock.Anything, mock.MatchedBy(func(r llm.CompletionRequest) bool { return r.ModelID == sonnet })).
+		Return(llm.CompletionResult{
+			Messages: []llm.Message{{Role: "assistant", Content: []llm.Content{{Text: "Hello from the fallback"}}}},
+			Usage:    llm.CompletionUsage{InputTokens: 10, OutputTokens: 5},
+		}, nil)
+
+	originalCreateAdapter := adapter.CreateAdapter
+	adapter.CreateAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.LLMAdapter, error) {
+		return lo.Ternary[adapter.LLMAdapter](modelId == opus, primaryAdapter, fallbackAdapter), nil
+	}
+	defer func() { adapter.CreateAdapter = originalCreateAdapter }()
+
+	requestBody := api.CreateCompletionJSONRequestBody{
+		ModelId: "smart",
+		Messages: []api.Message{{
+			Role:    api.MessageRole("user"),
+			Content: []api.MessageContent{{}},
+		}},
+	}
+	requestBody.Messages[0].Content[0].FromMessageContent0(api.MessageContent0{Text: "Hello, AI!"})
+
+	resp, resBody := PostHttp(t, server.URL+"/v1/completion", testhelper.SerializeToJson(t, requestBody), "test-token")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, resBody, "Hello from the fallback")
+	primaryAdapter.AssertNumberOfCalls(t, "GetCompletion", 1)
+	fallbackAdapter.AssertNumberOfCalls(t, "GetCompletion", 1)
+
+	// the usage is accounted to the model which generated the completion
+	var inputTokens, outputTokens int64
+	err = ds.QueryRow(ctx,
+		"SELECT SUM(input_tokens), SUM(output_tokens) FROM token_usages WHERE actor_id = $1 AND model_id = $2",
+		actor.ID, sonnet,
+	).Scan(&inputTokens, &outputTokens)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), inputTokens)
+	assert.Equal(t, int64(5), outputTokens)
+}
+
 func TestListCompletionModels(t *testing.T) {
 	ctx := context.Background()
 	server, ds, _ := SetupHttpTestWithDb(t, ctx)
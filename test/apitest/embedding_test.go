@@ -0,0 +1,148 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+// MockEmbeddingAdapter is a mock implementation of the EmbeddingAdapter interface
+type MockEmbeddingAdapter struct {
+	mock.Mock
+}
+
+func (m *MockEmbeddingAdapter) GetEmbedding(ctx context.Context, request llm.EmbeddingRequest) (llm.EmbeddingResult, error) {
+	args := m.Called(ctx, request)
+	return args.Get(0).(llm.EmbeddingResult), args.Error(1)
+}
+
+func TestCreateEmbedding(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"create:completion"})
+
+	mockAdapter := new(MockEmbeddingAdapter)
+
+	originalCreateEmbeddingAdapter := adapter.CreateEmbeddingAdapter
+	adapter.CreateEmbeddingAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.EmbeddingAdapter, error) {
+		if modelId != "test-model" {
+			return nil, fmt.Errorf("model %s not found", modelId)
+		}
+		return mockAdapter, nil
+	}
+	defer func() { adapter.CreateEmbeddingAdapter = originalCreateEmbeddingAdapter }()
+
+	t.Run("Successful embedding", func(t *testing.T) {
+		expectedRequest := llm.EmbeddingRequest{
+			ModelID:   "test-model",
+			Input:     []string{"The capital of France is Paris.", "The capital of Italy is Rome."},
+			InputType: lo.ToPtr("document"),
+		}
+		mockAdapter.On("GetEmbedding", mock.Anything, expectedRequest).Return(llm.EmbeddingResult{
+			Data: []llm.Embedding{
+				{Embedding: []float64{0.5, 0.25}, Index: 0},
+				{Embedding: []float64{0.125, 1}, Index: 1},
+			},
+		}, nil)
+
+		requestBody := api.CreateEmbeddingJSONRequestBody{
+			ModelId:   "test-model",
+			Input:     []string{"The capital of France is Paris.", "The capital of Italy is Rome."},
+			InputType: lo.ToPtr(api.Document),
+		}
+		resp, resBody := PostHttp(t, server.URL+"/v1/embedding", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var response api.CreateEmbedding200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		require.NotNil(t, response.Data)
+		assert.Equal(t, []api.Embedding{
+			{Embedding: &[]float32{0.5, 0.25}, Index: lo.ToPtr(0)},
+			{Embedding: &[]float32{0.125, 1}, Index: lo.ToPtr(1)},
+		}, *response.Data)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Provider failure", func(t *testing.T) {
+		expectedRequest := llm.EmbeddingRequest{
+			ModelID: "test-model",
+			Input:   []string{"Fail it"},
+		}
+		mockAdapter.On("GetEmbedding", mock.Anything, expectedRequest).Return(llm.EmbeddingResult{}, fmt.Errorf("rate limited"))
+
+		requestBody := api.CreateEmbeddingJSONRequestBody{ModelId: "test-model", Input: []string{"Fail it"}}
+		resp, resBody := PostHttp(t, server.URL+"/v1/embedding", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.Contains(t, resBody, "rate limited")
+	})
+
+	t.Run("Unknown model", func(t *testing.T) {
+		requestBody := api.CreateEmbeddingJSONRequestBody{ModelId: "invalid-model", Input: []string{"Hello"}}
+		resp, resBody := PostHttp(t, server.URL+"/v1/embedding", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "Model invalid-model not found")
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		requestBody := api.CreateEmbeddingJSONRequestBody{ModelId: "test-model", Input: []string{}}
+		resp, resBody := PostHttp(t, server.URL+"/v1/embedding", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "Input is empty")
+	})
+
+	t.Run("Unauthorized access", func(t *testing.T) {
+		requestBody := api.CreateEmbeddingJSONRequestBody{ModelId: "test-model", Input: []string{"Hello"}}
+		resp, _ := PostHttp(t, server.URL+"/v1/embedding", testhelper.SerializeToJson(t, requestBody), "invalid-token")
+
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestListEmbeddingModels(t *testing.T) {
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"read:completion"})
+
+	t.Run("Successful listing of embedding models", func(t *testing.T) {
+		resp, resBody := GetHttp(t, server.URL+"/v1/embedding/models", "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response api.ListEmbeddingModels200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+
+		expectedModels := adapter.GetEmbeddingModelList()
+		require.Equal(t, len(expectedModels), len(response.Data))
+		for i, model := range response.Data {
+			require.Equal(t, expectedModels[i].ID, model.Id)
+			require.Equal(t, expectedModels[i].Name, model.Name)
+			require.Equal(t, expectedModels[i].Provider, model.Provider)
+			require.Equal(t, expectedModels[i].Dimension, model.Dimension)
+		}
+	})
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		resp, _ := GetHttp(t, server.URL+"/v1/embedding/models", "")
+
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
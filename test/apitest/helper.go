@@ -29,6 +29,10 @@ func GetHttpWithHeader(t *testing.T, url, token string, headers map[string]strin
 	return request(t, http.MethodGet, url, nil, token, headers)
 }
 
+func PostHttpWithHeader(t *testing.T, url, body, token string, headers map[string]string) (*http.Response, string) {
+	return request(t, http.MethodPost, url, bytes.NewBufferString(body), token, headers)
+}
+
 func request(t *testing.T, method, url string, body io.Reader, token string, headers map[string]string) (*http.Response, string) {
 	req, err := http.NewRequest(method, url, body)
 	require.NoError(t, err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -100,6 +101,24 @@ func TestInvocationCreateEndpoint(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   "Meta is required",
 		},
+		{
+			name:           "Too long tag",
+			body:           `{"actor":"actor1","meta":{"kind": "test"},"payload":{},"tags":["` + strings.Repeat("a", 256) + `"]}`,
+			actorName:      "actor1",
+			tokenName:      "token007",
+			permissions:    []string{"create:invocation"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "tags must be at most 255 characters",
+		},
+		{
+			name:           "Too many tags",
+			body:           `{"actor":"actor1","meta":{"kind": "test"},"payload":{},"tags":["` + strings.Repeat(`a","`, 32) + `a"]}`,
+			actorName:      "actor1",
+			tokenName:      "token008",
+			permissions:    []string{"create:invocation"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "tags must have at most 32 items",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,100 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestCreateInvocationBatchEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+
+	actor1 := fixture.InsertActor(t, ctx, ds, "actor1")
+	actor2 := fixture.InsertActor(t, ctx, ds, "actor2")
+	fixture.InsertToken(t, ctx, ds, "token1", actor1.ID, []string{"create:invocation"})
+	fixture.InsertToken(t, ctx, ds, "token-read", actor1.ID, []string{"read:invocation"})
+
+	querier := dbsqlc.New()
+
+	t.Run("Create invocations for several actors", func(t *testing.T) {
+		body := `{"invocations":[
+			{"actor":"actor1","meta":{"kind":"first"},"payload":{"n":1}},
+			{"actor":"unknown","meta":{"kind":"second"},"payload":{"n":2}},
+			{"actor":"actor2","meta":{"kind":"third"},"payload":{"n":3},"priority":2}
+		]}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/invocations/batch", body, "token1")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result api.CreateInvocationBatch201JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &result))
+		require.Len(t, result.Results, 3)
+
+		require.NotNil(t, result.Results[0].Id)
+		require.Equal(t, api.InvocationStateAvailable, *result.Results[0].State)
+		require.Nil(t, result.Results[0].Error)
+
+		require.Nil(t, result.Results[1].Id)
+		require.Equal(t, "actor not found", *result.Results[1].Error)
+
+		require.NotNil(t, result.Results[2].Id)
+
+		id, err := strconv.ParseInt(*result.Results[0].Id, 10, 64)
+		require.NoError(t, err)
+		invocation, err := querier.InvocationFindById(ctx, ds, id)
+		require.NoError(t, err)
+		require.Equal(t, actor1.QueueID, invocation.QueueID)
+		require.JSONEq(t, `{"n":1}`, string(invocation.Payload))
+
+		id, err = strconv.ParseInt(*result.Results[2].Id, 10, 64)
+		require.NoError(t, err)
+		invocation, err = querier.InvocationFindById(ctx, ds, id)
+		require.NoError(t, err)
+		require.Equal(t, actor2.QueueID, invocation.QueueID)
+		require.EqualValues(t, 2, invocation.Priority)
+	})
+
+	t.Run("Replay with meta key returns the original invocation", func(t *testing.T) {
+		body := `{"invocations":[
+			{"actor":"actor1","meta":{"kind":"test","idempotency_key":"batch-key"},"payload":{}},
+			{"actor":"actor1","meta":{"kind":"test","idempotency_key":"batch-key"},"payload":{}}
+		]}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/invocations/batch", body, "token1")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var result api.CreateInvocationBatch201JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &result))
+		require.Len(t, result.Results, 2)
+		require.NotNil(t, result.Results[0].Id)
+		require.Equal(t, *result.Results[0].Id, *result.Results[1].Id)
+	})
+
+	t.Run("Invalid invocation rejects the batch", func(t *testing.T) {
+		body := `{"invocations":[
+			{"actor":"actor1","meta":{"kind":"test"},"payload":{}},
+			{"actor":"actor1","meta":{"kind":"test"},"payload":{},"priority":9}
+		]}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/invocations/batch", body, "token1")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "invocations[1]")
+	})
+
+	t.Run("Empty batch", func(t *testing.T) {
+		resp, _ := PostHttp(t, server.URL+"/v1/invocations/batch", `{"invocations":[]}`, "token1")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		resp, _ := PostHttp(t, server.URL+"/v1/invocations/batch", `{"invocations":[]}`, "token-read")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
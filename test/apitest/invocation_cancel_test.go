@@ -0,0 +1,128 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestInvocationCancelEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// setup creates an invocation for the agent actor on behalf of the caller actor
+	setup := func(t *testing.T, ctx context.Context) (*httptest.Server, dbaccess.DataSource, *dbsqlc.Actor, string) {
+		server, ds, _ := SetupHttpTestWithDb(t, ctx)
+		caller := fixture.InsertActor(t, ctx, ds, "caller")
+		agent := fixture.InsertActor(t, ctx, ds, "agent")
+		fixture.InsertToken(t, ctx, ds, "caller-token", caller.ID, []string{"create:invocation"})
+		fixture.InsertToken(t, ctx, ds, "agent-token", agent.ID, []string{"read:invocation", "create:invocation"})
+		fixture.InsertToken(t, ctx, ds, "admin-token", caller.ID, []string{"admin"})
+
+		body := `{"actor":"agent","meta":{"kind": "test"},"payload":{"key": "value"}}`
+		resp, resBody := PostHttp(t, server.URL+"/v1/invocations/async", body, "caller-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created api.CreateInvocationAsync201JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &created))
+		return server, ds, agent, created.Id
+	}
+
+	cancel := func(t *testing.T, server *httptest.Server, id string, token string) (*http.Response, api.CancelInvocation200JSONResponse) {
+		resp, resBody := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%s/cancel", server.URL, id), "", token)
+		var response api.CancelInvocation200JSONResponse
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		}
+		return resp, response
+	}
+
+	t.Run("available invocation is cancelled right away", func(t *testing.T) {
+		server, _, _, id := setup(t, ctx)
+
+		resp, response := cancel(t, server, id, "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, api.InvocationStateCancelled, response.State)
+
+		resp, resBody := GetHttp(t, fmt.Sprintf("%s/v1/invocations/%s", server.URL, id), "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, resBody, `"state":"cancelled"`)
+
+		resp, _ = cancel(t, server, id, "caller-token")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("running invocation is cancelled on next heartbeat", func(t *testing.T) {
+		server, ds, agent, id := setup(t, ctx)
+		_, err := querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: agent.ID,
+			QueueID:     agent.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+
+		resp, response := cancel(t, server, id, "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, api.InvocationStateRunning, response.State)
+
+		resp, resBody := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%s/heartbeat", server.URL, id), `{}`, "agent-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, resBody, `"cancelled":true`)
+
+		resp, resBody = GetHttp(t, fmt.Sprintf("%s/v1/invocations/%s", server.URL, id), "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, resBody, `"state":"cancelled"`)
+	})
+
+	t.Run("running invocation is cancelled on return", func(t *testing.T) {
+		server, ds, agent, id := setup(t, ctx)
+		_, err := querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: agent.ID,
+			QueueID:     agent.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+
+		resp, _ := cancel(t, server, id, "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = PostHttp(t, fmt.Sprintf("%s/v1/invocations/%s/response", server.URL, id), `{"result":{"done":true}}`, "agent-token")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp, resBody := GetHttp(t, fmt.Sprintf("%s/v1/invocations/%s", server.URL, id), "caller-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, resBody, `"state":"cancelled"`)
+	})
+
+	t.Run("admin can cancel any invocation", func(t *testing.T) {
+		server, _, _, id := setup(t, ctx)
+
+		resp, response := cancel(t, server, id, "admin-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, api.InvocationStateCancelled, response.State)
+	})
+
+	t.Run("other actors cannot cancel", func(t *testing.T) {
+		server, _, _, id := setup(t, ctx)
+
+		resp, _ := cancel(t, server, id, "agent-token")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		server, _, _, id := setup(t, ctx)
+
+		resp, _ := cancel(t, server, id, "invalid-token")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
 	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
@@ -46,6 +47,42 @@ func TestInvocationReturnErrorEndpoint(t *testing.T) {
 		require.JSONEq(t, `{"err": 16888}`, string(row.Errors))
 	})
 
+	t.Run("retry until attempts are exhausted", func(t *testing.T) {
+		server, ds, actor, token := setup(t, ctx)
+		_, err := querier.ActorUpdate(ctx, ds, &dbsqlc.ActorUpdateParams{
+			ID:                  actor.ID,
+			MaxAttempts:         lo.ToPtr(int16(2)),
+			RetryBackoffSeconds: lo.ToPtr(int32(0)),
+		})
+		require.NoError(t, err)
+
+		invocation := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+		for attempt := 1; attempt <= 2; attempt++ {
+			jobs, err := querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+				AttemptedBy: actor.ID,
+				QueueID:     actor.QueueID,
+				Max:         1,
+			})
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+
+			body := fmt.Sprintf(`{"errors":{"attempt": %d}}`, attempt)
+			resp, _ := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/error", server.URL, invocation), body, token.ID)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			row, err := querier.InvocationFindById(ctx, ds, invocation)
+			require.NoError(t, err)
+			require.JSONEq(t, fmt.Sprintf(`{"attempt": %d}`, attempt), string(row.Errors))
+			if attempt == 1 {
+				require.Equal(t, dbsqlc.InvocationState("available"), row.State)
+				require.Nil(t, row.FinalizedAt)
+			} else {
+				require.Equal(t, dbsqlc.InvocationState("discarded"), row.State)
+				require.NotNil(t, row.FinalizedAt)
+			}
+		}
+	})
+
 	t.Run("invalid token", func(t *testing.T) {
 		server, _, _, token := setup(t, ctx)
 
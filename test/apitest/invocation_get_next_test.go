@@ -64,6 +64,42 @@ func TestInvocationGetNextEndpoint(t *testing.T) {
 			resBody)
 	})
 
+	t.Run("Batch of invocations", func(t *testing.T) {
+		server, ds, actor, token := setup(t, ctx)
+		invocation1 := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+		invocation2 := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 2}`, actor.Name)
+		invocation3 := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 3}`, actor.Name)
+
+		resp, resBody := GetHttp(t, server.URL+"/v1/invocations/next?batch_size=2", token.ID)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.JSONEq(t,
+			fmt.Sprintf(`{"data":[
+				{"id":"%d", "meta":{"kind":"test","trace_id":"123"}, "payload":{"seq":1}},
+				{"id":"%d", "meta":{"kind":"test","trace_id":"123"}, "payload":{"seq":2}}
+			]}`, invocation1, invocation2),
+			resBody)
+
+		// fewer invocations than the batch size are returned without waiting for more
+		resp, resBody = GetHttp(t, server.URL+"/v1/invocations/next?batch_size=5", token.ID)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.JSONEq(t,
+			fmt.Sprintf(`{"data":[{"id":"%d", "meta":{"kind":"test","trace_id":"123"}, "payload":{"seq":3}}]}`, invocation3),
+			resBody)
+
+		for _, id := range []int64{invocation1, invocation2, invocation3} {
+			row, err := querier.InvocationFindById(ctx, ds, id)
+			require.NoError(t, err)
+			require.Equal(t, dbsqlc.InvocationStateRunning, row.State)
+		}
+	})
+
+	t.Run("Invalid batch size", func(t *testing.T) {
+		server, _, _, token := setup(t, ctx)
+
+		resp, _ := GetHttp(t, server.URL+"/v1/invocations/next?batch_size=101", token.ID)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("Invocations insert after", func(t *testing.T) {
 		server, ds, _ := SetupHttpTestWithDb(t, ctx)
 
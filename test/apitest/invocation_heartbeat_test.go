@@ -0,0 +1,121 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestInvocationHeartbeatEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	setup := func(t *testing.T, ctx context.Context) (*httptest.Server, dbaccess.DataSource, *dbsqlc.Actor, *dbsqlc.ApiToken) {
+		server, ds, _ := SetupHttpTestWithDb(t, ctx)
+		actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+		token := fixture.InsertToken(t, ctx, ds, "actor-token", actor.ID, []string{"read:invocation", "create:invocation"})
+		return server, ds, actor, token
+	}
+
+	t.Run("running invocation", func(t *testing.T) {
+		server, ds, actor, token := setup(t, ctx)
+		_, err := querier.QueueUpdate(ctx, ds, &dbsqlc.QueueUpdateParams{ID: actor.QueueID, LeaseSeconds: lo.ToPtr(int32(300))})
+		require.NoError(t, err)
+
+		// insert and change state to running
+		invocation := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+		_, err = querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: actor.ID,
+			QueueID:     actor.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+
+		body := `{"progress":{"step": 3, "total": 5}}`
+		resp, resBody := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/heartbeat", server.URL, invocation), body, token.ID)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response api.HeartbeatInvocation200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		require.NotNil(t, response.LeaseExpiresAt)
+		require.Greater(t, *response.LeaseExpiresAt, time.Now().Unix())
+
+		row, err := querier.InvocationFindById(ctx, ds, invocation)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateRunning, row.State)
+		require.Equal(t, response.LeaseExpiresAt, row.LeaseExpiresAt)
+		require.JSONEq(t, `{"step": 3, "total": 5}`, string(row.Progress))
+
+		// the progress is visible to pollers
+		resp, resBody = GetHttp(t, fmt.Sprintf("%s/v1/invocations/%d", server.URL, invocation), token.ID)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		require.Contains(t, resBody, `"progress":{"step":3,"total":5}`)
+	})
+
+	t.Run("running invocation without lease", func(t *testing.T) {
+		server, ds, actor, token := setup(t, ctx)
+
+		invocation := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+		_, err := querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: actor.ID,
+			QueueID:     actor.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+
+		resp, resBody := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/heartbeat", server.URL, invocation), `{}`, token.ID)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.JSONEq(t, `{"cancelled": false}`, resBody)
+
+		row, err := querier.InvocationFindById(ctx, ds, invocation)
+		require.NoError(t, err)
+		require.Equal(t, dbsqlc.InvocationStateRunning, row.State)
+		require.Nil(t, row.LeaseExpiresAt)
+	})
+
+	t.Run("invocation not running", func(t *testing.T) {
+		server, ds, actor, token := setup(t, ctx)
+
+		invocation := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+
+		resp, _ := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/heartbeat", server.URL, invocation), `{}`, token.ID)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("invalid permission", func(t *testing.T) {
+		server, ds, actor, _ := setup(t, ctx)
+		token := fixture.InsertToken(t, ctx, ds, "actor-token2", actor.ID, []string{"create:invocation"})
+
+		resp, _ := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/heartbeat", server.URL, 1998), `{}`, token.ID)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("attempted_by mismatch", func(t *testing.T) {
+		server, ds, actor, _ := setup(t, ctx)
+		actor2 := fixture.InsertActor(t, ctx, ds, "test-actor2")
+		token2 := fixture.InsertToken(t, ctx, ds, "actor2-token", actor2.ID, []string{"read:invocation"})
+
+		// insert and change state to running
+		invocation := fixture.InsertInvocation(t, ctx, ds, "available", `{"seq": 1}`, actor.Name)
+		_, err := querier.InvocationGetAvailable(ctx, ds, &dbsqlc.InvocationGetAvailableParams{
+			AttemptedBy: actor.ID,
+			QueueID:     actor.QueueID,
+			Max:         1,
+		})
+		require.NoError(t, err)
+
+		resp, _ := PostHttp(t, fmt.Sprintf("%s/v1/invocations/%d/heartbeat", server.URL, invocation), `{}`, token2.ID)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
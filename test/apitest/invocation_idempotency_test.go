@@ -0,0 +1,78 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestInvocationIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+
+	actor1 := fixture.InsertActor(t, ctx, ds, "actor1")
+	actor2 := fixture.InsertActor(t, ctx, ds, "actor2")
+	fixture.InsertToken(t, ctx, ds, "token1", actor1.ID, []string{"create:invocation"})
+	fixture.InsertToken(t, ctx, ds, "token2", actor2.ID, []string{"create:invocation"})
+
+	body := `{"actor":"actor1","meta":{"kind": "test"},"payload":{}}`
+
+	create := func(t *testing.T, body, token string, headers map[string]string) (int, map[string]interface{}) {
+		resp, resBody := PostHttpWithHeader(t, server.URL+"/v1/invocations/async", body, token, headers)
+		var result map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(resBody), &result))
+		return resp.StatusCode, result
+	}
+
+	t.Run("Replay with header returns the original invocation", func(t *testing.T) {
+		headers := map[string]string{"Idempotency-Key": "key-1"}
+		status, first := create(t, body, "token1", headers)
+		require.Equal(t, http.StatusCreated, status)
+
+		status, second := create(t, body, "token1", headers)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, first["id"], second["id"])
+		require.Equal(t, string(api.InvocationStateAvailable), second["state"])
+	})
+
+	t.Run("Replay with meta key returns the original invocation", func(t *testing.T) {
+		metaBody := `{"actor":"actor1","meta":{"kind": "test", "idempotency_key": "key-2"},"payload":{}}`
+		status, first := create(t, metaBody, "token1", nil)
+		require.Equal(t, http.StatusCreated, status)
+
+		status, second := create(t, metaBody, "token1", nil)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, first["id"], second["id"])
+	})
+
+	t.Run("Keys are unique per caller", func(t *testing.T) {
+		headers := map[string]string{"Idempotency-Key": "key-3"}
+		status, first := create(t, body, "token1", headers)
+		require.Equal(t, http.StatusCreated, status)
+
+		status, second := create(t, body, "token2", headers)
+		require.Equal(t, http.StatusCreated, status)
+		require.NotEqual(t, first["id"], second["id"])
+	})
+
+	t.Run("Requests without key are not deduplicated", func(t *testing.T) {
+		status, first := create(t, body, "token1", nil)
+		require.Equal(t, http.StatusCreated, status)
+
+		status, second := create(t, body, "token1", nil)
+		require.Equal(t, http.StatusCreated, status)
+		require.NotEqual(t, first["id"], second["id"])
+	})
+
+	t.Run("Invalid meta key", func(t *testing.T) {
+		status, _ := create(t, `{"actor":"actor1","meta":{"idempotency_key": 1},"payload":{}}`, "token1", nil)
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+}
@@ -229,6 +229,15 @@ func TestInvocationExecuteEndpointErrorCases(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   "Meta is required",
 		},
+		{
+			name:           "Invalid priority",
+			body:           `{"actor":"actor1","meta":{"kind": "test"},"payload":{},"priority":9}`,
+			actorName:      "actor1",
+			tokenName:      "token007",
+			permissions:    []string{"create:invocation"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "priority must be between 1 and 8",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,62 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+)
+
+func TestInvocationTraceContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "actor1")
+	fixture.InsertToken(t, ctx, ds, "actor-token", actor.ID, []string{"create:invocation", "read:invocation"})
+
+	getNextMeta := func(t *testing.T) map[string]interface{} {
+		resp, resBody := GetHttp(t, server.URL+"/v1/invocations/next?wait=1", "actor-token")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var job struct {
+			Meta map[string]interface{} `json:"meta"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(resBody), &job))
+		return job.Meta
+	}
+
+	t.Run("Trace context of the request", func(t *testing.T) {
+		resp, _ := PostHttpWithHeader(t, server.URL+"/v1/invocations/async",
+			`{"actor":"actor1","meta":{"kind":"test"},"payload":{}}`, "actor-token",
+			map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		meta := getNextMeta(t)
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", meta["trace_id"])
+		require.Regexp(t, `^00-4bf92f3577b34da6a3ce929d0e0e4736-[0-9a-f]{16}-01$`, meta["traceparent"])
+	})
+
+	t.Run("Trace context given in meta", func(t *testing.T) {
+		resp, _ := PostHttpWithHeader(t, server.URL+"/v1/invocations/async",
+			`{"actor":"actor1","meta":{"kind":"test","trace_id":"abc","traceparent":"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"},"payload":{}}`,
+			"actor-token",
+			map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		meta := getNextMeta(t)
+		require.Equal(t, "abc", meta["trace_id"])
+		require.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", meta["traceparent"])
+	})
+
+	t.Run("No trace context", func(t *testing.T) {
+		resp, _ := PostHttp(t, server.URL+"/v1/invocations/async", `{"actor":"actor1","meta":{"kind":"test"},"payload":{}}`, "actor-token")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		meta := getNextMeta(t)
+		require.NotEmpty(t, meta["trace_id"])
+		require.NotContains(t, meta, "traceparent")
+	})
+}
@@ -0,0 +1,184 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+// MockRerankAdapter is a mock implementation of the RerankAdapter interface
+type MockRerankAdapter struct {
+	mock.Mock
+}
+
+func (m *MockRerankAdapter) Rerank(ctx context.Context, request llm.RerankRequest) (llm.RerankResult, error) {
+	args := m.Called(ctx, request)
+	return args.Get(0).(llm.RerankResult), args.Error(1)
+}
+
+func TestCreateRerank(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"create:completion"})
+
+	mockAdapter := new(MockRerankAdapter)
+
+	originalCreateRerankAdapter := adapter.CreateRerankAdapter
+	adapter.CreateRerankAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.RerankAdapter, error) {
+		if modelId != "test-model" {
+			return nil, fmt.Errorf("model %s not found", modelId)
+		}
+		return mockAdapter, nil
+	}
+	defer func() { adapter.CreateRerankAdapter = originalCreateRerankAdapter }()
+
+	documents := []string{"The capital of France is Paris.", "The capital of Italy is Rome.", "The capital of Spain is Madrid."}
+
+	t.Run("Successful rerank", func(t *testing.T) {
+		expectedRequest := llm.RerankRequest{
+			ModelID:         "test-model",
+			Query:           "What is the capital of Italy?",
+			Documents:       documents,
+			TopK:            lo.ToPtr(2),
+			ReturnDocuments: true,
+		}
+		mockAdapter.On("Rerank", mock.Anything, expectedRequest).Return(llm.RerankResult{
+			Data: []llm.RerankedDocument{
+				{Index: 1, Score: 0.875, Text: "The capital of Italy is Rome."},
+				{Index: 0, Score: 0.25, Text: "The capital of France is Paris."},
+			},
+		}, nil)
+
+		requestBody := api.CreateRerankJSONRequestBody{
+			ModelId:         "test-model",
+			Query:           "What is the capital of Italy?",
+			Documents:       documents,
+			TopK:            lo.ToPtr(2),
+			ReturnDocuments: lo.ToPtr(true),
+		}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var response api.CreateRerank201JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		assert.Equal(t, []api.RerankResult{
+			{Index: 1, Score: 0.875, Text: lo.ToPtr("The capital of Italy is Rome.")},
+			{Index: 0, Score: 0.25, Text: lo.ToPtr("The capital of France is Paris.")},
+		}, response.Data)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Rerank without documents in result", func(t *testing.T) {
+		expectedRequest := llm.RerankRequest{
+			ModelID:   "test-model",
+			Query:     "What is the capital of Spain?",
+			Documents: documents,
+		}
+		mockAdapter.On("Rerank", mock.Anything, expectedRequest).Return(llm.RerankResult{
+			Data: []llm.RerankedDocument{{Index: 2, Score: 0.5}, {Index: 0, Score: 0.125}, {Index: 1, Score: 0.0625}},
+		}, nil)
+
+		requestBody := api.CreateRerankJSONRequestBody{
+			ModelId:   "test-model",
+			Query:     "What is the capital of Spain?",
+			Documents: documents,
+		}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.JSONEq(t, `{"data":[{"index":2,"score":0.5},{"index":0,"score":0.125},{"index":1,"score":0.0625}]}`, resBody)
+
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Provider failure", func(t *testing.T) {
+		expectedRequest := llm.RerankRequest{
+			ModelID:   "test-model",
+			Query:     "Fail it",
+			Documents: documents,
+		}
+		mockAdapter.On("Rerank", mock.Anything, expectedRequest).Return(llm.RerankResult{}, fmt.Errorf("rate limited"))
+
+		requestBody := api.CreateRerankJSONRequestBody{ModelId: "test-model", Query: "Fail it", Documents: documents}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.Contains(t, resBody, "rate limited")
+	})
+
+	t.Run("Unknown model", func(t *testing.T) {
+		requestBody := api.CreateRerankJSONRequestBody{ModelId: "invalid-model", Query: "Hello", Documents: documents}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "Model invalid-model not found")
+	})
+
+	t.Run("Empty documents", func(t *testing.T) {
+		requestBody := api.CreateRerankJSONRequestBody{ModelId: "test-model", Query: "Hello", Documents: []string{}}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "Documents are empty")
+	})
+
+	t.Run("Invalid top_k", func(t *testing.T) {
+		requestBody := api.CreateRerankJSONRequestBody{ModelId: "test-model", Query: "Hello", Documents: documents, TopK: lo.ToPtr(0)}
+		resp, resBody := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "test-token")
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Contains(t, resBody, "top_k must be positive")
+	})
+
+	t.Run("Unauthorized access", func(t *testing.T) {
+		requestBody := api.CreateRerankJSONRequestBody{ModelId: "test-model", Query: "Hello", Documents: documents}
+		resp, _ := PostHttp(t, server.URL+"/v1/rerank", testhelper.SerializeToJson(t, requestBody), "invalid-token")
+
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestListRerankModels(t *testing.T) {
+	ctx := context.Background()
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"read:completion"})
+
+	t.Run("Successful listing of rerank models", func(t *testing.T) {
+		resp, resBody := GetHttp(t, server.URL+"/v1/rerank/models", "test-token")
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response api.ListRerankModels200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+
+		expectedModels := adapter.GetRerankModelList()
+		require.Equal(t, len(expectedModels), len(response.Data))
+		for i, model := range response.Data {
+			require.Equal(t, expectedModels[i].ID, model.Id)
+			require.Equal(t, expectedModels[i].Name, model.Name)
+			require.Equal(t, expectedModels[i].Provider, model.Provider)
+		}
+	})
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		resp, _ := GetHttp(t, server.URL+"/v1/rerank/models", "")
+
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
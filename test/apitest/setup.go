@@ -135,12 +135,14 @@ func builder(t *testing.T, ctx context.Context, pool *pgxpool.Pool) (*httptest.S
 	require.NoError(t, err)
 
 	router := mux.NewRouter()
+	metricsMiddleware := middleware.NewMetricsMiddleware()
+	tracingMiddleware := middleware.NewTracingMiddleware()
 	middleware, cacheCloser := middleware.NewBearerAuthMiddleware(
 		middleware.NewDatabaseApiTokenFetch(pool, ""),
 		10*time.Second,
 	)
 
-	middlewares := []api.StrictMiddlewareFunc{middleware}
+	middlewares := []api.StrictMiddlewareFunc{middleware, metricsMiddleware, tracingMiddleware}
 	options := api.StrictHTTPServerOptions{
 		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			message, _ := json.Marshal(err.Error())
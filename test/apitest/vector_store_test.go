@@ -0,0 +1,194 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/fixture"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+func TestVectorCollection(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	testhelper.RequirePgvector(ctx, t, ds)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "writer-token", actor.ID, []string{"create:vector", "read:vector"})
+	fixture.InsertToken(t, ctx, ds, "reader-token", actor.ID, []string{"read:vector"})
+
+	headers := map[string]string{"MAOS_VECTOR_DATABASE_NAME": "db1"}
+	createBody := api.CreateCollectionJSONRequestBody{
+		Name: "notes",
+		Fields: []api.CollectionField{
+			{Name: "id", DataType: api.INT64, IsPrimary: lo.ToPtr(true)},
+			{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(2)},
+		},
+	}
+
+	t.Run("Create without permission", func(t *testing.T) {
+		resp, _ := PostHttpWithHeader(t, server.URL+"/v1/vector/collection", testhelper.SerializeToJson(t, createBody), "reader-token", headers)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	resp, resBody := PostHttpWithHeader(t, server.URL+"/v1/vector/collection", testhelper.SerializeToJson(t, createBody), "writer-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+
+	resp, resBody = GetHttpWithHeader(t, server.URL+"/v1/vector/collection", "reader-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"data":["notes"]}`, resBody)
+
+	resp, resBody = GetHttp(t, server.URL+"/v1/vector/list", "reader-token")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"data":["db1"]}`, resBody)
+
+	upsertBody := `{"data":[{"id":1,"embedding":[1,0]},{"id":2,"embedding":[0,1]}]}`
+	resp, resBody = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes", upsertBody, "writer-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+	assert.JSONEq(t, `{"upsert_count":2}`, resBody)
+
+	resp, resBody = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query", `{"vector":[0,2],"top_k":1}`, "reader-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+	var response api.QueryCollection200JSONResponse
+	require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+	require.Len(t, response.Data, 1)
+	assert.Equal(t, map[string]interface{}{"id": 2.0}, response.Data[0].Entity)
+	assert.InDelta(t, 0.0, response.Data[0].Distance, 1e-6)
+
+	resp, _ = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/missing/query", `{"vector":[0,2]}`, "reader-token", headers)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestVectorCollectionEmbedding(t *testing.T) {
+	ctx := context.Background()
+
+	server, ds, _ := SetupHttpTestWithDb(t, ctx)
+	testhelper.RequirePgvector(ctx, t, ds)
+	actor := fixture.InsertActor(t, ctx, ds, "test-actor")
+	fixture.InsertToken(t, ctx, ds, "test-token", actor.ID, []string{"create:vector", "read:vector"})
+
+	embeddingModelId := "d68a09df-3589-4273-b032-04488d9b230d-azure-text-embedding-3-small"
+	rerankModelId := "0f6a4e0c-9d0b-4a4e-8d8e-2c5b6f1e7a31-voyage-rerank-1"
+
+	mockEmbeddingAdapter := new(MockEmbeddingAdapter)
+	originalCreateEmbeddingAdapter := adapter.CreateEmbeddingAdapter
+	adapter.CreateEmbeddingAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.EmbeddingAdapter, error) {
+		return mockEmbeddingAdapter, nil
+	}
+	defer func() { adapter.CreateEmbeddingAdapter = originalCreateEmbeddingAdapter }()
+
+	mockRerankAdapter := new(MockRerankAdapter)
+	originalCreateRerankAdapter := adapter.CreateRerankAdapter
+	adapter.CreateRerankAdapter = func(modelId string, credentials adapter.AdapterCredentials) (adapter.RerankAdapter, error) {
+		return mockRerankAdapter, nil
+	}
+	defer func() { adapter.CreateRerankAdapter = originalCreateRerankAdapter }()
+
+	// axis returns the unit vector of the embedding model along the i-th axis.
+	axis := func(i int) []float64 {
+		vector := make([]float64, 1536)
+		vector[i] = 1
+		return vector
+	}
+	// between returns a vector closest to the i-th axis, and then to the j-th axis.
+	between := func(i int, j int) []float64 {
+		vector := axis(i)
+		vector[j] = 0.5
+		return vector
+	}
+
+	headers := map[string]string{"MAOS_VECTOR_DATABASE_NAME": "db1"}
+	createBody := api.CreateCollectionJSONRequestBody{
+		Name: "notes",
+		Fields: []api.CollectionField{
+			{Name: "id", DataType: api.INT64, IsPrimary: lo.ToPtr(true)},
+			{Name: "text", DataType: api.VARCHAR, MaxLength: lo.ToPtr(256)},
+			{
+				Name:      "embedding",
+				DataType:  api.FLOATVECTOR,
+				Embedding: &api.CollectionEmbedding{ModelId: embeddingModelId, TextField: "text"},
+			},
+		},
+	}
+	resp, resBody := PostHttpWithHeader(t, server.URL+"/v1/vector/collection", testhelper.SerializeToJson(t, createBody), "test-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+
+	mockEmbeddingAdapter.On("GetEmbedding", mock.Anything, llm.EmbeddingRequest{
+		ModelID:   embeddingModelId,
+		Input:     []string{"Paris is in France.", "Rome is in Italy."},
+		InputType: lo.ToPtr("document"),
+	}).Return(llm.EmbeddingResult{Data: []llm.Embedding{
+		{Embedding: axis(1), Index: 1},
+		{Embedding: axis(0), Index: 0},
+	}}, nil).Once()
+
+	upsertBody := `{"data":[{"id":1,"text":"Paris is in France."},{"id":2,"text":"Rome is in Italy."},{"id":3,"text":"Madrid","embedding":` +
+		testhelper.SerializeToJson(t, axis(2)) + `}]}`
+	resp, resBody = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes", upsertBody, "test-token", headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+	assert.JSONEq(t, `{"upsert_count":3}`, resBody)
+
+	t.Run("Query text", func(t *testing.T) {
+		mockEmbeddingAdapter.On("GetEmbedding", mock.Anything, llm.EmbeddingRequest{
+			ModelID:   embeddingModelId,
+			Input:     []string{"Where is Rome?"},
+			InputType: lo.ToPtr("query"),
+		}).Return(llm.EmbeddingResult{Data: []llm.Embedding{{Embedding: axis(1), Index: 0}}}, nil).Once()
+
+		resp, resBody := PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query", `{"text":"Where is Rome?","top_k":1}`, "test-token", headers)
+		require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+		var response api.QueryCollection200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, map[string]interface{}{"id": 2.0, "text": "Rome is in Italy."}, response.Data[0].Entity)
+		assert.Nil(t, response.Data[0].RelevanceScore)
+	})
+
+	t.Run("Query text with rerank", func(t *testing.T) {
+		mockEmbeddingAdapter.On("GetEmbedding", mock.Anything, llm.EmbeddingRequest{
+			ModelID:   embeddingModelId,
+			Input:     []string{"Capital of France"},
+			InputType: lo.ToPtr("query"),
+		}).Return(llm.EmbeddingResult{Data: []llm.Embedding{{Embedding: between(2, 0), Index: 0}}}, nil).Once()
+		mockRerankAdapter.On("Rerank", mock.Anything, llm.RerankRequest{
+			ModelID:   rerankModelId,
+			Query:     "Capital of France",
+			Documents: []string{"Madrid", "Paris is in France.", "Rome is in Italy."},
+			TopK:      lo.ToPtr(1),
+		}).Return(llm.RerankResult{Data: []llm.RerankedDocument{{Index: 1, Score: 0.9}}}, nil).Once()
+
+		requestBody := `{"text":"Capital of France","output_fields":["id"],"rerank":{"model_id":"` + rerankModelId + `","top_k":1}}`
+		resp, resBody := PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query", requestBody, "test-token", headers)
+		require.Equal(t, http.StatusOK, resp.StatusCode, resBody)
+		var response api.QueryCollection200JSONResponse
+		require.NoError(t, json.Unmarshal([]byte(resBody), &response))
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, map[string]interface{}{"id": 1.0}, response.Data[0].Entity)
+		assert.Equal(t, lo.ToPtr(0.9), response.Data[0].RelevanceScore)
+	})
+
+	t.Run("Invalid query", func(t *testing.T) {
+		resp, _ := PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query", `{}`, "test-token", headers)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query",
+			`{"vector":[0],"rerank":{"model_id":"`+rerankModelId+`"}}`, "test-token", headers)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = PostHttpWithHeader(t, server.URL+"/v1/vector/collection/notes/query",
+			`{"text":"Capital","rerank":{"model_id":"unknown"}}`, "test-token", headers)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	mockEmbeddingAdapter.AssertExpectations(t)
+	mockRerankAdapter.AssertExpectations(t)
+}
@@ -0,0 +1,510 @@
+// Package vectorstore hosts the vector collections of the agents in the Postgres database of maos-core with pgvector.
+// Each collection is a table named after its ID, and the definitions of the collections are kept in the
+// vector_collections table.
+package vectorstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess"
+	"gitlab.com/navyx/ai/maos/maos-core/dbaccess/dbsqlc"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+const (
+	defaultTopK = 10
+	maxTopK     = 1024
+
+	maxDatabaseNameLength = 255
+
+	// maxStatementParameters is the maximum number of parameters of a Postgres statement.
+	maxStatementParameters = 65535
+)
+
+var querier = dbsqlc.New()
+
+// ErrPgvectorNotInstalled is returned by CheckPgvector when the vector extension is not installed in the database.
+// Creating it needs privileges that maos-core is not expected to have, so it's left to the database administrator.
+var ErrPgvectorNotInstalled = errors.New("pgvector extension is not installed, run CREATE EXTENSION vector to enable vector collections")
+
+// CheckPgvector returns ErrPgvectorNotInstalled if the pgvector extension is not installed in the database.
+func CheckPgvector(ctx context.Context, ds dbaccess.DataSource) error {
+	installed, err := querier.VectorExtensionInstalled(ctx, ds)
+	if err != nil {
+		return fmt.Errorf("cannot check pgvector extension: %w", err)
+	}
+	if !installed {
+		return ErrPgvectorNotInstalled
+	}
+	return nil
+}
+
+func ListVectorStores(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.ListVectoreStoresRequestObject) (api.ListVectoreStoresResponseObject, error) {
+	logger.Info("ListVectorStores")
+
+	databases, err := querier.VectorCollectionListDatabases(ctx, ds)
+	if err != nil {
+		logger.Error("Cannot list vector databases", "error", err)
+		return api.ListVectoreStores500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list vector databases: %v", err)},
+		}, nil
+	}
+	return api.ListVectoreStores200JSONResponse{Data: lo.Ternary(databases == nil, []string{}, databases)}, nil
+}
+
+func ListCollections(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.ListCollectionRequestObject) (api.ListCollectionResponseObject, error) {
+	logger.Info("ListCollections", "database", request.Params.MAOSVECTORDATABASENAME)
+
+	names, err := querier.VectorCollectionListByDatabase(ctx, ds, request.Params.MAOSVECTORDATABASENAME)
+	if err != nil {
+		logger.Error("Cannot list collections", "error", err)
+		return api.ListCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot list collections: %v", err)},
+		}, nil
+	}
+	return api.ListCollection200JSONResponse{Data: lo.Ternary(names == nil, []string{}, names)}, nil
+}
+
+func CreateCollection(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, request api.CreateCollectionRequestObject) (api.CreateCollectionResponseObject, error) {
+	database := request.Params.MAOSVECTORDATABASENAME
+	logger.Info("CreateCollection", "database", database, "name", request.Body.Name)
+
+	if err := validateNames(database, request.Body.Name); err != nil {
+		return api.CreateCollection400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: err.Error()}}, nil
+	}
+	schema, err := validateSchema(request.Body.Fields, lo.FromPtr(request.Body.Indexes))
+	if err != nil {
+		return api.CreateCollection400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: err.Error()}}, nil
+	}
+
+	fields, _ := json.Marshal(schema.Fields)
+	indexes, _ := json.Marshal(schema.Indexes)
+
+	if err := CheckPgvector(ctx, ds); err != nil {
+		logger.Error("Cannot create collection", "error", err)
+		return api.CreateCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create collection: %v", err)},
+		}, nil
+	}
+
+	tx, err := ds.Begin(ctx)
+	if err != nil {
+		logger.Error("Cannot start transaction", "error", err)
+		return api.CreateCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot start transaction: %v", err)},
+		}, nil
+	}
+	defer tx.Rollback(ctx)
+
+	collection, err := querier.VectorCollectionInsert(ctx, tx, &dbsqlc.VectorCollectionInsertParams{
+		DatabaseName: database,
+		Name:         request.Body.Name,
+		Fields:       fields,
+		Indexes:      indexes,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.CreateCollection409Response{}, nil
+		}
+		logger.Error("Cannot insert collection", "error", err)
+		return api.CreateCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create collection: %v", err)},
+		}, nil
+	}
+
+	for _, statement := range createTableStatements(collection.ID, schema) {
+		if _, err := tx.Exec(ctx, statement); err != nil {
+			logger.Error("Cannot create collection table", "statement", statement, "error", err)
+			return api.CreateCollection500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create collection: %v", err)},
+			}, nil
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Cannot commit transaction", "error", err)
+		return api.CreateCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot create collection: %v", err)},
+		}, nil
+	}
+
+	return api.CreateCollection200JSONResponse{Data: collection.Name}, nil
+}
+
+func UpsertCollection(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, credentials adapter.AdapterCredentials, request api.UpsertCollectionRequestObject) (api.UpsertCollectionResponseObject, error) {
+	logger.Info("UpsertCollection", "database", request.Params.MAOSVECTORDATABASENAME, "name", request.Name, "count", len(request.Body.Data))
+
+	collection, schema, err := findCollection(ctx, ds, request.Params.MAOSVECTORDATABASENAME, request.Name)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.UpsertCollection404Response{}, nil
+		}
+		logger.Error("Cannot get collection", "error", err)
+		return api.UpsertCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get collection: %v", err)},
+		}, nil
+	}
+
+	rows, err := encodeRows(schema, request.Body.Data)
+	if err != nil {
+		return api.UpsertCollection400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: err.Error()}}, nil
+	}
+	if err := embedRows(ctx, credentials, schema, request.Body.Data, rows); err != nil {
+		logger.Error("Cannot embed data", "error", err)
+		return api.UpsertCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot embed data: %v", err)},
+		}, nil
+	}
+
+	err = dbaccess.WithTx(ctx, ds, func(ctx context.Context, tx dbaccess.DataSource) error {
+		for _, chunk := range lo.Chunk(rows, maxStatementParameters/len(schema.Fields)) {
+			if _, err := tx.Exec(ctx, upsertStatement(collection.ID, schema, len(chunk)), lo.Flatten(chunk)...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("Cannot upsert collection", "error", err)
+		return api.UpsertCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot upsert collection: %v", err)},
+		}, nil
+	}
+
+	return api.UpsertCollection200JSONResponse{UpsertCount: len(rows)}, nil
+}
+
+func QueryCollection(ctx context.Context, logger *slog.Logger, ds dbaccess.DataSource, credentials adapter.AdapterCredentials, request api.QueryCollectionRequestObject) (api.QueryCollectionResponseObject, error) {
+	logger.Info("QueryCollection", "database", request.Params.MAOSVECTORDATABASENAME, "name", request.Name, "topK", request.Body.TopK)
+
+	collection, schema, err := findCollection(ctx, ds, request.Params.MAOSVECTORDATABASENAME, request.Name)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return api.QueryCollection404Response{}, nil
+		}
+		logger.Error("Cannot get collection", "error", err)
+		return api.QueryCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot get collection: %v", err)},
+		}, nil
+	}
+
+	query, err := buildQuery(collection.ID, schema, *request.Body)
+	if err != nil {
+		return api.QueryCollection400JSONResponse{N400JSONResponse: api.N400JSONResponse{Error: err.Error()}}, nil
+	}
+	if query.text != nil {
+		if err := query.embedQuery(ctx, credentials); err != nil {
+			logger.Error("Cannot embed query text", "error", err)
+			return api.QueryCollection500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot embed query text: %v", err)},
+			}, nil
+		}
+	}
+
+	hits, err := query.run(ctx, ds)
+	if err != nil {
+		logger.Error("Cannot query collection", "error", err)
+		return api.QueryCollection500JSONResponse{
+			N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot query collection: %v", err)},
+		}, nil
+	}
+
+	if query.rerankOptions != nil {
+		if hits, err = query.rerank(ctx, credentials, hits); err != nil {
+			logger.Error("Cannot rerank query result", "error", err)
+			return api.QueryCollection500JSONResponse{
+				N500JSONResponse: api.N500JSONResponse{Error: fmt.Sprintf("Cannot rerank query result: %v", err)},
+			}, nil
+		}
+	}
+	return api.QueryCollection200JSONResponse{Data: hits}, nil
+}
+
+func validateNames(database string, collection string) error {
+	if database == "" || len(database) > maxDatabaseNameLength {
+		return invalidf("Database name must have 1 to %d characters", maxDatabaseNameLength)
+	}
+	return validateIdentifier("collection", collection)
+}
+
+func findCollection(ctx context.Context, ds dbaccess.DataSource, database string, name string) (*dbsqlc.VectorCollection, collectionSchema, error) {
+	collection, err := querier.VectorCollectionFindByName(ctx, ds, &dbsqlc.VectorCollectionFindByNameParams{
+		DatabaseName: database,
+		Name:         name,
+	})
+	if err != nil {
+		return nil, collectionSchema{}, err
+	}
+
+	var schema collectionSchema
+	if err := json.Unmarshal(collection.Fields, &schema.Fields); err != nil {
+		return nil, collectionSchema{}, fmt.Errorf("invalid fields of collection %d: %w", collection.ID, err)
+	}
+	if err := json.Unmarshal(collection.Indexes, &schema.Indexes); err != nil {
+		return nil, collectionSchema{}, fmt.Errorf("invalid indexes of collection %d: %w", collection.ID, err)
+	}
+	return collection, schema, nil
+}
+
+// encodeRows converts the data into the parameters of the upsert statement, in the order of the fields.
+func encodeRows(schema collectionSchema, data []map[string]interface{}) ([][]interface{}, error) {
+	if len(data) == 0 {
+		return nil, invalidf("Data are empty")
+	}
+
+	primary := schema.primaryField()
+	primaryKeys := make(map[interface{}]bool, len(data))
+	rows := make([][]interface{}, 0, len(data))
+	for _, item := range data {
+		for name := range item {
+			if _, ok := schema.field(name); !ok {
+				return nil, invalidf("Field not found: %s", name)
+			}
+		}
+
+		row := make([]interface{}, 0, len(schema.Fields))
+		for _, field := range schema.Fields {
+			value, err := encodeValue(field, item[field.Name])
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, value)
+		}
+
+		primaryKey := item[primary.Name]
+		if primaryKey == nil {
+			return nil, invalidf("Primary field %s is missing", primary.Name)
+		}
+		if primaryKeys[primaryKey] {
+			return nil, invalidf("Duplicate primary key: %v", primaryKey)
+		}
+		primaryKeys[primaryKey] = true
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// upsertStatement returns the statement inserting rowCount rows into the collection table,
+// which replaces the rows with the same primary keys.
+func upsertStatement(collectionId int64, schema collectionSchema, rowCount int) string {
+	columns := lo.Map(schema.Fields, func(field api.CollectionField, _ int) string { return quote(field.Name) })
+
+	values := make([]string, 0, rowCount)
+	for i := 0; i < rowCount; i++ {
+		placeholders := lo.Map(schema.Fields, func(field api.CollectionField, j int) string {
+			return placeholder(field, i*len(schema.Fields)+j+1)
+		})
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+	}
+
+	statement := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON CONFLICT (%s)",
+		quote(tableName(collectionId)), strings.Join(columns, ", "), strings.Join(values, ", "), quote(schema.primaryField().Name))
+
+	updates := lo.FilterMap(schema.Fields, func(field api.CollectionField, _ int) (string, bool) {
+		return fmt.Sprintf("%s = EXCLUDED.%s", quote(field.Name), quote(field.Name)), !lo.FromPtr(field.IsPrimary)
+	})
+	if len(updates) == 0 {
+		return statement + " DO NOTHING"
+	}
+	return statement + " DO UPDATE SET " + strings.Join(updates, ", ")
+}
+
+// collectionQuery is a similarity search on a collection table.
+type collectionQuery struct {
+	statement string
+	// args starts with the query vector, which is left nil until the query text is embedded if text is set.
+	args         []interface{}
+	outputFields []api.CollectionField
+	vectorField  api.CollectionField
+	text         *string
+
+	rerankOptions *api.CollectionRerank
+	// hideTextField tells the text field is selected only for reranking, and removed from the entities afterwards.
+	hideTextField bool
+}
+
+func buildQuery(collectionId int64, schema collectionSchema, body api.QueryCollectionJSONRequestBody) (collectionQuery, error) {
+	topK := defaultTopK
+	if body.TopK != nil {
+		if *body.TopK < 1 || *body.TopK > maxTopK {
+			return collectionQuery{}, invalidf("top_k must be between 1 and %d", maxTopK)
+		}
+		topK = *body.TopK
+	}
+
+	vectorField, err := queryVectorField(schema, body.VectorField)
+	if err != nil {
+		return collectionQuery{}, err
+	}
+	if (body.Vector == nil || *body.Vector == nil) == (body.Text == nil) {
+		return collectionQuery{}, invalidf("Either vector or text is required")
+	}
+	var vector interface{}
+	if body.Text != nil {
+		if vectorField.Embedding == nil {
+			return collectionQuery{}, invalidf("Vector field %s has no embedding for text query", vectorField.Name)
+		}
+		if *body.Text == "" {
+			return collectionQuery{}, invalidf("Text is empty")
+		}
+	} else if vector, err = encodeValue(vectorField, *body.Vector); err != nil {
+		return collectionQuery{}, err
+	}
+
+	outputFields := lo.Filter(schema.Fields, func(field api.CollectionField, _ int) bool { return !isVectorField(field) })
+	if body.OutputFields != nil {
+		outputFields = make([]api.CollectionField, 0, len(*body.OutputFields))
+		for _, name := range *body.OutputFields {
+			field, ok := schema.field(name)
+			if !ok {
+				return collectionQuery{}, invalidf("Output field not found: %s", name)
+			}
+			outputFields = append(outputFields, field)
+		}
+	}
+
+	query := collectionQuery{
+		args:          []interface{}{vector},
+		outputFields:  outputFields,
+		vectorField:   vectorField,
+		text:          body.Text,
+		rerankOptions: body.Rerank,
+	}
+	if body.Rerank != nil {
+		if body.Text == nil {
+			return collectionQuery{}, invalidf("Rerank requires text query")
+		}
+		if _, ok := adapter.GetRerankModelByID(body.Rerank.ModelId); !ok {
+			return collectionQuery{}, invalidf("Rerank model %s not found", body.Rerank.ModelId)
+		}
+		if body.Rerank.TopK != nil && *body.Rerank.TopK < 1 {
+			return collectionQuery{}, invalidf("top_k of rerank must be positive")
+		}
+		textField, _ := schema.field(vectorField.Embedding.TextField)
+		if !slices.ContainsFunc(outputFields, func(field api.CollectionField) bool { return field.Name == textField.Name }) {
+			query.outputFields = append(query.outputFields, textField)
+			query.hideTextField = true
+		}
+	}
+	conditions := []string{quote(vectorField.Name) + " IS NOT NULL"}
+	filter := lo.FromPtr(body.Filter)
+	names := lo.Keys(filter)
+	slices.Sort(names)
+	for _, name := range names {
+		condition, err := query.filterCondition(schema, name, filter[name])
+		if err != nil {
+			return collectionQuery{}, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	columns := lo.Map(query.outputFields, func(field api.CollectionField, _ int) string {
+		if isVectorField(field) {
+			return quote(field.Name) + "::text"
+		}
+		return quote(field.Name)
+	})
+	distance := fmt.Sprintf("%s %s %s", quote(vectorField.Name), distanceOperators[schema.metric(vectorField)], placeholder(vectorField, 1))
+	query.statement = fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT %d",
+		strings.Join(append(columns, distance), ", "), quote(tableName(collectionId)), strings.Join(conditions, " AND "), distance, topK)
+	return query, nil
+}
+
+func queryVectorField(schema collectionSchema, name *string) (api.CollectionField, error) {
+	if name != nil {
+		field, ok := schema.field(*name)
+		if !ok || !isVectorField(field) {
+			return api.CollectionField{}, invalidf("Vector field not found: %s", *name)
+		}
+		return field, nil
+	}
+
+	vectorFields := lo.Filter(schema.Fields, func(field api.CollectionField, _ int) bool { return isVectorField(field) })
+	if len(vectorFields) != 1 {
+		return api.CollectionField{}, invalidf("vector_field is required for collection with several vector fields")
+	}
+	return vectorFields[0], nil
+}
+
+// filterCondition returns the condition matching the field with the value, and adds its parameters to the query.
+func (q *collectionQuery) filterCondition(schema collectionSchema, name string, value interface{}) (string, error) {
+	field, ok := schema.field(name)
+	if !ok {
+		return "", invalidf("Filter field not found: %s", name)
+	}
+	if isVectorField(field) {
+		return "", invalidf("Vector field %s cannot be filtered", name)
+	}
+
+	if value == nil {
+		return quote(name) + " IS NULL", nil
+	}
+	if isJsonField(field) {
+		encoded, err := encodeValue(api.CollectionField{Name: name, DataType: api.JSON}, value)
+		if err != nil {
+			return "", err
+		}
+		q.args = append(q.args, encoded)
+		return fmt.Sprintf("%s @> %s", quote(name), placeholder(field, len(q.args))), nil
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+	if len(values) == 0 {
+		return "FALSE", nil
+	}
+	placeholders := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded, err := encodeValue(field, value)
+		if err != nil {
+			return "", err
+		}
+		q.args = append(q.args, encoded)
+		placeholders = append(placeholders, placeholder(field, len(q.args)))
+	}
+	return fmt.Sprintf("%s IN (%s)", quote(name), strings.Join(placeholders, ", ")), nil
+}
+
+func (q *collectionQuery) run(ctx context.Context, ds dbaccess.DataSource) ([]api.CollectionHit, error) {
+	rows, err := ds.Query(ctx, q.statement, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := []api.CollectionHit{}
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+
+		entity := make(map[string]interface{}, len(q.outputFields))
+		for i, field := range q.outputFields {
+			entity[field.Name] = values[i]
+			if text, ok := values[i].(string); ok && isVectorField(field) {
+				if entity[field.Name], err = decodeVector(field, text); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		distance, ok := values[len(q.outputFields)].(float64)
+		if !ok {
+			return nil, errors.New("unexpected distance type")
+		}
+		hits = append(hits, api.CollectionHit{Distance: distance, Entity: entity})
+	}
+	return hits, rows.Err()
+}
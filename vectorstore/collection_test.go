@@ -0,0 +1,191 @@
+package vectorstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/internal/testhelper"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+	"gitlab.com/navyx/ai/maos/maos-core/vectorstore"
+)
+
+func queryVector(elements ...interface{}) *interface{} {
+	return lo.ToPtr[interface{}](elements)
+}
+
+func TestCollectionWithDB(t *testing.T) {
+	t.Parallel()
+	logger := testhelper.Logger(t)
+	ctx := context.Background()
+
+	dbPool := testhelper.TestDB(ctx, t)
+	defer dbPool.Close()
+	testhelper.RequirePgvector(ctx, t, dbPool)
+
+	params := api.CreateCollectionParams{MAOSVECTORDATABASENAME: "db1"}
+	createResponse, err := vectorstore.CreateCollection(ctx, logger, dbPool, api.CreateCollectionRequestObject{
+		Params: params,
+		Body: &api.CreateCollectionJSONRequestBody{
+			Name: "documents",
+			Fields: []api.CollectionField{
+				{Name: "id", DataType: api.VARCHAR, MaxLength: lo.ToPtr(32), IsPrimary: lo.ToPtr(true)},
+				{Name: "category", DataType: api.INT32},
+				{Name: "meta", DataType: api.JSON},
+				{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(2)},
+			},
+			Indexes: &[]api.CollectionIndex{
+				{FieldName: "embedding", MetricType: lo.ToPtr(api.L2)},
+				{FieldName: "category"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.CreateCollection200JSONResponse{Data: "documents"}, createResponse)
+
+	t.Run("Duplicate collection", func(t *testing.T) {
+		response, err := vectorstore.CreateCollection(ctx, logger, dbPool, api.CreateCollectionRequestObject{
+			Params: params,
+			Body: &api.CreateCollectionJSONRequestBody{
+				Name: "documents",
+				Fields: []api.CollectionField{
+					{Name: "id", DataType: api.INT64, IsPrimary: lo.ToPtr(true)},
+					{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(2)},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.CreateCollection409Response{}, response)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		response, err := vectorstore.ListVectorStores(ctx, logger, dbPool, api.ListVectoreStoresRequestObject{})
+		require.NoError(t, err)
+		assert.Equal(t, api.ListVectoreStores200JSONResponse{Data: []string{"db1"}}, response)
+
+		listResponse, err := vectorstore.ListCollections(ctx, logger, dbPool, api.ListCollectionRequestObject{
+			Params: api.ListCollectionParams{MAOSVECTORDATABASENAME: "db1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, api.ListCollection200JSONResponse{Data: []string{"documents"}}, listResponse)
+
+		listResponse, err = vectorstore.ListCollections(ctx, logger, dbPool, api.ListCollectionRequestObject{
+			Params: api.ListCollectionParams{MAOSVECTORDATABASENAME: "db2"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, api.ListCollection200JSONResponse{Data: []string{}}, listResponse)
+	})
+
+	upsert := func(data ...map[string]interface{}) (api.UpsertCollectionResponseObject, error) {
+		return vectorstore.UpsertCollection(ctx, logger, dbPool, adapter.AdapterCredentials{}, api.UpsertCollectionRequestObject{
+			Name:   "documents",
+			Params: api.UpsertCollectionParams{MAOSVECTORDATABASENAME: "db1"},
+			Body:   &api.UpsertCollectionJSONRequestBody{Data: data},
+		})
+	}
+	query := func(body api.QueryCollectionJSONRequestBody) (api.QueryCollectionResponseObject, error) {
+		return vectorstore.QueryCollection(ctx, logger, dbPool, adapter.AdapterCredentials{}, api.QueryCollectionRequestObject{
+			Name:   "documents",
+			Params: api.QueryCollectionParams{MAOSVECTORDATABASENAME: "db1"},
+			Body:   &body,
+		})
+	}
+
+	response, err := upsert(
+		map[string]interface{}{"id": "a", "category": 1.0, "meta": map[string]interface{}{"lang": "en"}, "embedding": []interface{}{0.0, 0.0}},
+		map[string]interface{}{"id": "b", "category": 2.0, "meta": map[string]interface{}{"lang": "fr"}, "embedding": []interface{}{1.0, 0.0}},
+		map[string]interface{}{"id": "c", "category": 1.0, "embedding": []interface{}{3.0, 4.0}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, api.UpsertCollection200JSONResponse{UpsertCount: 3}, response)
+
+	t.Run("Upsert replaces by primary key", func(t *testing.T) {
+		response, err := upsert(map[string]interface{}{"id": "c", "category": 3.0, "embedding": []interface{}{0.0, 2.0}})
+		require.NoError(t, err)
+		require.Equal(t, api.UpsertCollection200JSONResponse{UpsertCount: 1}, response)
+
+		queryResponse, err := query(api.QueryCollectionJSONRequestBody{
+			Vector: queryVector(0.0, 2.0),
+			Filter: &map[string]interface{}{"id": "c"},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.QueryCollection200JSONResponse{}, queryResponse)
+		hits := queryResponse.(api.QueryCollection200JSONResponse).Data
+		require.Len(t, hits, 1)
+		assert.Equal(t, 0.0, hits[0].Distance)
+		assert.Equal(t, int32(3), hits[0].Entity["category"])
+	})
+
+	t.Run("Invalid upsert", func(t *testing.T) {
+		response, err := upsert(map[string]interface{}{"category": 1.0})
+		require.NoError(t, err)
+		require.IsType(t, api.UpsertCollection400JSONResponse{}, response)
+
+		response, err = upsert(map[string]interface{}{"id": "d", "embedding": []interface{}{1.0}})
+		require.NoError(t, err)
+		require.IsType(t, api.UpsertCollection400JSONResponse{}, response)
+
+		response, err = upsert(map[string]interface{}{"id": "d", "unknown": 1.0})
+		require.NoError(t, err)
+		require.IsType(t, api.UpsertCollection400JSONResponse{}, response)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		response, err := query(api.QueryCollectionJSONRequestBody{
+			Vector:       queryVector(0.9, 0.0),
+			TopK:         lo.ToPtr(2),
+			OutputFields: &[]string{"id", "embedding"},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.QueryCollection200JSONResponse{}, response)
+		hits := response.(api.QueryCollection200JSONResponse).Data
+		require.Len(t, hits, 2)
+		assert.Equal(t, "b", hits[0].Entity["id"])
+		assert.Equal(t, []float64{1, 0}, hits[0].Entity["embedding"])
+		assert.InDelta(t, 0.1, hits[0].Distance, 1e-6)
+		assert.Equal(t, "a", hits[1].Entity["id"])
+	})
+
+	t.Run("Query with filter", func(t *testing.T) {
+		response, err := query(api.QueryCollectionJSONRequestBody{
+			Vector: queryVector(1.0, 0.0),
+			Filter: &map[string]interface{}{"category": []interface{}{1.0, 3.0}},
+		})
+		require.NoError(t, err)
+		hits := response.(api.QueryCollection200JSONResponse).Data
+		assert.Equal(t, []string{"a", "c"}, lo.Map(hits, func(hit api.CollectionHit, _ int) string { return hit.Entity["id"].(string) }))
+
+		response, err = query(api.QueryCollectionJSONRequestBody{
+			Vector: queryVector(1.0, 0.0),
+			Filter: &map[string]interface{}{"meta": map[string]interface{}{"lang": "fr"}},
+		})
+		require.NoError(t, err)
+		hits = response.(api.QueryCollection200JSONResponse).Data
+		require.Len(t, hits, 1)
+		assert.Equal(t, "b", hits[0].Entity["id"])
+		assert.Equal(t, map[string]interface{}{"lang": "fr"}, hits[0].Entity["meta"])
+	})
+
+	t.Run("Invalid query", func(t *testing.T) {
+		response, err := query(api.QueryCollectionJSONRequestBody{Vector: queryVector(1.0, 0.0), Filter: &map[string]interface{}{"unknown": 1.0}})
+		require.NoError(t, err)
+		require.IsType(t, api.QueryCollection400JSONResponse{}, response)
+
+		response, err = query(api.QueryCollectionJSONRequestBody{Vector: lo.ToPtr[interface{}]("text")})
+		require.NoError(t, err)
+		require.IsType(t, api.QueryCollection400JSONResponse{}, response)
+	})
+
+	t.Run("Unknown collection", func(t *testing.T) {
+		response, err := vectorstore.QueryCollection(ctx, logger, dbPool, adapter.AdapterCredentials{}, api.QueryCollectionRequestObject{
+			Name:   "documents",
+			Params: api.QueryCollectionParams{MAOSVECTORDATABASENAME: "db2"},
+			Body:   &api.QueryCollectionJSONRequestBody{Vector: queryVector(1.0, 0.0)},
+		})
+		require.NoError(t, err)
+		require.IsType(t, api.QueryCollection404Response{}, response)
+	})
+}
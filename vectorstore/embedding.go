@@ -0,0 +1,158 @@
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+	"gitlab.com/navyx/ai/maos/maos-core/llm"
+	"gitlab.com/navyx/ai/maos/maos-core/llm/adapter"
+)
+
+const (
+	embeddingInputTypeDocument = "document"
+	embeddingInputTypeQuery    = "query"
+)
+
+// validateEmbedding checks the embedding of a vector field, and returns the field with the dimension of the model
+// when it's omitted.
+func validateEmbedding(field api.CollectionField, fields []api.CollectionField) (api.CollectionField, error) {
+	if field.Embedding == nil {
+		return field, nil
+	}
+	if field.DataType != api.FLOATVECTOR && field.DataType != api.FLOAT16VECTOR {
+		return field, invalidf("Field %s of %s type cannot have embedding", field.Name, field.DataType)
+	}
+
+	model, ok := adapter.GetEmbeddingModelByID(field.Embedding.ModelId)
+	if !ok {
+		return field, invalidf("Embedding model %s not found", field.Embedding.ModelId)
+	}
+	if field.Dim == nil {
+		field.Dim = lo.ToPtr(model.Dimension)
+	} else if *field.Dim != model.Dimension {
+		return field, invalidf("Field %s must have dim %d of embedding model %s", field.Name, model.Dimension, model.ID)
+	}
+
+	textField, ok := lo.Find(fields, func(f api.CollectionField) bool { return f.Name == field.Embedding.TextField })
+	if !ok || textField.DataType != api.VARCHAR {
+		return field, invalidf("Text field of embedding must be a VARCHAR field: %s", field.Embedding.TextField)
+	}
+	return field, nil
+}
+
+// embed returns the vectors of the texts generated by the embedding model, in the form accepted by encodeValue.
+func embed(ctx context.Context, credentials adapter.AdapterCredentials, embedding api.CollectionEmbedding, texts []string, inputType string) ([][]interface{}, error) {
+	embeddingAdapter, err := adapter.CreateEmbeddingAdapter(embedding.ModelId, credentials)
+	if err != nil {
+		return nil, err
+	}
+	result, err := embeddingAdapter.GetEmbedding(ctx, llm.EmbeddingRequest{
+		ModelID:   embedding.ModelId,
+		Input:     texts,
+		InputType: lo.ToPtr(inputType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]interface{}, len(texts))
+	for _, item := range result.Data {
+		if item.Index < 0 || item.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding index %d out of range", item.Index)
+		}
+		vectors[item.Index] = lo.ToAnySlice(item.Embedding)
+	}
+	if slices.ContainsFunc(vectors, func(vector []interface{}) bool { return vector == nil }) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(texts), len(result.Data))
+	}
+	return vectors, nil
+}
+
+// embedRows fills the vectors omitted in the data from their text fields, into the rows encoded by encodeRows.
+func embedRows(ctx context.Context, credentials adapter.AdapterCredentials, schema collectionSchema, data []map[string]interface{}, rows [][]interface{}) error {
+	for column, field := range schema.Fields {
+		if field.Embedding == nil {
+			continue
+		}
+
+		var indexes []int
+		var texts []string
+		for i, item := range data {
+			text, ok := item[field.Embedding.TextField].(string)
+			if item[field.Name] == nil && ok && text != "" {
+				indexes = append(indexes, i)
+				texts = append(texts, text)
+			}
+		}
+		if len(texts) == 0 {
+			continue
+		}
+
+		vectors, err := embed(ctx, credentials, *field.Embedding, texts, embeddingInputTypeDocument)
+		if err != nil {
+			return fmt.Errorf("cannot embed field %s: %w", field.Name, err)
+		}
+		for i, vector := range vectors {
+			if rows[indexes[i]][column], err = encodeValue(field, vector); err != nil {
+				return fmt.Errorf("cannot embed field %s: %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// embedQuery sets the query vector from the embedding of the query text.
+func (q *collectionQuery) embedQuery(ctx context.Context, credentials adapter.AdapterCredentials) error {
+	vectors, err := embed(ctx, credentials, *q.vectorField.Embedding, []string{*q.text}, embeddingInputTypeQuery)
+	if err != nil {
+		return err
+	}
+	q.args[0], err = encodeValue(q.vectorField, vectors[0])
+	return err
+}
+
+// rerank sorts the hits by the relevance of their texts to the query text.
+func (q *collectionQuery) rerank(ctx context.Context, credentials adapter.AdapterCredentials, hits []api.CollectionHit) ([]api.CollectionHit, error) {
+	textField := q.vectorField.Embedding.TextField
+	defer func() {
+		if q.hideTextField {
+			for _, hit := range hits {
+				delete(hit.Entity, textField)
+			}
+		}
+	}()
+	if len(hits) == 0 {
+		return hits, nil
+	}
+
+	rerankAdapter, err := adapter.CreateRerankAdapter(q.rerankOptions.ModelId, credentials)
+	if err != nil {
+		return nil, err
+	}
+	result, err := rerankAdapter.Rerank(ctx, llm.RerankRequest{
+		ModelID: q.rerankOptions.ModelId,
+		Query:   *q.text,
+		Documents: lo.Map(hits, func(hit api.CollectionHit, _ int) string {
+			text, _ := hit.Entity[textField].(string)
+			return text
+		}),
+		TopK: q.rerankOptions.TopK,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reranked := make([]api.CollectionHit, 0, len(result.Data))
+	for _, document := range result.Data {
+		if document.Index < 0 || document.Index >= len(hits) {
+			return nil, fmt.Errorf("rerank index %d out of range", document.Index)
+		}
+		hit := hits[document.Index]
+		hit.RelevanceScore = lo.ToPtr(document.Score)
+		reranked = append(reranked, hit)
+	}
+	return reranked, nil
+}
@@ -0,0 +1,343 @@
+package vectorstore
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+)
+
+const (
+	// maxIdentifierLength is the maximum length of a Postgres identifier.
+	maxIdentifierLength = 63
+
+	defaultHnswM        = 16
+	defaultIvfFlatLists = 100
+)
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// invalidf formats an error caused by the request, whose message is reported in the bad request response.
+func invalidf(format string, args ...interface{}) error {
+	return fmt.Errorf(format, args...)
+}
+
+// vectorKind tells how a vector data type is stored by pgvector.
+type vectorKind struct {
+	// typeName is the pgvector type, which is also the prefix of its operator classes.
+	typeName      string
+	defaultMetric api.CollectionIndexMetricType
+	metrics       []api.CollectionIndexMetricType
+	indexTypes    []api.CollectionIndexIndexType
+	maxDimension  int
+}
+
+var floatMetrics = []api.CollectionIndexMetricType{api.L2, api.IP, api.COSINE}
+
+var vectorKinds = map[api.CollectionDataType]vectorKind{
+	api.FLOATVECTOR: {
+		typeName:      "vector",
+		defaultMetric: api.COSINE,
+		metrics:       floatMetrics,
+		indexTypes:    []api.CollectionIndexIndexType{api.FLAT, api.HNSW, api.IVFFLAT},
+		maxDimension:  16000,
+	},
+	api.FLOAT16VECTOR: {
+		typeName:      "halfvec",
+		defaultMetric: api.COSINE,
+		metrics:       floatMetrics,
+		indexTypes:    []api.CollectionIndexIndexType{api.FLAT, api.HNSW, api.IVFFLAT},
+		maxDimension:  16000,
+	},
+	api.SPARSEFLOATVECTOR: {
+		typeName:      "sparsevec",
+		defaultMetric: api.IP,
+		metrics:       floatMetrics,
+		indexTypes:    []api.CollectionIndexIndexType{api.FLAT, api.HNSW, api.SPARSEINVERTEDINDEX},
+		maxDimension:  1000000000,
+	},
+	api.BINARYVECTOR: {
+		typeName:      "bit",
+		defaultMetric: api.HAMMING,
+		metrics:       []api.CollectionIndexMetricType{api.HAMMING, api.JACCARD},
+		indexTypes:    []api.CollectionIndexIndexType{api.BINFLAT, api.HNSW, api.BINIVFFLAT},
+		maxDimension:  64000,
+	},
+}
+
+var distanceOperators = map[api.CollectionIndexMetricType]string{
+	api.L2:      "<->",
+	api.IP:      "<#>",
+	api.COSINE:  "<=>",
+	api.HAMMING: "<~>",
+	api.JACCARD: "<%>",
+}
+
+// collectionSchema is the validated definition of a collection.
+type collectionSchema struct {
+	Fields  []api.CollectionField `json:"fields"`
+	Indexes []api.CollectionIndex `json:"indexes"`
+}
+
+func isVectorField(field api.CollectionField) bool {
+	_, ok := vectorKinds[field.DataType]
+	return ok
+}
+
+func isJsonField(field api.CollectionField) bool {
+	return field.DataType == api.JSON || field.DataType == api.ARRAY
+}
+
+func (s *collectionSchema) field(name string) (api.CollectionField, bool) {
+	return lo.Find(s.Fields, func(field api.CollectionField) bool { return field.Name == name })
+}
+
+func (s *collectionSchema) primaryField() api.CollectionField {
+	field, _ := lo.Find(s.Fields, func(field api.CollectionField) bool { return lo.FromPtr(field.IsPrimary) })
+	return field
+}
+
+// metric returns the metric used to measure the distance of the given vector field.
+func (s *collectionSchema) metric(field api.CollectionField) api.CollectionIndexMetricType {
+	for _, index := range s.Indexes {
+		if index.FieldName == field.Name && index.MetricType != nil {
+			return *index.MetricType
+		}
+	}
+	return vectorKinds[field.DataType].defaultMetric
+}
+
+// validateSchema checks the fields and indexes of a new collection, and fills the defaults of the indexes.
+func validateSchema(fields []api.CollectionField, indexes []api.CollectionIndex) (collectionSchema, error) {
+	if len(fields) == 0 {
+		return collectionSchema{}, invalidf("Fields are empty")
+	}
+
+	schema := collectionSchema{Fields: make([]api.CollectionField, 0, len(fields)), Indexes: []api.CollectionIndex{}}
+	names := make(map[string]bool)
+	primaryCount := 0
+	vectorCount := 0
+	for _, field := range fields {
+		if err := validateIdentifier("field", field.Name); err != nil {
+			return collectionSchema{}, err
+		}
+		if names[field.Name] {
+			return collectionSchema{}, invalidf("Duplicate field: %s", field.Name)
+		}
+		names[field.Name] = true
+
+		field, err := validateEmbedding(field, fields)
+		if err != nil {
+			return collectionSchema{}, err
+		}
+		schema.Fields = append(schema.Fields, field)
+		if _, err := columnType(field); err != nil {
+			return collectionSchema{}, err
+		}
+		if isVectorField(field) {
+			vectorCount++
+		}
+		if lo.FromPtr(field.IsPrimary) {
+			if field.DataType != api.INT64 && field.DataType != api.VARCHAR {
+				return collectionSchema{}, invalidf("Primary field %s must be INT64 or VARCHAR", field.Name)
+			}
+			primaryCount++
+		}
+	}
+	if primaryCount != 1 {
+		return collectionSchema{}, invalidf("Collection must have exactly one primary field")
+	}
+	if vectorCount == 0 {
+		return collectionSchema{}, invalidf("Collection must have at least one vector field")
+	}
+
+	metrics := make(map[string]api.CollectionIndexMetricType)
+	for _, index := range indexes {
+		field, ok := schema.field(index.FieldName)
+		if !ok {
+			return collectionSchema{}, invalidf("Index field not found: %s", index.FieldName)
+		}
+		if index.IndexName != nil && *index.IndexName == "" {
+			return collectionSchema{}, invalidf("Index name of field %s is empty", index.FieldName)
+		}
+		if !isVectorField(field) {
+			index.IndexType = nil
+			index.MetricType = nil
+			index.Parameter = nil
+			schema.Indexes = append(schema.Indexes, index)
+			continue
+		}
+
+		kind := vectorKinds[field.DataType]
+		if index.IndexType == nil {
+			index.IndexType = lo.ToPtr(api.HNSW)
+		}
+		if !lo.Contains(kind.indexTypes, *index.IndexType) {
+			return collectionSchema{}, invalidf("Index type %s is not supported for %s field %s", *index.IndexType, field.DataType, field.Name)
+		}
+		if index.MetricType == nil {
+			// Indexes of the same field share the metric of the first one.
+			index.MetricType = lo.ToPtr(lo.ValueOr(metrics, field.Name, kind.defaultMetric))
+		}
+		if !lo.Contains(kind.metrics, *index.MetricType) {
+			return collectionSchema{}, invalidf("Metric type %s is not supported for %s field %s", *index.MetricType, field.DataType, field.Name)
+		}
+		if *index.IndexType == api.BINIVFFLAT && *index.MetricType != api.HAMMING {
+			return collectionSchema{}, invalidf("Index type BIN_IVF_FLAT only supports HAMMING metric")
+		}
+		if metric, ok := metrics[field.Name]; ok && metric != *index.MetricType {
+			return collectionSchema{}, invalidf("Indexes of field %s have different metric types", field.Name)
+		}
+		if err := validateIndexParameter(index); err != nil {
+			return collectionSchema{}, err
+		}
+		metrics[field.Name] = *index.MetricType
+		schema.Indexes = append(schema.Indexes, index)
+	}
+
+	return schema, nil
+}
+
+// validateIndexParameter checks the parameters against the limits of pgvector.
+func validateIndexParameter(index api.CollectionIndex) error {
+	if index.Parameter == nil {
+		return nil
+	}
+	parameter := index.Parameter
+	if parameter.M != nil && (*parameter.M < 2 || *parameter.M > 100) {
+		return invalidf("Parameter M of field %s must be between 2 and 100", index.FieldName)
+	}
+	if parameter.EfConstruction != nil {
+		m := defaultHnswM
+		if parameter.M != nil {
+			m = *parameter.M
+		}
+		if *parameter.EfConstruction < 4 || *parameter.EfConstruction > 1000 {
+			return invalidf("Parameter efConstruction of field %s must be between 4 and 1000", index.FieldName)
+		}
+		if *parameter.EfConstruction < 2*m {
+			return invalidf("Parameter efConstruction of field %s must be at least twice of M", index.FieldName)
+		}
+	}
+	if parameter.Nlist != nil && (*parameter.Nlist < 1 || *parameter.Nlist > 32768) {
+		return invalidf("Parameter nlist of field %s must be between 1 and 32768", index.FieldName)
+	}
+	return nil
+}
+
+func validateIdentifier(kind string, name string) error {
+	if len(name) > maxIdentifierLength || !identifierPattern.MatchString(name) {
+		return invalidf("Invalid %s name: %s", kind, name)
+	}
+	return nil
+}
+
+// columnType returns the Postgres type of the field.
+func columnType(field api.CollectionField) (string, error) {
+	switch field.DataType {
+	case api.BOOL:
+		return "boolean", nil
+	case api.INT8, api.INT16:
+		return "smallint", nil
+	case api.INT32:
+		return "integer", nil
+	case api.INT64:
+		return "bigint", nil
+	case api.FLOAT:
+		return "real", nil
+	case api.VARCHAR:
+		if field.MaxLength == nil || *field.MaxLength < 1 || *field.MaxLength > 65535 {
+			return "", invalidf("Field %s of VARCHAR type must have max_length between 1 and 65535", field.Name)
+		}
+		return fmt.Sprintf("varchar(%d)", *field.MaxLength), nil
+	case api.JSON, api.ARRAY:
+		return "jsonb", nil
+	}
+
+	kind, ok := vectorKinds[field.DataType]
+	if !ok {
+		return "", invalidf("Data type %s of field %s is not supported", field.DataType, field.Name)
+	}
+	if field.Dim == nil || *field.Dim < 1 || *field.Dim > kind.maxDimension {
+		return "", invalidf("Field %s of %s type must have dim between 1 and %d", field.Name, field.DataType, kind.maxDimension)
+	}
+	return fmt.Sprintf("%s(%d)", kind.typeName, *field.Dim), nil
+}
+
+func tableName(collectionId int64) string {
+	return fmt.Sprintf("vector_collection_%d", collectionId)
+}
+
+func quote(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
+
+// createTableStatements returns the DDL statements creating the table and the indexes of the collection.
+func createTableStatements(collectionId int64, schema collectionSchema) []string {
+	table := tableName(collectionId)
+	columns := make([]string, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		typeName, _ := columnType(field)
+		column := quote(field.Name) + " " + typeName
+		switch {
+		case lo.FromPtr(field.IsPrimary):
+			column += " PRIMARY KEY"
+		case field.DataType == api.INT8:
+			column += fmt.Sprintf(" CHECK (%s BETWEEN -128 AND 127)", quote(field.Name))
+		case field.DataType == api.ARRAY:
+			column += fmt.Sprintf(" CHECK (jsonb_typeof(%s) = 'array')", quote(field.Name))
+		}
+		columns = append(columns, column)
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", quote(table), strings.Join(columns, ",\n  ")),
+	}
+	for i, index := range schema.Indexes {
+		if statement := createIndexStatement(table, fmt.Sprintf("%s_index_%d", table, i), schema, index); statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+func createIndexStatement(table string, indexName string, schema collectionSchema, index api.CollectionIndex) string {
+	field, _ := schema.field(index.FieldName)
+	prefix := fmt.Sprintf("CREATE INDEX %s ON %s", quote(indexName), quote(table))
+	if !isVectorField(field) {
+		if isJsonField(field) {
+			return fmt.Sprintf("%s USING gin (%s jsonb_path_ops)", prefix, quote(field.Name))
+		}
+		return fmt.Sprintf("%s USING btree (%s)", prefix, quote(field.Name))
+	}
+
+	opsClass := fmt.Sprintf("%s_%s_ops", vectorKinds[field.DataType].typeName, strings.ToLower(string(*index.MetricType)))
+	parameter := lo.FromPtr(index.Parameter)
+	switch *index.IndexType {
+	case api.HNSW, api.SPARSEINVERTEDINDEX:
+		options := []string{}
+		if parameter.M != nil {
+			options = append(options, fmt.Sprintf("m = %d", *parameter.M))
+		}
+		if parameter.EfConstruction != nil {
+			options = append(options, fmt.Sprintf("ef_construction = %d", *parameter.EfConstruction))
+		}
+		statement := fmt.Sprintf("%s USING hnsw (%s %s)", prefix, quote(field.Name), opsClass)
+		if len(options) > 0 {
+			statement += fmt.Sprintf(" WITH (%s)", strings.Join(options, ", "))
+		}
+		return statement
+	case api.IVFFLAT, api.BINIVFFLAT:
+		lists := defaultIvfFlatLists
+		if parameter.Nlist != nil {
+			lists = *parameter.Nlist
+		}
+		return fmt.Sprintf("%s USING ivfflat (%s %s) WITH (lists = %d)", prefix, quote(field.Name), opsClass, lists)
+	default:
+		// FLAT and BIN_FLAT are exact searches without index.
+		return ""
+	}
+}
@@ -0,0 +1,235 @@
+package vectorstore
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+)
+
+func testFields() []api.CollectionField {
+	return []api.CollectionField{
+		{Name: "id", DataType: api.INT64, IsPrimary: lo.ToPtr(true)},
+		{Name: "title", DataType: api.VARCHAR, MaxLength: lo.ToPtr(16)},
+		{Name: "tags", DataType: api.ARRAY},
+		{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(3)},
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	t.Parallel()
+
+	schema, err := validateSchema(testFields(), []api.CollectionIndex{
+		{FieldName: "embedding"},
+		{FieldName: "title", IndexType: lo.ToPtr(api.HNSW)},
+	})
+	require.NoError(t, err)
+	require.Len(t, schema.Indexes, 2)
+	assert.Equal(t, api.HNSW, *schema.Indexes[0].IndexType)
+	assert.Equal(t, api.COSINE, *schema.Indexes[0].MetricType)
+	assert.Nil(t, schema.Indexes[1].IndexType)
+	assert.Equal(t, "id", schema.primaryField().Name)
+
+	invalidCases := map[string]struct {
+		fields  []api.CollectionField
+		indexes []api.CollectionIndex
+	}{
+		"No primary field": {
+			fields: []api.CollectionField{{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(3)}},
+		},
+		"Float primary field": {
+			fields: []api.CollectionField{
+				{Name: "id", DataType: api.FLOAT, IsPrimary: lo.ToPtr(true)},
+				{Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(3)},
+			},
+		},
+		"No vector field": {
+			fields: testFields()[:3],
+		},
+		"Duplicate field": {
+			fields: append(testFields(), api.CollectionField{Name: "title", DataType: api.BOOL}),
+		},
+		"Invalid field name": {
+			fields: append(testFields(), api.CollectionField{Name: "bad-name", DataType: api.BOOL}),
+		},
+		"VARCHAR without max_length": {
+			fields: append(testFields(), api.CollectionField{Name: "text", DataType: api.VARCHAR}),
+		},
+		"Vector without dim": {
+			fields: append(testFields(), api.CollectionField{Name: "vector", DataType: api.FLOAT16VECTOR}),
+		},
+		"Unsupported data type": {
+			fields: append(testFields(), api.CollectionField{Name: "vector", DataType: api.BFLOAT16VECTOR, Dim: lo.ToPtr(3)}),
+		},
+		"Unknown index field": {
+			fields:  testFields(),
+			indexes: []api.CollectionIndex{{FieldName: "unknown"}},
+		},
+		"Unsupported index type": {
+			fields:  testFields(),
+			indexes: []api.CollectionIndex{{FieldName: "embedding", IndexType: lo.ToPtr(api.DISKANN)}},
+		},
+		"Unsupported metric type": {
+			fields:  testFields(),
+			indexes: []api.CollectionIndex{{FieldName: "embedding", MetricType: lo.ToPtr(api.HAMMING)}},
+		},
+		"Different metric types": {
+			fields: testFields(),
+			indexes: []api.CollectionIndex{
+				{FieldName: "embedding", MetricType: lo.ToPtr(api.L2)},
+				{FieldName: "embedding", MetricType: lo.ToPtr(api.IP)},
+			},
+		},
+	}
+	for name, c := range invalidCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := validateSchema(c.fields, c.indexes)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCreateTableStatements(t *testing.T) {
+	t.Parallel()
+
+	schema, err := validateSchema(testFields(), []api.CollectionIndex{
+		{FieldName: "embedding", MetricType: lo.ToPtr(api.L2)},
+		{FieldName: "embedding", IndexType: lo.ToPtr(api.FLAT)},
+		{FieldName: "tags"},
+	})
+	require.NoError(t, err)
+
+	statements := createTableStatements(7, schema)
+	assert.Equal(t, []string{
+		"CREATE TABLE \"vector_collection_7\" (\n" +
+			"  \"id\" bigint PRIMARY KEY,\n" +
+			"  \"title\" varchar(16),\n" +
+			"  \"tags\" jsonb CHECK (jsonb_typeof(\"tags\") = 'array'),\n" +
+			"  \"embedding\" vector(3)\n" +
+			")",
+		`CREATE INDEX "vector_collection_7_index_0" ON "vector_collection_7" USING hnsw ("embedding" vector_l2_ops)`,
+		`CREATE INDEX "vector_collection_7_index_2" ON "vector_collection_7" USING gin ("tags" jsonb_path_ops)`,
+	}, statements)
+	assert.Equal(t, api.L2, schema.metric(api.CollectionField{Name: "embedding", DataType: api.FLOATVECTOR}))
+}
+
+func TestEncodeValue(t *testing.T) {
+	t.Parallel()
+
+	dense := api.CollectionField{Name: "dense", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(3)}
+	sparse := api.CollectionField{Name: "sparse", DataType: api.SPARSEFLOATVECTOR, Dim: lo.ToPtr(5)}
+	binary := api.CollectionField{Name: "binary", DataType: api.BINARYVECTOR, Dim: lo.ToPtr(4)}
+	varchar := api.CollectionField{Name: "varchar", DataType: api.VARCHAR, MaxLength: lo.ToPtr(2)}
+	int8 := api.CollectionField{Name: "int8", DataType: api.INT8}
+	bigint := api.CollectionField{Name: "int64", DataType: api.INT64}
+
+	validCases := []struct {
+		field   api.CollectionField
+		value   interface{}
+		encoded interface{}
+	}{
+		{dense, []interface{}{1.0, 2.5, -3.0}, "[1,2.5,-3]"},
+		{sparse, map[string]interface{}{"4": 2.0, "0": 0.5}, "{1:0.5,5:2}/5"},
+		{binary, "0101", "0101"},
+		{varchar, "中文", "中文"},
+		{int8, 127.0, int64(127)},
+		{bigint, -9007199254740992.0, int64(-9007199254740992)},
+		{api.CollectionField{Name: "json", DataType: api.JSON}, map[string]interface{}{"a": 1.0}, `{"a":1}`},
+		{int8, nil, nil},
+	}
+	for _, c := range validCases {
+		encoded, err := encodeValue(c.field, c.value)
+		require.NoError(t, err, c.field.Name)
+		assert.Equal(t, c.encoded, encoded, c.field.Name)
+	}
+
+	invalidCases := []struct {
+		field api.CollectionField
+		value interface{}
+	}{
+		{dense, []interface{}{1.0, 2.0}},
+		{dense, []interface{}{1.0, 2.0, "3"}},
+		{sparse, map[string]interface{}{"5": 1.0}},
+		{binary, "0102"},
+		{varchar, "abc"},
+		{int8, 128.0},
+		{int8, 1.5},
+		// may have been rounded by the JSON decoding
+		{bigint, 9007199254740994.0},
+		{api.CollectionField{Name: "array", DataType: api.ARRAY}, "a"},
+		{api.CollectionField{Name: "bool", DataType: api.BOOL}, 1.0},
+	}
+	for _, c := range invalidCases {
+		_, err := encodeValue(c.field, c.value)
+		assert.Error(t, err, c.field.Name)
+	}
+}
+
+func TestDecodeVector(t *testing.T) {
+	t.Parallel()
+
+	dense, err := decodeVector(api.CollectionField{DataType: api.FLOATVECTOR}, "[1,2.5,-3]")
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2.5, -3}, dense)
+
+	sparse, err := decodeVector(api.CollectionField{DataType: api.SPARSEFLOATVECTOR}, "{1:0.5,5:2}/5")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"0": 0.5, "4": 2}, sparse)
+
+	binary, err := decodeVector(api.CollectionField{DataType: api.BINARYVECTOR}, "0101")
+	require.NoError(t, err)
+	assert.Equal(t, "0101", binary)
+}
+
+func TestValidateEmbedding(t *testing.T) {
+	t.Parallel()
+
+	modelId := "d68a09df-3589-4273-b032-04488d9b230d-azure-text-embedding-3-small"
+	fields := func(embedding api.CollectionField) []api.CollectionField {
+		return []api.CollectionField{
+			{Name: "id", DataType: api.INT64, IsPrimary: lo.ToPtr(true)},
+			{Name: "text", DataType: api.VARCHAR, MaxLength: lo.ToPtr(1024)},
+			embedding,
+		}
+	}
+
+	schema, err := validateSchema(fields(api.CollectionField{
+		Name:      "embedding",
+		DataType:  api.FLOATVECTOR,
+		Embedding: &api.CollectionEmbedding{ModelId: modelId, TextField: "text"},
+	}), nil)
+	require.NoError(t, err)
+	field, _ := schema.field("embedding")
+	assert.Equal(t, 1536, *field.Dim)
+
+	invalidFields := map[string]api.CollectionField{
+		"Unknown model": {
+			Name: "embedding", DataType: api.FLOATVECTOR,
+			Embedding: &api.CollectionEmbedding{ModelId: "unknown", TextField: "text"},
+		},
+		"Dimension mismatch": {
+			Name: "embedding", DataType: api.FLOATVECTOR, Dim: lo.ToPtr(3),
+			Embedding: &api.CollectionEmbedding{ModelId: modelId, TextField: "text"},
+		},
+		"Binary vector": {
+			Name: "embedding", DataType: api.BINARYVECTOR, Dim: lo.ToPtr(1536),
+			Embedding: &api.CollectionEmbedding{ModelId: modelId, TextField: "text"},
+		},
+		"Text field not VARCHAR": {
+			Name: "embedding", DataType: api.FLOATVECTOR,
+			Embedding: &api.CollectionEmbedding{ModelId: modelId, TextField: "id"},
+		},
+		"Unknown text field": {
+			Name: "embedding", DataType: api.FLOATVECTOR,
+			Embedding: &api.CollectionEmbedding{ModelId: modelId, TextField: "unknown"},
+		},
+	}
+	for name, field := range invalidFields {
+		t.Run(name, func(t *testing.T) {
+			_, err := validateSchema(fields(field), nil)
+			assert.Error(t, err)
+		})
+	}
+}
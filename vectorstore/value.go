@@ -0,0 +1,190 @@
+package vectorstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gitlab.com/navyx/ai/maos/maos-core/api"
+)
+
+// maxExactInteger is the largest magnitude of the integers that a JSON number decoded as float64 holds exactly.
+// INT64 values are limited to it, since larger ones may have been rounded when the request was decoded.
+const maxExactInteger = 1 << 53
+
+// placeholder returns the statement placeholder of the n-th parameter cast to the column type of the field.
+// JSON and vector values are sent as text, since they are encoded by encodeValue.
+func placeholder(field api.CollectionField, n int) string {
+	typeName, _ := columnType(field)
+	if isVectorField(field) || isJsonField(field) {
+		return fmt.Sprintf("$%d::text::%s", n, typeName)
+	}
+	return fmt.Sprintf("$%d::%s", n, typeName)
+}
+
+// encodeValue converts a JSON value of the field into the parameter of its placeholder.
+func encodeValue(field api.CollectionField, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.DataType {
+	case api.BOOL:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case api.INT8:
+		return encodeInteger(field, value, math.MinInt8, math.MaxInt8)
+	case api.INT16:
+		return encodeInteger(field, value, math.MinInt16, math.MaxInt16)
+	case api.INT32:
+		return encodeInteger(field, value, math.MinInt32, math.MaxInt32)
+	case api.INT64:
+		return encodeInteger(field, value, -maxExactInteger, maxExactInteger)
+	case api.FLOAT:
+		if f, ok := value.(float64); ok {
+			return f, nil
+		}
+	case api.VARCHAR:
+		if s, ok := value.(string); ok {
+			if utf8.RuneCountInString(s) > *field.MaxLength {
+				return nil, invalidf("Value of field %s exceeds max_length %d", field.Name, *field.MaxLength)
+			}
+			return s, nil
+		}
+	case api.JSON:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, invalidf("Value of field %s is not valid JSON: %v", field.Name, err)
+		}
+		return string(encoded), nil
+	case api.ARRAY:
+		if _, ok := value.([]interface{}); ok {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, invalidf("Value of field %s is not valid JSON: %v", field.Name, err)
+			}
+			return string(encoded), nil
+		}
+	case api.FLOATVECTOR, api.FLOAT16VECTOR:
+		return encodeDenseVector(field, value)
+	case api.SPARSEFLOATVECTOR:
+		return encodeSparseVector(field, value)
+	case api.BINARYVECTOR:
+		if s, ok := value.(string); ok && len(s) == *field.Dim && strings.Trim(s, "01") == "" {
+			return s, nil
+		}
+		return nil, invalidf("Value of field %s must be a string of %d bits", field.Name, *field.Dim)
+	}
+	return nil, invalidf("Value of field %s is not %s", field.Name, field.DataType)
+}
+
+func encodeInteger(field api.CollectionField, value interface{}, min float64, max float64) (interface{}, error) {
+	f, ok := value.(float64)
+	if !ok || f != math.Trunc(f) {
+		return nil, invalidf("Value of field %s is not %s", field.Name, field.DataType)
+	}
+	if f < min || f > max {
+		return nil, invalidf("Value of field %s must be between %d and %d", field.Name, int64(min), int64(max))
+	}
+	return int64(f), nil
+}
+
+// encodeDenseVector encodes an array of numbers as the text of pgvector, e.g. [1,2.5,3].
+func encodeDenseVector(field api.CollectionField, value interface{}) (interface{}, error) {
+	elements, ok := value.([]interface{})
+	if !ok || len(elements) != *field.Dim {
+		return nil, invalidf("Value of field %s must be an array of %d numbers", field.Name, *field.Dim)
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, element := range elements {
+		f, ok := element.(float64)
+		if !ok {
+			return nil, invalidf("Value of field %s must be an array of %d numbers", field.Name, *field.Dim)
+		}
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(f, 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String(), nil
+}
+
+// encodeSparseVector encodes an object of zero-based index to value as the text of pgvector,
+// whose indexes are one-based, e.g. {1:0.5,3:2}/5.
+func encodeSparseVector(field api.CollectionField, value interface{}) (interface{}, error) {
+	elements, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, invalidf("Value of field %s must be an object of index to number", field.Name)
+	}
+
+	indexes := make([]int, 0, len(elements))
+	values := make(map[int]float64, len(elements))
+	for key, element := range elements {
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= *field.Dim {
+			return nil, invalidf("Index %s of field %s must be between 0 and %d", key, field.Name, *field.Dim-1)
+		}
+		f, ok := element.(float64)
+		if !ok {
+			return nil, invalidf("Value of field %s must be an object of index to number", field.Name)
+		}
+		indexes = append(indexes, index)
+		values[index] = f
+	}
+	slices.Sort(indexes)
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, index := range indexes {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(fmt.Sprintf("%d:%s", index+1, strconv.FormatFloat(values[index], 'g', -1, 32)))
+	}
+	sb.WriteString(fmt.Sprintf("}/%d", *field.Dim))
+	return sb.String(), nil
+}
+
+// decodeVector converts the text of a pgvector value into the form accepted by encodeValue.
+func decodeVector(field api.CollectionField, text string) (interface{}, error) {
+	switch field.DataType {
+	case api.FLOATVECTOR, api.FLOAT16VECTOR:
+		var elements []float64
+		if err := json.Unmarshal([]byte(text), &elements); err != nil {
+			return nil, err
+		}
+		return elements, nil
+	case api.SPARSEFLOATVECTOR:
+		body, _, found := strings.Cut(text, "/")
+		if !found || !strings.HasPrefix(body, "{") || !strings.HasSuffix(body, "}") {
+			return nil, fmt.Errorf("invalid sparse vector: %s", text)
+		}
+		elements := make(map[string]float64)
+		for _, pair := range strings.Split(strings.Trim(body, "{}"), ",") {
+			if pair == "" {
+				continue
+			}
+			key, value, _ := strings.Cut(pair, ":")
+			index, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sparse vector: %s", text)
+			}
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sparse vector: %s", text)
+			}
+			elements[strconv.Itoa(index-1)] = f
+		}
+		return elements, nil
+	default:
+		return text, nil
+	}
+}